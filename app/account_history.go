@@ -0,0 +1,33 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AddCosmosTxToAccountHistory records a successful Cosmos transaction in the account
+// history of its signers and of the senders and recipients of the transfers it made. EVM
+// transactions are recorded from their receipts instead.
+func (app *App) AddCosmosTxToAccountHistory(ctx sdk.Context, tx sdk.Tx, checksum [32]byte, response sdk.DeliverTxHookInput) {
+	if !app.EvmKeeper.AccountHistoryIndexEnabled() || response.EvmTxInfo != nil {
+		return
+	}
+	addrs := []sdk.AccAddress{}
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		addrs = append(addrs, sigTx.GetSigners()...)
+	}
+	for _, event := range GetEventsOfType(response, banktypes.EventTypeTransfer) {
+		for _, key := range []string{banktypes.AttributeKeyRecipient, banktypes.AttributeKeySender} {
+			value, found := GetAttributeValue(event, key)
+			if !found {
+				continue
+			}
+			if addr, err := sdk.AccAddressFromBech32(value); err == nil {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	app.EvmKeeper.SetTransientAccountHistory(ctx, addrs, common.BytesToHash(checksum[:]))
+}
@@ -0,0 +1,41 @@
+package app_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestAddCosmosTxToAccountHistoryGas(t *testing.T) {
+	a := testkeeper.EVMTestApp
+	from, _ := testkeeper.MockAddressPair()
+	to, _ := testkeeper.MockAddressPair()
+	builder := a.GetTxConfig().NewTxBuilder()
+	require.Nil(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1))))))
+	response := sdk.DeliverTxHookInput{Events: []abci.Event{{
+		Type: banktypes.EventTypeTransfer,
+		Attributes: []abci.EventAttribute{
+			{Key: []byte(banktypes.AttributeKeyRecipient), Value: []byte(to.String())},
+			{Key: []byte(banktypes.AttributeKeySender), Value: []byte(from.String())},
+		},
+	}}}
+	gasUsed := func(enabled bool) uint64 {
+		a.EvmKeeper.SetAccountHistoryIndexEnabled(enabled)
+		defer a.EvmKeeper.SetAccountHistoryIndexEnabled(false)
+		ctx := a.GetContextForDeliverTx([]byte{}).WithGasMeter(sdk.NewGasMeter(1000000, 1, 1))
+		a.AddCosmosTxToAccountHistory(ctx, builder.GetTx(), [32]byte{1}, response)
+		if enabled {
+			// the entry is written without consuming gas
+			key := types.AccountHistoryKey(from, uint64(ctx.BlockHeight()), uint32(ctx.TxIndex()), types.AccountHistoryCosmosKind)
+			require.Equal(t, []byte{1}, ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1)).TransientStore(a.GetTKey(types.TransientStoreKey)).Get(key)[:1])
+		}
+		return ctx.GasMeter().GasConsumed()
+	}
+	// the index is node-local, so it must not change the gas used by the transaction
+	require.Equal(t, gasUsed(false), gasUsed(true))
+}
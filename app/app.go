@@ -385,6 +385,8 @@ type App struct {
 
 	stateStore   seidb.StateStore
 	receiptStore seidb.StateStore
+	// closed to stop the flat snapshot base layer generator
+	flatSnapshotStop chan struct{}
 
 	forkInitializer func(sdk.Context)
 }
@@ -612,10 +614,16 @@ func New(
 		wasmOpts...,
 	)
 
+	app.evmRPCConfig, err = evmrpc.ReadConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error reading EVM config due to %s", err))
+	}
 	receiptStorePath := filepath.Join(homePath, "data", "receipt.db")
 	ssConfig := ssconfig.DefaultStateStoreConfig()
 	ssConfig.DedicatedChangelog = true
-	ssConfig.KeepRecent = cast.ToInt(appOpts.Get(server.FlagMinRetainBlocks))
+	// the receipt store prunes itself in the background
+	ssConfig.KeepRecent = receiptKeepRecent(app.evmRPCConfig, appOpts)
+	ssConfig.PruneIntervalSeconds = app.evmRPCConfig.ReceiptPruneIntervalSeconds
 	ssConfig.DBDirectory = receiptStorePath
 	ssConfig.KeepLastVersion = false
 	if app.receiptStore == nil {
@@ -629,13 +637,19 @@ func New(
 		&app.AccountKeeper, &app.StakingKeeper, app.TransferKeeper,
 		wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper), &app.WasmKeeper, &app.ConfidentialTransfersKeeper, &app.UpgradeKeeper)
 	app.BankKeeper.RegisterRecipientChecker(app.EvmKeeper.CanAddressReceive)
+	app.TokenFactoryKeeper.SetHooks(tokenfactorytypes.NewMultiTokenFactoryHooks(app.EvmKeeper.TokenFactoryHooks()))
 
 	bApp.SetPreCommitHandler(app.HandlePreCommit)
 	bApp.SetCloseHandler(app.HandleClose)
 
-	app.evmRPCConfig, err = evmrpc.ReadConfig(appOpts)
-	if err != nil {
-		panic(fmt.Sprintf("error reading EVM config due to %s", err))
+	app.EvmKeeper.SetLogIndexEnabled(app.evmRPCConfig.EnableLogIndex)
+	app.EvmKeeper.SetTxAddressIndexEnabled(app.evmRPCConfig.EnableTxAddressIndex)
+	app.EvmKeeper.SetAccountHistoryIndexEnabled(app.evmRPCConfig.EnableAccountHistoryIndex)
+	app.EvmKeeper.SetStateDiffIndexEnabled(app.evmRPCConfig.EnableStateDiffIndex)
+	app.EvmKeeper.SetFlatSnapshotEnabled(app.evmRPCConfig.EnableFlatSnapshot)
+	if app.evmRPCConfig.EnableFlatSnapshot {
+		app.flatSnapshotStop = make(chan struct{})
+		go app.generateFlatSnapshotBaseLayers(app.evmRPCConfig.FlatSnapshotBaseLayerInterval, app.flatSnapshotStop)
 	}
 	evmQueryConfig, err := querier.ReadConfig(appOpts)
 	if err != nil {
@@ -709,7 +723,7 @@ func New(
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, evm.NewNativePointerIBCMiddleware(transferIBCModule, &app.EvmKeeper))
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper))
 	// this line is used by starport scaffolding # ibc/app/router
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -1004,6 +1018,7 @@ func New(
 	app.HardForkManager.RegisterHandler(v0upgrade.NewHardForkUpgradeHandler(100_000, upgrades.ChainIDSeiHardForkTest, app.WasmKeeper))
 
 	app.RegisterDeliverTxHook(app.AddCosmosEventsToEVMReceiptIfApplicable)
+	app.RegisterDeliverTxHook(app.AddCosmosTxToAccountHistory)
 
 	return app
 }
@@ -1015,12 +1030,24 @@ func (app *App) HandlePreCommit(ctx sdk.Context) error {
 
 // Close closes all items that needs closing (called by baseapp)
 func (app *App) HandleClose() error {
+	if app.flatSnapshotStop != nil {
+		close(app.flatSnapshotStop)
+	}
 	if app.receiptStore != nil {
 		return app.receiptStore.Close()
 	}
 	return nil
 }
 
+// receiptKeepRecent returns the number of blocks whose receipts the receipt store keeps, 0
+// meaning all of them. Receipts follow min-retain-blocks unless configured otherwise.
+func receiptKeepRecent(evmRPCConfig evmrpc.Config, appOpts servertypes.AppOptions) int {
+	if evmRPCConfig.ReceiptKeepRecent >= 0 {
+		return evmRPCConfig.ReceiptKeepRecent
+	}
+	return cast.ToInt(appOpts.Get(server.FlagMinRetainBlocks))
+}
+
 // Add (or remove) keepers when they are introduced / removed in different versions
 func (app *App) SetStoreUpgradeHandlers() {
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -1210,6 +1237,8 @@ func (app *App) FinalizeBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock)
 			}
 			cms := app.WriteState()
 			app.LightInvarianceChecks(cms, app.lightInvarianceConfig)
+			app.IndexStateDiff(cms, req.Height)
+			app.IndexFlatSnapshot(cms, req.Height)
 			appHash := app.GetWorkingHash()
 			resp := app.getFinalizeBlockResponse(appHash, app.optimisticProcessingInfo.Events, app.optimisticProcessingInfo.TxRes, app.optimisticProcessingInfo.EndBlockResp)
 			return &resp, nil
@@ -1225,6 +1254,8 @@ func (app *App) FinalizeBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock)
 	}
 	cms := app.WriteState()
 	app.LightInvarianceChecks(cms, app.lightInvarianceConfig)
+	app.IndexStateDiff(cms, req.Height)
+	app.IndexFlatSnapshot(cms, req.Height)
 	appHash := app.GetWorkingHash()
 	resp := app.getFinalizeBlockResponse(appHash, events, txResults, endBlockResp)
 	return &resp, nil
@@ -1874,8 +1905,14 @@ func (app *App) RPCContextProvider(i int64) sdk.Context {
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
 
+	// HTTP and websocket clients share one rate limit budget
+	rateLimiter, err := evmrpc.NewRateLimiterFromConfig(app.evmRPCConfig)
+	if err != nil {
+		panic(err)
+	}
+
 	if app.evmRPCConfig.HTTPEnabled {
-		evmHTTPServer, err := evmrpc.NewEVMHTTPServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, app.BaseApp, app.AnteHandler, app.RPCContextProvider, app.encodingConfig.TxConfig, DefaultNodeHome, nil)
+		evmHTTPServer, err := evmrpc.NewEVMHTTPServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, app.BaseApp, app.AnteHandler, app.RPCContextProvider, app.encodingConfig.TxConfig, DefaultNodeHome, rateLimiter, nil)
 		if err != nil {
 			panic(err)
 		}
@@ -1885,7 +1922,7 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	}
 
 	if app.evmRPCConfig.WSEnabled {
-		evmWSServer, err := evmrpc.NewEVMWebSocketServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, app.BaseApp, app.AnteHandler, app.RPCContextProvider, app.encodingConfig.TxConfig, DefaultNodeHome)
+		evmWSServer, err := evmrpc.NewEVMWebSocketServer(app.Logger(), app.evmRPCConfig, clientCtx.Client, &app.EvmKeeper, app.BaseApp, app.AnteHandler, app.RPCContextProvider, app.encodingConfig.TxConfig, DefaultNodeHome, rateLimiter)
 		if err != nil {
 			panic(err)
 		}
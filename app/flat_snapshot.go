@@ -0,0 +1,94 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/storev2/commitment"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+const (
+	flatSnapshotBaseLayerBatchSize = 10000
+	flatSnapshotGeneratorInterval  = 10 * time.Second
+)
+
+// IndexFlatSnapshot writes the changes the block being committed made to the keys the
+// flat snapshot keeps. Like IndexStateDiff it relies on the changed pairs of the memiavl
+// stores, so it must be called after the state is written and before it is committed.
+func (app *App) IndexFlatSnapshot(cms sdk.CommitMultiStore, height int64) {
+	if !app.EvmKeeper.FlatSnapshotEnabled() {
+		return
+	}
+	defer metrics.MeasureSince(
+		[]string{"sei", "flat_snapshot", "diff", "milliseconds"},
+		time.Now().UTC(),
+	)
+	evmStore, ok := cms.GetStore(app.EvmKeeper.GetStoreKey()).(*commitment.Store)
+	if !ok {
+		app.Logger().Error("evm store is not a memiavl store; cannot write flat snapshot")
+		return
+	}
+	bankStore, ok := cms.GetStore(app.BankKeeper.GetStoreKey()).(*commitment.Store)
+	if !ok {
+		app.Logger().Error("bank store is not a memiavl store; cannot write flat snapshot")
+		return
+	}
+	var evmChanges, bankChanges []*iavl.KVPair
+	for _, prefix := range evmkeeper.FlatSnapshotPrefixes(evmtypes.FlatSnapshotEVMStore) {
+		evmChanges = append(evmChanges, evmStore.GetChangedPairs(prefix)...)
+	}
+	for _, prefix := range evmkeeper.FlatSnapshotPrefixes(evmtypes.FlatSnapshotBankStore) {
+		bankChanges = append(bankChanges, bankStore.GetChangedPairs(prefix)...)
+	}
+	if err := app.EvmKeeper.SetFlatSnapshotDiff(height, evmChanges, bankChanges); err != nil {
+		app.Logger().Error(fmt.Sprintf("failed to write flat snapshot diff of block %d: %s", height, err))
+	}
+}
+
+// generateFlatSnapshotBaseLayers writes a base layer of the flat snapshot whenever the
+// snapshot cannot serve the last block or its last base layer is interval blocks old,
+// until stop is closed.
+func (app *App) generateFlatSnapshotBaseLayers(interval int64, stop <-chan struct{}) {
+	ticker := time.NewTicker(flatSnapshotGeneratorInterval)
+	defer ticker.Stop()
+	var lastBaseLayer int64
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		height := app.LastBlockHeight()
+		if height <= 0 || height-lastBaseLayer < interval {
+			continue
+		}
+		// the diffs of the last blocks may not have been flushed yet, so check against
+		// the last block written to the snapshot
+		_, latest, err := evmkeeper.GetFlatSnapshotStatus(app.receiptStore)
+		if err != nil || latest == 0 {
+			continue
+		}
+		if base, ok, err := evmkeeper.FlatSnapshotBaseLayer(app.receiptStore, latest); err == nil && ok && height-int64(base) < interval { //nolint:gosec
+			lastBaseLayer = int64(base) //nolint:gosec
+			continue
+		}
+		ctx, err := app.CreateQueryContext(height, false)
+		if err != nil {
+			app.Logger().Error(fmt.Sprintf("failed to create query context for flat snapshot base layer at height %d: %s", height, err))
+			continue
+		}
+		start := time.Now()
+		written, err := app.EvmKeeper.WriteFlatSnapshotBaseLayer(ctx, flatSnapshotBaseLayerBatchSize)
+		if err != nil {
+			app.Logger().Error(fmt.Sprintf("failed to write flat snapshot base layer at height %d: %s", height, err))
+			continue
+		}
+		lastBaseLayer = height
+		app.Logger().Info(fmt.Sprintf("wrote flat snapshot base layer of %d entries at height %d in %s", written, height, time.Since(start)))
+	}
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	seidbproto "github.com/sei-protocol/sei-db/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFlatSnapshotBaseLayer(t *testing.T) {
+	a := Setup(false, false)
+	ctx := a.GetContextForDeliverTx([]byte{}).WithBlockHeight(5)
+	addr := common.HexToAddress("0xf1a7")
+	a.EvmKeeper.SetState(ctx, addr, common.HexToHash("0x1"), common.HexToHash("0x2"))
+	a.EvmKeeper.SetState(ctx, addr, common.HexToHash("0x3"), common.HexToHash("0x4"))
+	// receipts of later blocks were already written
+	require.Nil(t, a.receiptStore.ApplyChangeset(100, &seidbproto.NamedChangeSet{
+		Name:      evmtypes.ReceiptStoreKey,
+		Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: evmtypes.FlatSnapshotStatusKey, Value: append(make([]byte, 7), 5, 0, 0, 0, 0, 0, 0, 0, 100)}}},
+	}))
+
+	written, err := a.EvmKeeper.WriteFlatSnapshotBaseLayer(ctx, 1)
+	require.Nil(t, err)
+	require.NotZero(t, written)
+	// the base layer does not move the latest version of the receipt store back
+	latest, err := a.receiptStore.GetLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, int64(100), latest)
+	slot := append(evmtypes.StateKey(addr), common.HexToHash("0x1").Bytes()...)
+	value, err := evmkeeper.GetFlatSnapshotValue(a.receiptStore, evmtypes.FlatSnapshotEVMStore, slot, 5)
+	require.Nil(t, err)
+	require.Equal(t, common.HexToHash("0x2").Bytes(), value)
+
+	// the snapshot serves height 5, and iterators read the same storage as point reads
+	a.EvmKeeper.SetFlatSnapshotEnabled(true)
+	defer a.EvmKeeper.SetFlatSnapshotEnabled(false)
+	base, ok, err := evmkeeper.FlatSnapshotBaseLayer(a.receiptStore, 5)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(5), base)
+	snapshotCtx := a.EvmKeeper.FlatSnapshotContext(ctx)
+	storage := map[common.Hash]common.Hash{}
+	a.EvmKeeper.IterateStorage(snapshotCtx, addr, nil, func(key common.Hash, val common.Hash) bool {
+		storage[key] = val
+		return false
+	})
+	require.Equal(t, map[common.Hash]common.Hash{
+		common.HexToHash("0x1"): common.HexToHash("0x2"),
+		common.HexToHash("0x3"): common.HexToHash("0x4"),
+	}, storage)
+	for key, val := range storage {
+		require.Equal(t, val, a.EvmKeeper.GetState(snapshotCtx, addr, key))
+	}
+}
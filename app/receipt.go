@@ -10,6 +10,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/sei-protocol/sei-chain/utils"
@@ -45,7 +47,8 @@ func getOwnerEventKey(contractAddr string, tokenID string) string {
 func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.Tx, checksum [32]byte, response sdk.DeliverTxHookInput) {
 	// hooks will only be called if DeliverTx is successful
 	wasmEvents := GetEventsOfType(response, wasmtypes.WasmModuleEventType)
-	if len(wasmEvents) == 0 {
+	bankTransfers := app.getBankTransfers(ctx, response)
+	if len(wasmEvents) == 0 && len(bankTransfers) == 0 {
 		return
 	}
 	logs := []*ethtypes.Log{}
@@ -105,15 +108,39 @@ func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.
 			continue
 		}
 	}
-	if len(logs) == 0 {
-		return
-	}
 	txHash := common.BytesToHash(checksum[:])
 	if response.EvmTxInfo != nil {
 		txHash = common.HexToHash(response.EvmTxInfo.TxHash)
 	}
+	existingReceipt, err := app.EvmKeeper.GetTransientReceipt(wasmToEvmEventCtx, txHash)
+	if err != nil {
+		existingReceipt = nil
+	}
+	// movements made by a native pointer contract through the bank precompile already have a
+	// Transfer log from the contract itself, so only the remaining movements get synthetic ones
+	pointerLogs := map[string]int{}
+	if existingReceipt != nil {
+		for _, l := range existingReceipt.Logs {
+			pointerLogs[syntheticLogKey(l.Address, l.Topics, l.Data)]++
+		}
+	}
+	nativePointers := map[string]*common.Address{}
+	for _, bankTransfer := range bankTransfers {
+		for _, log := range app.translateBankTransfer(wasmToEvmEventCtx, bankTransfer, nativePointers) {
+			key := syntheticLogKey(log.Address.Hex(), utils.Map(log.Topics, func(h common.Hash) string { return h.Hex() }), log.Data)
+			if pointerLogs[key] > 0 {
+				pointerLogs[key]--
+				continue
+			}
+			log.Index = uint(len(logs))
+			logs = append(logs, log)
+		}
+	}
+	if len(logs) == 0 {
+		return
+	}
 	var bloom ethtypes.Bloom
-	if r, err := app.EvmKeeper.GetTransientReceipt(wasmToEvmEventCtx, txHash); err == nil && r != nil {
+	if r := existingReceipt; r != nil {
 		r.Logs = append(r.Logs, utils.Map(logs, evmkeeper.ConvertSyntheticEthLog)...)
 		for i, l := range r.Logs {
 			l.Index = uint32(i)
@@ -200,6 +227,44 @@ func (app *App) translateCW20Event(ctx sdk.Context, wasmEvent abci.Event, pointe
 	return
 }
 
+// translateBankTransfer turns a bank movement of coins into ERC20 Transfer logs for the
+// denoms that have a native pointer. nativePointers caches pointer lookups across a tx.
+func (app *App) translateBankTransfer(ctx sdk.Context, transfer bankTransfer, nativePointers map[string]*common.Address) (res []*ethtypes.Log) {
+	defer func() {
+		if r := recover(); r != nil {
+			fmt.Printf("[Error] Panic caught during translateBankTransfer: type=%T, value=%+v\n", r, r)
+		}
+	}()
+
+	from, to := app.GetEvmAddressHash(ctx, transfer.from), app.GetEvmAddressHash(ctx, transfer.to)
+	for _, coin := range transfer.amount {
+		pointerAddr, cached := nativePointers[coin.Denom]
+		if !cached {
+			if addr, _, exists := app.EvmKeeper.GetERC20NativePointer(ctx, coin.Denom); exists {
+				pointerAddr = &addr
+			}
+			nativePointers[coin.Denom] = pointerAddr
+		}
+		if pointerAddr == nil {
+			continue
+		}
+		res = append(res, &ethtypes.Log{
+			Address: *pointerAddr,
+			Topics: []common.Hash{
+				ERC20TransferTopic,
+				from,
+				to,
+			},
+			Data: common.BigToHash(coin.Amount.BigInt()).Bytes(),
+		})
+	}
+	return
+}
+
+func syntheticLogKey(address string, topics []string, data []byte) string {
+	return fmt.Sprintf("%s/%s/%x", strings.ToLower(address), strings.ToLower(strings.Join(topics, ",")), data)
+}
+
 func (app *App) translateCW721Event(ctx sdk.Context, wasmEvent abci.Event, pointerAddr common.Address, contractAddr string,
 	ownerEventsMap map[string][]abci.Event, cw721TransferCounterMap map[string]int) (res []*ethtypes.Log) {
 	for _, action := range app.GetActionsFromWasmEvent(ctx, wasmEvent) {
@@ -412,6 +477,100 @@ func GetEventsOfType(rdtx sdk.DeliverTxHookInput, ty string) (res []abci.Event)
 	return
 }
 
+// bankTransfer is a movement of coins between two accounts. from is empty for mints and to
+// is empty for burns.
+type bankTransfer struct {
+	from, to string
+	amount   sdk.Coins
+}
+
+// getBankTransfers returns the coin movements recorded by the bank events of a tx in emission
+// order. Fee deductions are left out, since they are not transfers made by the tx itself.
+func (app *App) getBankTransfers(ctx sdk.Context, rdtx sdk.DeliverTxHookInput) (res []bankTransfer) {
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String()
+	fees := map[string]int{}
+	for _, event := range GetEventsOfType(rdtx, sdk.EventTypeTx) {
+		fee, feeFound := GetAttributeValue(event, sdk.AttributeKeyFee)
+		payer, payerFound := GetAttributeValue(event, sdk.AttributeKeyFeePayer)
+		if feeFound && payerFound {
+			fees[payer+"/"+fee]++
+		}
+	}
+	parseAmount := func(event abci.Event) sdk.Coins {
+		amount, _ := GetAttributeValue(event, sdk.AttributeKeyAmount)
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to parse %s event amount %s due to %s", event.Type, amount, err))
+		}
+		return coins
+	}
+	// multi-send emits a sender-only message event right after the coin_spent event of each
+	// input, followed by a sender-less transfer event per output. Plain sends emit the same
+	// message event, but after their transfer event.
+	var inputs []*bankTransfer
+	inOutputs := false
+	for i, event := range rdtx.Events {
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			sender, found := GetAttributeValue(event, banktypes.AttributeKeySender)
+			if !found || len(event.Attributes) != 1 || i == 0 || rdtx.Events[i-1].Type != banktypes.EventTypeCoinSpent {
+				continue
+			}
+			if spender, _ := GetAttributeValue(rdtx.Events[i-1], banktypes.AttributeKeySpender); spender != sender {
+				continue
+			}
+			if inOutputs {
+				inputs, inOutputs = nil, false
+			}
+			inputs = append(inputs, &bankTransfer{from: sender, amount: parseAmount(rdtx.Events[i-1])})
+		case banktypes.EventTypeTransfer:
+			recipient, _ := GetAttributeValue(event, banktypes.AttributeKeyRecipient)
+			amount := parseAmount(event)
+			sender, found := GetAttributeValue(event, banktypes.AttributeKeySender)
+			if !found {
+				inOutputs = true
+				res = append(res, attributeMultiSendOutput(inputs, recipient, amount)...)
+				continue
+			}
+			if feeKey := sender + "/" + amount.String(); recipient == feeCollector && fees[feeKey] > 0 {
+				fees[feeKey]--
+				continue
+			}
+			res = append(res, bankTransfer{from: sender, to: recipient, amount: amount})
+		case banktypes.EventTypeCoinMint:
+			minter, _ := GetAttributeValue(event, banktypes.AttributeKeyMinter)
+			res = append(res, bankTransfer{to: minter, amount: parseAmount(event)})
+		case banktypes.EventTypeCoinBurn:
+			burner, _ := GetAttributeValue(event, banktypes.AttributeKeyBurner)
+			res = append(res, bankTransfer{from: burner, amount: parseAmount(event)})
+		}
+	}
+	return
+}
+
+// attributeMultiSendOutput splits a multi-send output across the inputs that fund it, in input
+// order, drawing down what is left of each input. Inputs and outputs of a multi-send balance
+// per denom, so every output is fully attributed.
+func attributeMultiSendOutput(inputs []*bankTransfer, recipient string, amount sdk.Coins) (res []bankTransfer) {
+	for _, coin := range amount {
+		needed := coin.Amount
+		for _, input := range inputs {
+			if !needed.IsPositive() {
+				break
+			}
+			available := input.amount.AmountOf(coin.Denom)
+			if !available.IsPositive() {
+				continue
+			}
+			drawn := sdk.MinInt(available, needed)
+			input.amount = input.amount.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, drawn)))
+			needed = needed.Sub(drawn)
+			res = append(res, bankTransfer{from: input.from, to: recipient, amount: sdk.NewCoins(sdk.NewCoin(coin.Denom, drawn))})
+		}
+	}
+	return
+}
+
 func GetAttributeValue(event abci.Event, attribute string) (string, bool) {
 	for _, attr := range event.Attributes {
 		if string(attr.Key) == attribute {
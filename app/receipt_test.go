@@ -18,10 +18,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	eabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/app"
 	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
 	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -1003,3 +1005,143 @@ func signTxMultiple(txBuilder client.TxBuilder, privKeys []cryptotypes.PrivKey,
 	_ = txBuilder.SetSignatures(sigsV2...)
 	return txBuilder.GetTx()
 }
+
+func TestEvmEventsForNativePointer(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	k := testApp.EvmKeeper
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).WithChainID("sei-test").WithBlockHeight(1)
+	_, pointerAddr := testkeeper.MockAddressPair()
+	k.SetERC20NativePointer(ctx, "unative", pointerAddr)
+	sender, senderEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, sender, senderEvmAddr)
+	recipient, recipientEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, recipient, recipientEvmAddr)
+	other, otherEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, other, otherEvmAddr)
+	feeCollector := testApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	attrs := func(kvs ...string) (res []abci.EventAttribute) {
+		for i := 0; i < len(kvs); i += 2 {
+			res = append(res, abci.EventAttribute{Key: []byte(kvs[i]), Value: []byte(kvs[i+1])})
+		}
+		return
+	}
+	events := []abci.Event{
+		// fee deduction
+		{Type: "transfer", Attributes: attrs("recipient", feeCollector.String(), "sender", sender.String(), "amount", "10unative")},
+		{Type: "message", Attributes: attrs("sender", sender.String())},
+		{Type: "tx", Attributes: attrs("fee", "10unative", "fee_payer", sender.String())},
+		// plain send
+		{Type: "coin_spent", Attributes: attrs("spender", sender.String(), "amount", "100unative,5uother")},
+		{Type: "coin_received", Attributes: attrs("receiver", recipient.String(), "amount", "100unative,5uother")},
+		{Type: "transfer", Attributes: attrs("recipient", recipient.String(), "sender", sender.String(), "amount", "100unative,5uother")},
+		{Type: "message", Attributes: attrs("sender", sender.String())},
+		// multi-send with two inputs, whose outputs carry no sender
+		{Type: "coin_spent", Attributes: attrs("spender", sender.String(), "amount", "4unative")},
+		{Type: "message", Attributes: attrs("sender", sender.String())},
+		{Type: "coin_spent", Attributes: attrs("spender", other.String(), "amount", "2unative")},
+		{Type: "message", Attributes: attrs("sender", other.String())},
+		{Type: "coin_received", Attributes: attrs("receiver", recipient.String(), "amount", "5unative")},
+		{Type: "transfer", Attributes: attrs("recipient", recipient.String(), "amount", "5unative")},
+		{Type: "coin_received", Attributes: attrs("receiver", sender.String(), "amount", "1unative")},
+		{Type: "transfer", Attributes: attrs("recipient", sender.String(), "amount", "1unative")},
+		{Type: "coinbase", Attributes: attrs("minter", recipient.String(), "amount", "7unative")},
+		{Type: "burn", Attributes: attrs("burner", sender.String(), "amount", "3unative")},
+	}
+
+	checksum := sha256.Sum256([]byte("native pointer cosmos tx"))
+	testApp.AddCosmosEventsToEVMReceiptIfApplicable(ctx, nil, checksum, sdk.DeliverTxHookInput{Events: events})
+	receipt, err := k.GetTransientReceipt(ctx, common.BytesToHash(checksum[:]))
+	require.Nil(t, err)
+	require.Equal(t, uint32(app.ShellEVMTxType), receipt.TxType)
+	require.NotEmpty(t, receipt.LogsBloom)
+	senderHash := common.BytesToHash(senderEvmAddr[:]).Hex()
+	recipientHash := common.BytesToHash(recipientEvmAddr[:]).Hex()
+	otherHash := common.BytesToHash(otherEvmAddr[:]).Hex()
+	zeroHash := app.EmptyHash.Hex()
+	type transfer struct {
+		from, to string
+		amount   int64
+	}
+	requireTransfers := func(logs []*evmtypes.Log, expected []transfer) {
+		require.Equal(t, len(expected), len(logs))
+		for i, e := range expected {
+			log := logs[i]
+			require.Equal(t, pointerAddr.Hex(), log.Address)
+			require.Equal(t, uint32(i), log.Index)
+			require.Equal(t, []string{app.ERC20TransferTopic.Hex(), e.from, e.to}, log.Topics)
+			require.Equal(t, common.BigToHash(big.NewInt(e.amount)).Bytes(), log.Data)
+		}
+	}
+	requireTransfers(receipt.Logs, []transfer{
+		{senderHash, recipientHash, 100},
+		{senderHash, recipientHash, 4},
+		{otherHash, recipientHash, 1},
+		{otherHash, senderHash, 1},
+		{zeroHash, recipientHash, 7},
+		{senderHash, zeroHash, 3},
+	})
+
+	// in EVM txs, movements made by the pointer contract through the bank precompile already
+	// have a Transfer log from the contract, while other movements (e.g. funds sent along with
+	// a wasmd precompile call) get synthetic ones
+	checksum = sha256.Sum256([]byte("native pointer evm tx"))
+	evmTxHash := common.BytesToHash(checksum[:])
+	require.Nil(t, k.SetTransientReceipt(ctx, evmTxHash, &evmtypes.Receipt{
+		TxHashHex: evmTxHash.Hex(),
+		Logs: []*evmtypes.Log{{
+			Address: pointerAddr.Hex(),
+			Topics:  []string{app.ERC20TransferTopic.Hex(), senderHash, recipientHash},
+			Data:    common.BigToHash(big.NewInt(100)).Bytes(),
+		}},
+	}))
+	testApp.AddCosmosEventsToEVMReceiptIfApplicable(ctx, nil, checksum, sdk.DeliverTxHookInput{
+		EvmTxInfo: &abci.EvmTxInfo{TxHash: evmTxHash.Hex()},
+		Events: []abci.Event{
+			{Type: "transfer", Attributes: attrs("recipient", recipient.String(), "sender", sender.String(), "amount", "100unative")},
+			{Type: "transfer", Attributes: attrs("recipient", other.String(), "sender", sender.String(), "amount", "20unative")},
+		},
+	})
+	receipt, err = k.GetTransientReceipt(ctx, evmTxHash)
+	require.Nil(t, err)
+	requireTransfers(receipt.Logs, []transfer{{senderHash, recipientHash, 100}, {senderHash, otherHash, 20}})
+}
+
+func TestEvmEventsForNativePointerMultiSend(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	k := testApp.EvmKeeper
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).WithChainID("sei-test").WithBlockHeight(1)
+	_, pointerAddr := testkeeper.MockAddressPair()
+	k.SetERC20NativePointer(ctx, "unative", pointerAddr)
+	privKey := testkeeper.MockPrivateKey()
+	sender, senderEvmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, sender, senderEvmAddr)
+	recipient, recipientEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, recipient, recipientEvmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000000000)), sdk.NewCoin("unative", sdk.NewInt(100)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, "evm", amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, "evm", sender, amt))
+
+	coins := sdk.NewCoins(sdk.NewCoin("unative", sdk.NewInt(30)))
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: sender.String(), Coins: coins}},
+		Outputs: []banktypes.Output{{Address: recipient.String(), Coins: coins}},
+	}
+	txBuilder := testApp.GetTxConfig().NewTxBuilder()
+	require.Nil(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1000000))))
+	txBuilder.SetGasLimit(300000)
+	tx := signTx(txBuilder, privKey, k.AccountKeeper().GetAccount(ctx, sender))
+	txbz, err := testApp.GetTxConfig().TxEncoder()(tx)
+	require.Nil(t, err)
+	sum := sha256.Sum256(txbz)
+	res := testApp.DeliverTx(ctx.WithEventManager(sdk.NewEventManager()), abci.RequestDeliverTx{Tx: txbz}, tx, sum)
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	receipt, err := k.GetTransientReceipt(ctx, common.BytesToHash(sum[:]))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(receipt.Logs))
+	require.Equal(t, pointerAddr.Hex(), receipt.Logs[0].Address)
+	require.Equal(t, []string{
+		app.ERC20TransferTopic.Hex(), common.BytesToHash(senderEvmAddr[:]).Hex(), common.BytesToHash(recipientEvmAddr[:]).Hex(),
+	}, receipt.Logs[0].Topics)
+	require.Equal(t, common.BigToHash(big.NewInt(30)).Bytes(), receipt.Logs[0].Data)
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/storev2/commitment"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// IndexStateDiff records the EVM accounts whose balance, nonce, code or storage was
+// changed by the block being committed, along with the storage slots it changed. Like the
+// light invariance checks it relies on the changed pairs of the memiavl stores, so it
+// must be called after the state is written and before it is committed.
+func (app *App) IndexStateDiff(cms sdk.CommitMultiStore, height int64) {
+	if !app.EvmKeeper.StateDiffIndexEnabled() {
+		return
+	}
+	defer metrics.MeasureSince(
+		[]string{"sei", "state_diff_index", "milliseconds"},
+		time.Now().UTC(),
+	)
+	evmStore, ok := cms.GetStore(app.EvmKeeper.GetStoreKey()).(*commitment.Store)
+	if !ok {
+		app.Logger().Error("evm store is not a memiavl store; cannot index state diff")
+		return
+	}
+	bankStore, ok := cms.GetStore(app.BankKeeper.GetStoreKey()).(*commitment.Store)
+	if !ok {
+		app.Logger().Error("bank store is not a memiavl store; cannot index state diff")
+		return
+	}
+	modified := map[common.Address][]common.Hash{}
+	touch := func(addr common.Address) {
+		if _, ok := modified[addr]; !ok {
+			modified[addr] = []common.Hash{}
+		}
+	}
+	for _, p := range evmStore.GetChangedPairs(evmtypes.StateKeyPrefix) {
+		if len(p.Key) != len(evmtypes.StateKeyPrefix)+common.AddressLength+common.HashLength {
+			app.Logger().Error(fmt.Sprintf("invalid changed state key: %X", p.Key))
+			continue
+		}
+		addr := common.BytesToAddress(p.Key[len(evmtypes.StateKeyPrefix) : len(evmtypes.StateKeyPrefix)+common.AddressLength])
+		modified[addr] = append(modified[addr], common.BytesToHash(p.Key[len(evmtypes.StateKeyPrefix)+common.AddressLength:]))
+	}
+	for _, pref := range [][]byte{evmtypes.NonceKeyPrefix, evmtypes.CodeKeyPrefix, evmtypes.CodeHashKeyPrefix, evmtypes.CodeSizeKeyPrefix} {
+		for _, p := range evmStore.GetChangedPairs(pref) {
+			if len(p.Key) != len(pref)+common.AddressLength {
+				app.Logger().Error(fmt.Sprintf("invalid changed account key: %X", p.Key))
+				continue
+			}
+			touch(common.BytesToAddress(p.Key[len(pref):]))
+		}
+	}
+	// balances are keyed by Sei address, which may have been associated in this block
+	associations := map[string]common.Address{}
+	for _, p := range evmStore.GetChangedPairs(evmtypes.SeiAddressToEVMAddressKeyPrefix) {
+		if !p.Delete {
+			associations[string(p.Key[len(evmtypes.SeiAddressToEVMAddressKeyPrefix):])] = common.BytesToAddress(p.Value)
+		}
+	}
+	evmAddress := func(seiAddr []byte) (common.Address, bool) {
+		if addr, ok := associations[string(seiAddr)]; ok {
+			return addr, true
+		}
+		if bz := evmStore.Get(evmtypes.SeiAddressToEVMAddressKey(seiAddr)); bz != nil {
+			return common.BytesToAddress(bz), true
+		}
+		if len(seiAddr) == common.AddressLength {
+			return common.BytesToAddress(seiAddr), true
+		}
+		return common.Address{}, false
+	}
+	for _, p := range bankStore.GetChangedPairs(banktypes.BalancesPrefix) {
+		if len(p.Key) < 2 || len(p.Key) < int(p.Key[1])+2 {
+			app.Logger().Error(fmt.Sprintf("invalid changed balance key: %X", p.Key))
+			continue
+		}
+		addrLen := int(p.Key[1])
+		if string(p.Key[addrLen+2:]) != sdk.MustGetBaseDenom() {
+			continue
+		}
+		if addr, ok := evmAddress(p.Key[2 : addrLen+2]); ok {
+			touch(addr)
+		}
+	}
+	for _, p := range bankStore.GetChangedPairs(banktypes.WeiBalancesPrefix) {
+		if len(p.Key) < 1 {
+			app.Logger().Error(fmt.Sprintf("invalid changed wei balance key: %X", p.Key))
+			continue
+		}
+		if addr, ok := evmAddress(p.Key[1:]); ok {
+			touch(addr)
+		}
+	}
+	if err := app.EvmKeeper.SetModifiedAccounts(height, modified); err != nil {
+		app.Logger().Error(fmt.Sprintf("failed to index state diff of block %d: %s", height, err))
+	}
+}
@@ -0,0 +1,46 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	app "github.com/sei-protocol/sei-chain/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexStateDiff(t *testing.T) {
+	testWrapper := app.NewTestWrapperWithSc(t, time.Now().UTC(), secp256k1.GenPrivKey().PubKey(), false)
+	a, ctx := testWrapper.App, testWrapper.Ctx
+	defer safeClose(a)
+	a.EvmKeeper.SetStateDiffIndexEnabled(true)
+	defer a.EvmKeeper.SetStateDiffIndexEnabled(false)
+
+	contract, eoa := common.HexToAddress("0xd1ff10"), common.HexToAddress("0xd1ff11")
+	associatedSei, associatedEvm := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), common.HexToAddress("0xd1ff12")
+	a.EvmKeeper.SetAddressMapping(ctx, associatedSei, associatedEvm)
+	a.EvmKeeper.SetState(ctx, contract, common.HexToHash("0x1"), common.HexToHash("0x1"))
+	a.SetDeliverStateToCommit()
+	a.WriteState()
+	a.GetWorkingHash() // flush to sc
+
+	a.EvmKeeper.SetState(ctx, contract, common.HexToHash("0x2"), common.HexToHash("0x2"))
+	a.EvmKeeper.SetNonce(ctx, eoa, 1)
+	require.Nil(t, a.BankKeeper.AddCoins(ctx, associatedSei, sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(1))), false))
+	a.SetDeliverStateToCommit()
+	a.IndexStateDiff(a.WriteState(), 5)
+
+	modified := map[common.Address][]common.Hash{}
+	ok, err := a.EvmKeeper.IterateModifiedAccounts(5, 5, func(_ uint64, addr common.Address, slots []common.Hash) bool {
+		modified[addr] = slots
+		return true
+	})
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, []common.Hash{common.HexToHash("0x2")}, modified[contract])
+	require.Equal(t, []common.Hash{}, modified[eoa])
+	require.Equal(t, []common.Hash{}, modified[associatedEvm])
+	require.NotContains(t, modified, common.BytesToAddress(associatedSei))
+}
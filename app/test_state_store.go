@@ -29,40 +29,27 @@ func (s *InMemoryStateStore) Get(storeKey string, version int64, key []byte) ([]
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	store, ok := s.data[storeKey]
-	if !ok {
-		return nil, errors.New("not found")
-	}
-
-	versionData, ok := store[version]
-	if !ok {
-		return nil, errors.New("not found")
+	if version < s.earliestVersion {
+		return nil, errors.New("version pruned")
 	}
-
-	value, ok := versionData[string(key)]
-	if !ok {
-		return nil, errors.New("not found")
+	// like the real state store, return the value as of the latest version at or below
+	// version, and nil if there is none
+	found := int64(-1)
+	var value []byte
+	for v, versionData := range s.data[storeKey] {
+		if v > version || v <= found {
+			continue
+		}
+		if val, ok := versionData[string(key)]; ok {
+			found, value = v, val
+		}
 	}
-
 	return value, nil
 }
 
 func (s *InMemoryStateStore) Has(storeKey string, version int64, key []byte) (bool, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	store, ok := s.data[storeKey]
-	if !ok {
-		return false, nil
-	}
-
-	versionData, ok := store[version]
-	if !ok {
-		return false, nil
-	}
-
-	_, ok = versionData[string(key)]
-	return ok, nil
+	value, _ := s.Get(storeKey, version, key)
+	return value != nil, nil
 }
 
 func (s *InMemoryStateStore) Iterator(storeKey string, version int64, start, end []byte) (types.DBIterator, error) {
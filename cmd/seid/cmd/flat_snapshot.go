@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/sei-protocol/sei-chain/app/params"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-db/common/logger"
+	ssconfig "github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/ss"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagFlatSnapshotHeight        = "height"
+	flagFlatSnapshotMaxMismatches = "max-mismatches"
+)
+
+func VerifyFlatSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-flat-snapshot",
+		Short: "Verify the flat snapshot of EVM state against the state store",
+		Long: `Verify the flat snapshot of EVM state kept in the receipt store against the state store
+at a height, reporting every key whose value differs. The node must be stopped while the
+command runs, and the state store must be enabled.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			params.SetTendermintConfigs(config)
+			config.SetRoot(clientCtx.HomeDir)
+			height, err := cmd.Flags().GetUint64(flagFlatSnapshotHeight)
+			if err != nil {
+				return err
+			}
+			maxMismatches, err := cmd.Flags().GetInt(flagFlatSnapshotMaxMismatches)
+			if err != nil {
+				return err
+			}
+
+			receiptStorePath := filepath.Join(config.RootDir, "data", "receipt.db")
+			receiptConfig := ssconfig.DefaultStateStoreConfig()
+			receiptConfig.DBDirectory = receiptStorePath
+			receiptConfig.KeepLastVersion = false
+			receiptConfig.KeepRecent = 0
+			receiptStore, err := ss.NewStateStore(logger.NewNopLogger(), receiptStorePath, receiptConfig)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = receiptStore.Close() }()
+			stateConfig := ssconfig.DefaultStateStoreConfig()
+			stateConfig.Enable = true
+			stateConfig.KeepRecent = 0
+			stateStore, err := ss.NewStateStore(logger.NewNopLogger(), config.RootDir, stateConfig)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = stateStore.Close() }()
+
+			if height == 0 {
+				if _, height, err = evmkeeper.GetFlatSnapshotStatus(receiptStore); err != nil {
+					return err
+				}
+			}
+			base, ok, err := evmkeeper.FlatSnapshotBaseLayer(receiptStore, height)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("the flat snapshot cannot serve height %d", height)
+			}
+			fmt.Printf("verifying the flat snapshot at height %d from the base layer at height %d\n", height, base)
+
+			start := time.Now()
+			mismatches := 0
+			checked, err := evmkeeper.VerifyFlatSnapshot(receiptStore, stateStore, height, func(store byte, key []byte, expected []byte, actual []byte) {
+				mismatches++
+				if maxMismatches == 0 || mismatches <= maxMismatches {
+					fmt.Printf("mismatch in store %d for key %X: expected %X, got %X\n", store, key, expected, actual)
+				}
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("checked %d keys in %f seconds\n", checked, time.Since(start).Seconds())
+			if mismatches > 0 {
+				return fmt.Errorf("found %d mismatches", mismatches)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().Uint64(flagFlatSnapshotHeight, 0, "height to verify the flat snapshot at (0 means the latest height of the snapshot)")
+	cmd.Flags().Int(flagFlatSnapshotMaxMismatches, 100, "maximum number of mismatches to print (0 means no limit)")
+
+	return cmd
+}
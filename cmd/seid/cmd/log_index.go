@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/tools/receipts"
+	"github.com/sei-protocol/sei-db/common/logger"
+	ssconfig "github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/ss"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagLogIndexStartHeight = "start-height"
+	flagLogIndexEndHeight   = "end-height"
+	flagLogIndexBatchSize   = "batch-size"
+	flagTxAddressIndex      = "tx-address-index"
+)
+
+func BackfillLogIndexCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill-log-index",
+		Short: "Build the EVM log index for receipts that are already in the receipt store",
+		Long: `Build the EVM log index for receipts that are already in the receipt store. The node
+must be stopped while the command runs. Only receipts in the receipt store are indexed; receipts
+that still live in the legacy application store are skipped. With --tx-address-index, the
+transaction address index used by the ots namespace is built as well.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			params.SetTendermintConfigs(config)
+			config.SetRoot(clientCtx.HomeDir)
+			startHeight, err := cmd.Flags().GetUint64(flagLogIndexStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetUint64(flagLogIndexEndHeight)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagLogIndexBatchSize)
+			if err != nil {
+				return err
+			}
+			txAddressIndex, err := cmd.Flags().GetBool(flagTxAddressIndex)
+			if err != nil {
+				return err
+			}
+
+			receiptStorePath := filepath.Join(config.RootDir, "data", "receipt.db")
+			ssConfig := ssconfig.DefaultStateStoreConfig()
+			ssConfig.DBDirectory = receiptStorePath
+			ssConfig.KeepLastVersion = false
+			// the command must not prune the store while it runs
+			ssConfig.KeepRecent = 0
+			receiptStore, err := ss.NewStateStore(logger.NewNopLogger(), receiptStorePath, ssConfig)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = receiptStore.Close() }()
+
+			start := time.Now()
+			numReceipts, indexed, err := receipts.Backfill(receiptStore, startHeight, endHeight, receipts.BackfillOptions{
+				BatchSize:      batchSize,
+				TxAddressIndex: txAddressIndex,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("wrote %d index entries from %d receipts in %f seconds\n", indexed, numReceipts, time.Since(start).Seconds())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().Uint64(flagLogIndexStartHeight, 0, "lowest block height whose receipts should be indexed")
+	cmd.Flags().Uint64(flagLogIndexEndHeight, 0, "highest block height whose receipts should be indexed (0 means no upper bound)")
+	cmd.Flags().Int(flagLogIndexBatchSize, 10000, "number of index entries to write per batch")
+	cmd.Flags().Bool(flagTxAddressIndex, false, "also build the transaction address index")
+
+	return cmd
+}
@@ -139,6 +139,8 @@ func initRootCmd(
 		config.Cmd(),
 		pruning.PruningCmd(newApp),
 		CompactCmd(app.DefaultNodeHome),
+		BackfillLogIndexCmd(app.DefaultNodeHome),
+		VerifyFlatSnapshotCmd(app.DefaultNodeHome),
 		tools.ToolCmd(),
 	)
 
@@ -549,9 +551,90 @@ max_log_no_block = {{ .EVM.MaxLogNoBlock }}
 # max number of blocks to query logs for
 max_blocks_for_log = {{ .EVM.MaxBlocksForLog }}
 
+# max number of blocks trace_filter may replay in one call
+max_trace_filter_blocks = {{ .EVM.MaxTraceFilterBlocks }}
+
 # max number of concurrent NewHead subscriptions
 max_subscriptions_new_head = {{ .EVM.MaxSubscriptionsNewHead }}
 
+# max number of concurrent NewPendingTransactions subscriptions
+max_subscriptions_new_pending_txs = {{ .EVM.MaxSubscriptionsNewPendingTxs }}
+
+# max number of concurrent Syncing subscriptions
+max_subscriptions_syncing = {{ .EVM.MaxSubscriptionsSyncing }}
+
+# whether to maintain a persistent log index in the receipt store to serve wide-range log queries
+enable_log_index = {{ .EVM.EnableLogIndex }}
+
+# cost units a single IP may spend per second, 0 disables per-IP rate limiting
+rate_limit_per_ip = {{ .EVM.RateLimitPerIP }}
+
+# max cost units a single IP may spend at once, defaults to rate_limit_per_ip and must cover the highest method cost
+rate_limit_per_ip_burst = {{ .EVM.RateLimitPerIPBurst }}
+
+# cost units a single JWT subject may spend per second, 0 limits JWT authenticated requests per IP instead
+rate_limit_per_jwt_subject = {{ .EVM.RateLimitPerJWTSubject }}
+
+# max cost units a single JWT subject may spend at once, defaults to rate_limit_per_jwt_subject and must cover the highest method cost
+rate_limit_per_jwt_subject_burst = {{ .EVM.RateLimitPerJWTSubjectBurst }}
+
+# cost of each method as "method=cost", a trailing * matches a method prefix; unlisted methods cost 1
+rate_limit_method_costs = [{{ range $i, $v := .EVM.RateLimitMethodCosts }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# max number of tracing requests served at the same time, 0 means unlimited
+max_concurrent_trace_calls = {{ .EVM.MaxConcurrentTraceCalls }}
+
+# max number of call/estimateGas/simulate requests served at the same time, 0 means unlimited
+max_concurrent_simulation_calls = {{ .EVM.MaxConcurrentSimulationCalls }}
+
+# max number of storage slots of an account loaded to build its storage trie for eth_getProof in the mpt format
+max_storage_slots_for_proof = {{ .EVM.MaxStorageSlotsForProof }}
+
+# max number of historical block, receipt, transaction and trace results cached by the RPC servers, 0 disables the cache
+response_cache_size = {{ .EVM.ResponseCacheSize }}
+
+# whether to index every transaction by its sender, recipient and created contract in the receipt store, required by the ots_ search methods
+enable_tx_address_index = {{ .EVM.EnableTxAddressIndex }}
+
+# signer used by eth_sendTransaction, eth_signTransaction and eth_sign, either "keyring" or "external"
+signer_backend = "{{ .EVM.SignerBackend }}"
+
+# keyring backend the "keyring" signer loads keys from, one of "test", "file" or "os"
+signer_keyring_backend = "{{ .EVM.SignerKeyringBackend }}"
+
+# HTTP URL or IPC path of the Clef-compatible signer used by the "external" signer
+signer_external_endpoint = "{{ .EVM.SignerExternalEndpoint }}"
+
+# whether eth_gasPrice and eth_maxPriorityFeePerGas use the gas price oracle, which blends recent block and mempool priority fees
+enable_gas_price_oracle = {{ .EVM.EnableGasPriceOracle }}
+
+# number of recent blocks the gas price oracle samples
+gas_price_oracle_blocks = {{ .EVM.GasPriceOracleBlocks }}
+
+# gas weighted percentile of sampled priority fees the gas price oracle suggests
+gas_price_oracle_percentile = {{ .EVM.GasPriceOraclePercentile }}
+
+# weight in percent of pending tx priority fees in the gas price oracle suggestion, the rest going to recent blocks
+gas_price_oracle_mempool_weight = {{ .EVM.GasPriceOracleMempoolWeight }}
+
+# whether to index the EVM transactions, Cosmos transactions and synthetic events of every account in the receipt store, required by sei_getAccountHistory
+enable_account_history_index = {{ .EVM.EnableAccountHistoryIndex }}
+
+# whether to index the accounts and storage slots modified by every block in the receipt store, required by debug_getModifiedAccountsByNumber/ByHash and debug_getStateDiffByNumber
+enable_state_diff_index = {{ .EVM.EnableStateDiffIndex }}
+
+# number of most recent blocks whose receipts are kept in the receipt store and pruned in the background, 0 to keep them forever (archive nodes), -1 to follow min-retain-blocks
+receipt_keep_recent = {{ .EVM.ReceiptKeepRecent }}
+
+# interval in seconds between two runs of the receipt store pruner
+receipt_prune_interval_seconds = {{ .EVM.ReceiptPruneIntervalSeconds }}
+
+# whether to keep a flat snapshot of EVM state in the receipt store to serve state queries and eth_call at past heights, generating a base layer in the background every flat_snapshot_base_layer_interval blocks
+enable_flat_snapshot = {{ .EVM.EnableFlatSnapshot }}
+
+# number of blocks between two base layers of the flat snapshot, which should be lower than the receipt retention so that pruning keeps a base layer
+flat_snapshot_base_layer_interval = {{ .EVM.FlatSnapshotBaseLayerInterval }}
+
 [eth_replay]
 eth_replay_enabled = {{ .ETHReplay.Enabled }}
 eth_rpc = "{{ .ETHReplay.EthRPC }}"
@@ -0,0 +1,165 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+const (
+	DefaultAccountHistoryLimit = 100
+	MaxAccountHistoryLimit     = 1000
+)
+
+var ErrAccountHistoryIndexDisabled = errors.New("account history index is disabled, set enable_account_history_index to use this method")
+
+type AccountHistoryItem struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Type             string         `json:"type"`
+	Hash             common.Hash    `json:"hash"`
+}
+
+type AccountHistoryResult struct {
+	EVMAddress common.Address        `json:"evmAddress"`
+	SeiAddress string                `json:"seiAddress"`
+	Items      []*AccountHistoryItem `json:"items"`
+	// NextCursor is empty once the oldest transaction has been returned
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// GetAccountHistory returns the EVM transactions, Cosmos transactions and synthetic
+// events involving an account, newest first. The account can be given by its EVM or
+// Sei address, and the history of both is merged. Pass the returned cursor to get the
+// next page.
+func (t *AssociationAPI) GetAccountHistory(_ context.Context, address string, cursor *string, limit *uint) (result *AccountHistoryResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("sei_getAccountHistory", t.connectionType, startTime, returnErr == nil)
+	if !t.keeper.AccountHistoryIndexEnabled() {
+		return nil, ErrAccountHistoryIndexDisabled
+	}
+	n := DefaultAccountHistoryLimit
+	if limit != nil {
+		if *limit == 0 || *limit > MaxAccountHistoryLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", MaxAccountHistoryLimit)
+		}
+		n = int(*limit)
+	}
+	var before *keeper.AccountHistoryEntry
+	if cursor != nil && *cursor != "" {
+		var err error
+		if before, err = decodeAccountHistoryCursor(*cursor); err != nil {
+			return nil, err
+		}
+	}
+	ctx := t.ctxProvider(LatestCtxHeight)
+	evmAddr, seiAddr, err := t.resolveAccount(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := map[[12]byte]keeper.AccountHistoryEntry{}
+	prefixes := [][]byte{evmAddr[:]}
+	if !seiAddr.Equals(sdk.AccAddress(evmAddr[:])) {
+		prefixes = append(prefixes, seiAddr)
+	}
+	for _, addr := range prefixes {
+		// one more position than requested is collected to tell whether there is a next page
+		positions := map[[12]byte]struct{}{}
+		if _, err := t.keeper.IterateAccountHistory(ctx, addr, before, func(entry keeper.AccountHistoryEntry) bool {
+			pos := accountHistoryPosition(entry)
+			positions[pos] = struct{}{}
+			if existing, ok := entries[pos]; !ok || entry.Kind < existing.Kind {
+				entries[pos] = entry
+			}
+			return len(positions) <= n
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sorted := make([]keeper.AccountHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, entry)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Height != sorted[j].Height {
+			return sorted[i].Height > sorted[j].Height
+		}
+		return sorted[i].TxIndex > sorted[j].TxIndex
+	})
+
+	result = &AccountHistoryResult{EVMAddress: evmAddr, SeiAddress: seiAddr.String(), Items: []*AccountHistoryItem{}}
+	for i, entry := range sorted {
+		if i == n {
+			result.NextCursor = encodeAccountHistoryCursor(sorted[n-1])
+			break
+		}
+		result.Items = append(result.Items, &AccountHistoryItem{
+			BlockNumber:      hexutil.Uint64(entry.Height),
+			TransactionIndex: hexutil.Uint64(entry.TxIndex),
+			Type:             accountHistoryKindName(entry.Kind),
+			Hash:             entry.TxHash,
+		})
+	}
+	return result, nil
+}
+
+// resolveAccount returns the EVM and Sei addresses of an account given by either. The
+// direct cast is used for unassociated accounts.
+func (t *AssociationAPI) resolveAccount(ctx sdk.Context, address string) (common.Address, sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		evmAddr := common.HexToAddress(address)
+		return evmAddr, t.keeper.GetSeiAddressOrDefault(ctx, evmAddr), nil
+	}
+	if !strings.HasPrefix(address, sdk.GetConfig().GetBech32AccountAddrPrefix()) {
+		return common.Address{}, nil, fmt.Errorf("invalid address %s", address)
+	}
+	seiAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return t.keeper.GetEVMAddressOrDefault(ctx, seiAddr), seiAddr, nil
+}
+
+func accountHistoryPosition(entry keeper.AccountHistoryEntry) [12]byte {
+	var pos [12]byte
+	binary.BigEndian.PutUint64(pos[:8], entry.Height)
+	binary.BigEndian.PutUint32(pos[8:], entry.TxIndex)
+	return pos
+}
+
+func encodeAccountHistoryCursor(entry keeper.AccountHistoryEntry) string {
+	pos := accountHistoryPosition(entry)
+	return hexutil.Encode(pos[:])
+}
+
+func decodeAccountHistoryCursor(cursor string) (*keeper.AccountHistoryEntry, error) {
+	bz, err := hexutil.Decode(cursor)
+	if err != nil || len(bz) != 12 {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	return &keeper.AccountHistoryEntry{Height: binary.BigEndian.Uint64(bz[:8]), TxIndex: binary.BigEndian.Uint32(bz[8:])}, nil
+}
+
+func accountHistoryKindName(kind byte) string {
+	switch kind {
+	case types.AccountHistoryEVMKind:
+		return "evm"
+	case types.AccountHistoryCosmosKind:
+		return "cosmos"
+	case types.AccountHistorySyntheticKind:
+		return "synthetic"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,60 @@
+package evmrpc_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountHistoryIndexDisabled(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "sei", "getAccountHistory", "0x1234567890123456789012345678901234567890", nil, 10)
+	require.Equal(t, evmrpc.ErrAccountHistoryIndexDisabled.Error(), resObj["error"].(map[string]interface{})["message"])
+}
+
+func TestGetAccountHistory(t *testing.T) {
+	EVMKeeper.SetAccountHistoryIndexEnabled(true)
+	defer EVMKeeper.SetAccountHistoryIndexEnabled(false)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	EVMKeeper.SetAddressMapping(Ctx, seiAddr, evmAddr)
+	cosmosHash, evmHash := common.HexToHash("0xacc01"), common.HexToHash("0xacc02")
+	EVMKeeper.SetTransientAccountHistory(Ctx.WithBlockHeight(3).WithTxIndex(1), []sdk.AccAddress{seiAddr}, cosmosHash)
+	require.Nil(t, EVMKeeper.MockReceipt(Ctx, evmHash, &types.Receipt{
+		TxHashHex:        evmHash.Hex(),
+		BlockNumber:      5,
+		TransactionIndex: 2,
+		From:             evmAddr.Hex(),
+	}))
+
+	for _, addr := range []string{evmAddr.Hex(), seiAddr.String()} {
+		resObj := sendRequestGoodWithNamespace(t, "sei", "getAccountHistory", addr, nil, nil)
+		result := resObj["result"].(map[string]interface{})
+		require.Equal(t, strings.ToLower(evmAddr.Hex()), result["evmAddress"])
+		require.Equal(t, seiAddr.String(), result["seiAddress"])
+		items := result["items"].([]interface{})
+		require.Len(t, items, 2)
+		require.Equal(t, map[string]interface{}{"blockNumber": "0x5", "transactionIndex": "0x2", "type": "evm", "hash": evmHash.Hex()}, items[0])
+		require.Equal(t, map[string]interface{}{"blockNumber": "0x3", "transactionIndex": "0x1", "type": "cosmos", "hash": cosmosHash.Hex()}, items[1])
+		require.Nil(t, result["nextCursor"])
+	}
+
+	// paginate one entry at a time
+	resObj := sendRequestGoodWithNamespace(t, "sei", "getAccountHistory", evmAddr.Hex(), nil, 1)
+	result := resObj["result"].(map[string]interface{})
+	require.Len(t, result["items"], 1)
+	require.Equal(t, "0x000000000000000500000002", result["nextCursor"])
+	resObj = sendRequestGoodWithNamespace(t, "sei", "getAccountHistory", evmAddr.Hex(), result["nextCursor"], 1)
+	result = resObj["result"].(map[string]interface{})
+	items := result["items"].([]interface{})
+	require.Len(t, items, 1)
+	require.Equal(t, cosmosHash.Hex(), items[0].(map[string]interface{})["hash"])
+	require.Nil(t, result["nextCursor"])
+
+	resObj = sendRequestGoodWithNamespace(t, "sei", "getAccountHistory", evmAddr.Hex(), "0x01", 1)
+	require.Equal(t, "invalid cursor 0x01", resObj["error"].(map[string]interface{})["message"])
+}
@@ -40,6 +40,7 @@ type BlockAPI struct {
 	namespace            string
 	includeShellReceipts bool
 	includeBankTransfers bool
+	cache                *ResponseCache
 }
 
 type SeiBlockAPI struct {
@@ -47,7 +48,7 @@ type SeiBlockAPI struct {
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error)
 }
 
-func NewBlockAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, connectionType ConnectionType) *BlockAPI {
+func NewBlockAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, connectionType ConnectionType, cache *ResponseCache) *BlockAPI {
 	return &BlockAPI{
 		tmClient:             tmClient,
 		keeper:               k,
@@ -57,6 +58,7 @@ func NewBlockAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(i
 		includeShellReceipts: false,
 		includeBankTransfers: false,
 		namespace:            "eth",
+		cache:                cache,
 	}
 }
 
@@ -67,6 +69,7 @@ func NewSeiBlockAPI(
 	txConfig client.TxConfig,
 	connectionType ConnectionType,
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
+	cache *ResponseCache,
 ) *SeiBlockAPI {
 	blockAPI := &BlockAPI{
 		tmClient:             tmClient,
@@ -77,6 +80,7 @@ func NewSeiBlockAPI(
 		includeShellReceipts: true,
 		includeBankTransfers: false,
 		namespace:            "sei",
+		cache:                cache,
 	}
 	return &SeiBlockAPI{
 		BlockAPI:  blockAPI,
@@ -91,8 +95,9 @@ func NewSei2BlockAPI(
 	txConfig client.TxConfig,
 	connectionType ConnectionType,
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
+	cache *ResponseCache,
 ) *SeiBlockAPI {
-	blockAPI := NewSeiBlockAPI(tmClient, k, ctxProvider, txConfig, connectionType, isPanicTx)
+	blockAPI := NewSeiBlockAPI(tmClient, k, ctxProvider, txConfig, connectionType, isPanicTx, cache)
 	blockAPI.namespace = "sei2"
 	blockAPI.includeBankTransfers = true
 	return blockAPI
@@ -140,16 +145,13 @@ func (a *BlockAPI) GetBlockByHash(ctx context.Context, blockHash common.Hash, fu
 func (a *BlockAPI) getBlockByHash(ctx context.Context, blockHash common.Hash, fullTx bool, includeSyntheticTxs bool, isPanicTx func(ctx context.Context, hash common.Hash) (bool, error)) (result map[string]interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics(fmt.Sprintf("%s_getBlockByHash", a.namespace), a.connectionType, startTime, returnErr == nil)
-	block, err := blockByHashWithRetry(ctx, a.tmClient, blockHash[:], 1)
-	if err != nil {
-		return nil, err
-	}
-	blockRes, err := blockResultsWithRetry(ctx, a.tmClient, &block.Block.Height)
-	if err != nil {
-		return nil, err
-	}
-	blockBloom := a.keeper.GetBlockBloom(a.ctxProvider(block.Block.Height))
-	return EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeBankTransfers, includeSyntheticTxs, isPanicTx)
+	return cached(a.cache, fmt.Sprintf("%s_getBlockByHash", a.namespace), func() (map[string]interface{}, int64, error) {
+		block, err := blockByHashWithRetry(ctx, a.tmClient, blockHash[:], 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		return a.encodeBlock(ctx, block, fullTx, includeSyntheticTxs, isPanicTx)
+	}, blockHash, fullTx, includeSyntheticTxs, isPanicTx != nil)
 }
 
 func (a *BlockAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (result map[string]interface{}, returnErr error) {
@@ -196,16 +198,29 @@ func (a *BlockAPI) getBlockByNumber(
 	if err != nil {
 		return nil, err
 	}
-	block, err := blockByNumberWithRetry(ctx, a.tmClient, numberPtr, 1)
-	if err != nil {
-		return nil, err
-	}
+	return cached(a.cache.forHeight(numberPtr), fmt.Sprintf("%s_getBlockByNumber", a.namespace), func() (map[string]interface{}, int64, error) {
+		block, err := blockByNumberWithRetry(ctx, a.tmClient, numberPtr, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		return a.encodeBlock(ctx, block, fullTx, includeSyntheticTxs, isPanicTx)
+	}, numberPtr, fullTx, includeSyntheticTxs, isPanicTx != nil)
+}
+
+func (a *BlockAPI) encodeBlock(
+	ctx context.Context,
+	block *coretypes.ResultBlock,
+	fullTx bool,
+	includeSyntheticTxs bool,
+	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
+) (map[string]interface{}, int64, error) {
 	blockRes, err := blockResultsWithRetry(ctx, a.tmClient, &block.Block.Height)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	blockBloom := a.keeper.GetBlockBloom(a.ctxProvider(block.Block.Height))
-	return EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeBankTransfers, includeSyntheticTxs, isPanicTx)
+	res, err := EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeBankTransfers, includeSyntheticTxs, isPanicTx)
+	return res, block.Block.Height, err
 }
 
 func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []map[string]interface{}, returnErr error) {
@@ -216,10 +231,15 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 	if err != nil {
 		return nil, err
 	}
+	return cached(a.cache.forHeight(heightPtr), fmt.Sprintf("%s_getBlockReceipts", a.namespace), func() ([]map[string]interface{}, int64, error) {
+		return a.getBlockReceipts(ctx, heightPtr)
+	}, heightPtr)
+}
 
+func (a *BlockAPI) getBlockReceipts(ctx context.Context, heightPtr *int64) ([]map[string]interface{}, int64, error) {
 	block, err := blockByNumberWithRetry(ctx, a.tmClient, heightPtr, 1)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Get all tx hashes for the block
@@ -228,6 +248,7 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 	// Get tx receipts for all hashes in parallel
 	wg := sync.WaitGroup{}
 	mtx := sync.Mutex{}
+	var returnErr error
 	allReceipts := make([]map[string]interface{}, len(txHashes))
 	sdkCtx := a.ctxProvider(LatestCtxHeight)
 	signer := ethtypes.MakeSigner(
@@ -278,9 +299,9 @@ func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.Block
 		}
 	}
 	if returnErr != nil {
-		return nil, returnErr
+		return nil, 0, returnErr
 	}
-	return compactReceipts, nil
+	return compactReceipts, height, nil
 }
 
 func EncodeTmBlock(
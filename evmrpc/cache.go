@@ -0,0 +1,101 @@
+package evmrpc
+
+import (
+	"encoding/json"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/sei-protocol/sei-chain/utils/metrics"
+)
+
+// ResponseCache caches the results of RPC methods that only depend on committed data,
+// such as blocks, receipts and traces. Only results at heights below the latest height are
+// cached, since those can no longer change. A nil *ResponseCache is valid and caches nothing.
+type ResponseCache struct {
+	cache       *lru.Cache[string, interface{}]
+	ctxProvider func(int64) sdk.Context
+}
+
+// NewResponseCache returns a cache holding up to size results, or nil if size is not
+// positive.
+func NewResponseCache(size int, ctxProvider func(int64) sdk.Context) *ResponseCache {
+	if size <= 0 {
+		return nil
+	}
+	cache, err := lru.New[string, interface{}](size)
+	if err != nil {
+		panic(err)
+	}
+	return &ResponseCache{cache: cache, ctxProvider: ctxProvider}
+}
+
+// responseCacheKey builds the key of a result from the method name and the JSON encoding
+// of its parameters, so that equivalent parameters map to the same entry.
+func responseCacheKey(method string, params ...interface{}) (string, bool) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	return method + string(bz), true
+}
+
+// cached returns the cached result of method with the given parameters, or calls fn and
+// caches its result if fn succeeds with a non-nil result at a height below the latest
+// height. fn returns the height of its result. Cached results are shared between callers
+// and must not be modified.
+func cached[T any](c *ResponseCache, method string, fn func() (T, int64, error), params ...interface{}) (T, error) {
+	if c == nil {
+		res, _, err := fn()
+		return res, err
+	}
+	key, ok := responseCacheKey(method, params...)
+	if !ok {
+		res, _, err := fn()
+		return res, err
+	}
+	if res, ok := c.cache.Get(key); ok {
+		if typed, ok := res.(T); ok {
+			metrics.IncrementRpcResponseCacheCounter(method, true)
+			return typed, nil
+		}
+	}
+	metrics.IncrementRpcResponseCacheCounter(method, false)
+	res, height, err := fn()
+	if err != nil || isNilResult(res) {
+		return res, err
+	}
+	if height > 0 && height < c.ctxProvider(LatestCtxHeight).BlockHeight() {
+		c.cache.Add(key, res)
+	}
+	return res, nil
+}
+
+// forHeight disables caching for lookups of the latest block, which are requested with a
+// nil height.
+func (c *ResponseCache) forHeight(height *int64) *ResponseCache {
+	if height == nil {
+		return nil
+	}
+	return c
+}
+
+func isNilResult(res interface{}) bool {
+	if res == nil {
+		return true
+	}
+	v := reflect.ValueOf(res)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// Len returns the number of cached results.
+func (c *ResponseCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
@@ -0,0 +1,44 @@
+package evmrpc_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCache(t *testing.T) {
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	cache := evmrpc.NewResponseCache(10, ctxProvider)
+	api := evmrpc.NewBlockAPI(&MockClient{}, EVMKeeper, ctxProvider, TxConfig, evmrpc.ConnectionTypeHTTP, cache)
+
+	// historical blocks are cached
+	res, err := api.GetBlockByNumber(context.Background(), rpc.BlockNumber(MockHeight2), false)
+	require.Nil(t, err)
+	require.Equal(t, 1, cache.Len())
+	cachedRes, err := api.GetBlockByNumber(context.Background(), rpc.BlockNumber(MockHeight2), false)
+	require.Nil(t, err)
+	require.Equal(t, res, cachedRes)
+	require.Equal(t, 1, cache.Len())
+
+	// different params are cached separately
+	_, err = api.GetBlockByNumber(context.Background(), rpc.BlockNumber(MockHeight2), true)
+	require.Nil(t, err)
+	require.Equal(t, 2, cache.Len())
+
+	// the latest block is not cached
+	_, err = api.GetBlockByNumber(context.Background(), rpc.LatestBlockNumber, false)
+	require.Nil(t, err)
+	_, err = api.GetBlockByNumber(context.Background(), rpc.BlockNumber(Ctx.BlockHeight()), false)
+	require.Nil(t, err)
+	require.Equal(t, 2, cache.Len())
+}
+
+func TestResponseCacheDisabled(t *testing.T) {
+	cache := evmrpc.NewResponseCache(0, func(int64) sdk.Context { return Ctx })
+	require.Nil(t, cache)
+	require.Equal(t, 0, cache.Len())
+}
@@ -3,6 +3,7 @@ package evmrpc
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/cast"
@@ -80,59 +81,206 @@ type Config struct {
 	// max number of blocks to query logs for
 	MaxBlocksForLog int64 `mapstructure:"max_blocks_for_log"`
 
+	// max number of blocks trace_filter may replay in one call
+	MaxTraceFilterBlocks int64 `mapstructure:"max_trace_filter_blocks"`
+
 	// max number of concurrent NewHead subscriptions
 	MaxSubscriptionsNewHead uint64 `mapstructure:"max_subscriptions_new_head"`
 
+	// max number of concurrent NewPendingTransactions subscriptions
+	MaxSubscriptionsNewPendingTxs uint64 `mapstructure:"max_subscriptions_new_pending_txs"`
+
+	// max number of concurrent Syncing subscriptions
+	MaxSubscriptionsSyncing uint64 `mapstructure:"max_subscriptions_syncing"`
+
+	// whether to maintain a persistent log index in the receipt store to serve wide-range log queries
+	EnableLogIndex bool `mapstructure:"enable_log_index"`
+
+	// cost units a single IP may spend per second, 0 disables per-IP rate limiting
+	RateLimitPerIP float64 `mapstructure:"rate_limit_per_ip"`
+
+	// max cost units a single IP may spend at once, defaults to rate_limit_per_ip and must cover the highest method cost
+	RateLimitPerIPBurst int `mapstructure:"rate_limit_per_ip_burst"`
+
+	// cost units a single JWT subject may spend per second, 0 limits JWT authenticated requests per IP instead
+	RateLimitPerJWTSubject float64 `mapstructure:"rate_limit_per_jwt_subject"`
+
+	// max cost units a single JWT subject may spend at once, defaults to rate_limit_per_jwt_subject and must cover the highest method cost
+	RateLimitPerJWTSubjectBurst int `mapstructure:"rate_limit_per_jwt_subject_burst"`
+
+	// cost of each method as "method=cost", a trailing * matches a method prefix; unlisted methods cost 1
+	RateLimitMethodCosts []string `mapstructure:"rate_limit_method_costs"`
+
+	// max number of tracing requests served at the same time, 0 means unlimited
+	MaxConcurrentTraceCalls int `mapstructure:"max_concurrent_trace_calls"`
+
+	// max number of call/estimateGas/simulate requests served at the same time, 0 means unlimited
+	MaxConcurrentSimulationCalls int `mapstructure:"max_concurrent_simulation_calls"`
+
+	// max number of storage slots of an account loaded to build its storage trie for eth_getProof in the mpt format
+	MaxStorageSlotsForProof int `mapstructure:"max_storage_slots_for_proof"`
+
+	// max number of historical block, receipt, transaction and trace results cached by the RPC servers, 0 disables the cache
+	ResponseCacheSize int `mapstructure:"response_cache_size"`
+
+	// whether to index every transaction by its sender, recipient and created contract in the receipt store, required by the ots_ search methods
+	EnableTxAddressIndex bool `mapstructure:"enable_tx_address_index"`
+
+	// signer used by eth_sendTransaction, eth_signTransaction and eth_sign, either "keyring" or "external"
+	SignerBackend string `mapstructure:"signer_backend"`
+
+	// keyring backend the "keyring" signer loads keys from, one of "test", "file" or "os"
+	SignerKeyringBackend string `mapstructure:"signer_keyring_backend"`
+
+	// HTTP URL or IPC path of the Clef-compatible signer used by the "external" signer
+	SignerExternalEndpoint string `mapstructure:"signer_external_endpoint"`
+
+	// whether eth_gasPrice and eth_maxPriorityFeePerGas use the gas price oracle, which blends recent block and mempool priority fees
+	EnableGasPriceOracle bool `mapstructure:"enable_gas_price_oracle"`
+
+	// number of recent blocks the gas price oracle samples
+	GasPriceOracleBlocks int64 `mapstructure:"gas_price_oracle_blocks"`
+
+	// gas weighted percentile of sampled priority fees the gas price oracle suggests
+	GasPriceOraclePercentile float64 `mapstructure:"gas_price_oracle_percentile"`
+
+	// weight in percent of pending tx priority fees in the gas price oracle suggestion, the rest going to recent blocks
+	GasPriceOracleMempoolWeight int64 `mapstructure:"gas_price_oracle_mempool_weight"`
+
+	// whether to index the EVM transactions, Cosmos transactions and synthetic events of every account in the receipt store, required by sei_getAccountHistory
+	EnableAccountHistoryIndex bool `mapstructure:"enable_account_history_index"`
+
+	// whether to index the accounts and storage slots modified by every block in the receipt store, required by debug_getModifiedAccountsByNumber/ByHash and debug_getStateDiffByNumber
+	EnableStateDiffIndex bool `mapstructure:"enable_state_diff_index"`
+
+	// number of most recent blocks whose receipts are kept in the receipt store and pruned in the background, 0 to keep them forever (archive nodes), -1 to follow min-retain-blocks
+	ReceiptKeepRecent int `mapstructure:"receipt_keep_recent"`
+
+	// interval in seconds between two runs of the receipt store pruner
+	ReceiptPruneIntervalSeconds int `mapstructure:"receipt_prune_interval_seconds"`
+
+	// whether to keep a flat snapshot of EVM state in the receipt store to serve state queries and eth_call at past heights, generating a base layer in the background every flat_snapshot_base_layer_interval blocks
+	EnableFlatSnapshot bool `mapstructure:"enable_flat_snapshot"`
+
+	// number of blocks between two base layers of the flat snapshot, which should be lower than the receipt retention so that pruning keeps a base layer
+	FlatSnapshotBaseLayerInterval int64 `mapstructure:"flat_snapshot_base_layer_interval"`
+
 	// test api enables certain override apis for integration test situations
 	EnableTestAPI bool `mapstructure:"enable_test_api"`
 }
 
+// DefaultRateLimitMethodCosts weighs the methods that scan many blocks or re-execute
+// transactions more heavily than regular reads.
+var DefaultRateLimitMethodCosts = []string{
+	"eth_getLogs=10",
+	"eth_getFilterLogs=10",
+	"sei_getLogs=10",
+	"sei_getFilterLogs=10",
+	"debug_trace*=20",
+	"trace_*=20",
+	"sei_trace*=20",
+}
+
 var DefaultConfig = Config{
-	HTTPEnabled:             true,
-	HTTPPort:                8545,
-	WSEnabled:               true,
-	WSPort:                  8546,
-	ReadTimeout:             rpc.DefaultHTTPTimeouts.ReadTimeout,
-	ReadHeaderTimeout:       rpc.DefaultHTTPTimeouts.ReadHeaderTimeout,
-	WriteTimeout:            rpc.DefaultHTTPTimeouts.WriteTimeout,
-	IdleTimeout:             rpc.DefaultHTTPTimeouts.IdleTimeout,
-	SimulationGasLimit:      10_000_000, // 10M
-	SimulationEVMTimeout:    60 * time.Second,
-	CORSOrigins:             "*",
-	WSOrigins:               "*",
-	FilterTimeout:           120 * time.Second,
-	CheckTxTimeout:          5 * time.Second,
-	MaxTxPoolTxs:            1000,
-	Slow:                    false,
-	DenyList:                make([]string, 0),
-	MaxLogNoBlock:           10000,
-	MaxBlocksForLog:         2000,
-	MaxSubscriptionsNewHead: 10000,
-	EnableTestAPI:           false,
+	HTTPEnabled:                   true,
+	HTTPPort:                      8545,
+	WSEnabled:                     true,
+	WSPort:                        8546,
+	ReadTimeout:                   rpc.DefaultHTTPTimeouts.ReadTimeout,
+	ReadHeaderTimeout:             rpc.DefaultHTTPTimeouts.ReadHeaderTimeout,
+	WriteTimeout:                  rpc.DefaultHTTPTimeouts.WriteTimeout,
+	IdleTimeout:                   rpc.DefaultHTTPTimeouts.IdleTimeout,
+	SimulationGasLimit:            10_000_000, // 10M
+	SimulationEVMTimeout:          60 * time.Second,
+	CORSOrigins:                   "*",
+	WSOrigins:                     "*",
+	FilterTimeout:                 120 * time.Second,
+	CheckTxTimeout:                5 * time.Second,
+	MaxTxPoolTxs:                  1000,
+	Slow:                          false,
+	DenyList:                      make([]string, 0),
+	MaxLogNoBlock:                 10000,
+	MaxBlocksForLog:               2000,
+	MaxTraceFilterBlocks:          100,
+	MaxSubscriptionsNewHead:       10000,
+	MaxSubscriptionsNewPendingTxs: 10000,
+	MaxSubscriptionsSyncing:       10000,
+	EnableLogIndex:                false,
+	RateLimitPerIP:                0,
+	RateLimitPerIPBurst:           0,
+	RateLimitPerJWTSubject:        0,
+	RateLimitPerJWTSubjectBurst:   0,
+	RateLimitMethodCosts:          DefaultRateLimitMethodCosts,
+	MaxConcurrentTraceCalls:       0,
+	MaxConcurrentSimulationCalls:  0,
+	MaxStorageSlotsForProof:       1000,
+	ResponseCacheSize:             10000,
+	EnableTxAddressIndex:          false,
+	SignerBackend:                 SignerBackendKeyring,
+	SignerKeyringBackend:          keyring.BackendTest,
+	SignerExternalEndpoint:        "",
+	EnableGasPriceOracle:          false,
+	GasPriceOracleBlocks:          20,
+	GasPriceOraclePercentile:      60,
+	GasPriceOracleMempoolWeight:   50,
+	EnableAccountHistoryIndex:     false,
+	EnableStateDiffIndex:          false,
+	ReceiptKeepRecent:             -1,
+	ReceiptPruneIntervalSeconds:   600,
+	EnableFlatSnapshot:            false,
+	FlatSnapshotBaseLayerInterval: 50000,
+	EnableTestAPI:                 false,
 }
 
 const (
-	flagHTTPEnabled             = "evm.http_enabled"
-	flagHTTPPort                = "evm.http_port"
-	flagWSEnabled               = "evm.ws_enabled"
-	flagWSPort                  = "evm.ws_port"
-	flagReadTimeout             = "evm.read_timeout"
-	flagReadHeaderTimeout       = "evm.read_header_timeout"
-	flagWriteTimeout            = "evm.write_timeout"
-	flagIdleTimeout             = "evm.idle_timeout"
-	flagSimulationGasLimit      = "evm.simulation_gas_limit"
-	flagSimulationEVMTimeout    = "evm.simulation_evm_timeout"
-	flagCORSOrigins             = "evm.cors_origins"
-	flagWSOrigins               = "evm.ws_origins"
-	flagFilterTimeout           = "evm.filter_timeout"
-	flagMaxTxPoolTxs            = "evm.max_tx_pool_txs"
-	flagCheckTxTimeout          = "evm.checktx_timeout"
-	flagSlow                    = "evm.slow"
-	flagDenyList                = "evm.deny_list"
-	flagMaxLogNoBlock           = "evm.max_log_no_block"
-	flagMaxBlocksForLog         = "evm.max_blocks_for_log"
-	flagMaxSubscriptionsNewHead = "evm.max_subscriptions_new_head"
-	flagEnableTestAPI           = "evm.enable_test_api"
+	flagHTTPEnabled                   = "evm.http_enabled"
+	flagHTTPPort                      = "evm.http_port"
+	flagWSEnabled                     = "evm.ws_enabled"
+	flagWSPort                        = "evm.ws_port"
+	flagReadTimeout                   = "evm.read_timeout"
+	flagReadHeaderTimeout             = "evm.read_header_timeout"
+	flagWriteTimeout                  = "evm.write_timeout"
+	flagIdleTimeout                   = "evm.idle_timeout"
+	flagSimulationGasLimit            = "evm.simulation_gas_limit"
+	flagSimulationEVMTimeout          = "evm.simulation_evm_timeout"
+	flagCORSOrigins                   = "evm.cors_origins"
+	flagWSOrigins                     = "evm.ws_origins"
+	flagFilterTimeout                 = "evm.filter_timeout"
+	flagMaxTxPoolTxs                  = "evm.max_tx_pool_txs"
+	flagCheckTxTimeout                = "evm.checktx_timeout"
+	flagSlow                          = "evm.slow"
+	flagDenyList                      = "evm.deny_list"
+	flagMaxLogNoBlock                 = "evm.max_log_no_block"
+	flagMaxBlocksForLog               = "evm.max_blocks_for_log"
+	flagMaxTraceFilterBlocks          = "evm.max_trace_filter_blocks"
+	flagMaxSubscriptionsNewHead       = "evm.max_subscriptions_new_head"
+	flagMaxSubscriptionsNewPendingTxs = "evm.max_subscriptions_new_pending_txs"
+	flagMaxSubscriptionsSyncing       = "evm.max_subscriptions_syncing"
+	flagEnableLogIndex                = "evm.enable_log_index"
+	flagRateLimitPerIP                = "evm.rate_limit_per_ip"
+	flagRateLimitPerIPBurst           = "evm.rate_limit_per_ip_burst"
+	flagRateLimitPerJWTSubject        = "evm.rate_limit_per_jwt_subject"
+	flagRateLimitPerJWTSubjectBurst   = "evm.rate_limit_per_jwt_subject_burst"
+	flagRateLimitMethodCosts          = "evm.rate_limit_method_costs"
+	flagMaxConcurrentTraceCalls       = "evm.max_concurrent_trace_calls"
+	flagMaxConcurrentSimulationCalls  = "evm.max_concurrent_simulation_calls"
+	flagMaxStorageSlotsForProof       = "evm.max_storage_slots_for_proof"
+	flagResponseCacheSize             = "evm.response_cache_size"
+	flagEnableTxAddressIndex          = "evm.enable_tx_address_index"
+	flagSignerBackend                 = "evm.signer_backend"
+	flagSignerKeyringBackend          = "evm.signer_keyring_backend"
+	flagSignerExternalEndpoint        = "evm.signer_external_endpoint"
+	flagEnableGasPriceOracle          = "evm.enable_gas_price_oracle"
+	flagGasPriceOracleBlocks          = "evm.gas_price_oracle_blocks"
+	flagGasPriceOraclePercentile      = "evm.gas_price_oracle_percentile"
+	flagGasPriceOracleMempoolWeight   = "evm.gas_price_oracle_mempool_weight"
+	flagEnableAccountHistoryIndex     = "evm.enable_account_history_index"
+	flagEnableStateDiffIndex          = "evm.enable_state_diff_index"
+	flagReceiptKeepRecent             = "evm.receipt_keep_recent"
+	flagReceiptPruneIntervalSeconds   = "evm.receipt_prune_interval_seconds"
+	flagEnableFlatSnapshot            = "evm.enable_flat_snapshot"
+	flagFlatSnapshotBaseLayerInterval = "evm.flat_snapshot_base_layer_interval"
+	flagEnableTestAPI                 = "evm.enable_test_api"
 )
 
 func ReadConfig(opts servertypes.AppOptions) (Config, error) {
@@ -233,11 +381,146 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagMaxTraceFilterBlocks); v != nil {
+		if cfg.MaxTraceFilterBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagMaxSubscriptionsNewHead); v != nil {
 		if cfg.MaxSubscriptionsNewHead, err = cast.ToUint64E(v); err != nil {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagMaxSubscriptionsNewPendingTxs); v != nil {
+		if cfg.MaxSubscriptionsNewPendingTxs, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxSubscriptionsSyncing); v != nil {
+		if cfg.MaxSubscriptionsSyncing, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableLogIndex); v != nil {
+		if cfg.EnableLogIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitPerIP); v != nil {
+		if cfg.RateLimitPerIP, err = cast.ToFloat64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitPerIPBurst); v != nil {
+		if cfg.RateLimitPerIPBurst, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitPerJWTSubject); v != nil {
+		if cfg.RateLimitPerJWTSubject, err = cast.ToFloat64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitPerJWTSubjectBurst); v != nil {
+		if cfg.RateLimitPerJWTSubjectBurst, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimitMethodCosts); v != nil {
+		if cfg.RateLimitMethodCosts, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxConcurrentTraceCalls); v != nil {
+		if cfg.MaxConcurrentTraceCalls, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxConcurrentSimulationCalls); v != nil {
+		if cfg.MaxConcurrentSimulationCalls, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxStorageSlotsForProof); v != nil {
+		if cfg.MaxStorageSlotsForProof, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagResponseCacheSize); v != nil {
+		if cfg.ResponseCacheSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableTxAddressIndex); v != nil {
+		if cfg.EnableTxAddressIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagSignerBackend); v != nil {
+		if cfg.SignerBackend, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagSignerKeyringBackend); v != nil {
+		if cfg.SignerKeyringBackend, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagSignerExternalEndpoint); v != nil {
+		if cfg.SignerExternalEndpoint, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableGasPriceOracle); v != nil {
+		if cfg.EnableGasPriceOracle, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleBlocks); v != nil {
+		if cfg.GasPriceOracleBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOraclePercentile); v != nil {
+		if cfg.GasPriceOraclePercentile, err = cast.ToFloat64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleMempoolWeight); v != nil {
+		if cfg.GasPriceOracleMempoolWeight, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableAccountHistoryIndex); v != nil {
+		if cfg.EnableAccountHistoryIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableStateDiffIndex); v != nil {
+		if cfg.EnableStateDiffIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagReceiptKeepRecent); v != nil {
+		if cfg.ReceiptKeepRecent, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagReceiptPruneIntervalSeconds); v != nil {
+		if cfg.ReceiptPruneIntervalSeconds, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableFlatSnapshot); v != nil {
+		if cfg.EnableFlatSnapshot, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagFlatSnapshotBaseLayerInterval); v != nil {
+		if cfg.FlatSnapshotBaseLayerInterval, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagEnableTestAPI); v != nil {
 		if cfg.EnableTestAPI, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
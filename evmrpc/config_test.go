@@ -9,27 +9,54 @@ import (
 )
 
 type opts struct {
-	httpEnabled             interface{}
-	httpPort                interface{}
-	wsEnabled               interface{}
-	wsPort                  interface{}
-	readTimeout             interface{}
-	readHeaderTimeout       interface{}
-	writeTimeout            interface{}
-	idleTimeout             interface{}
-	simulationGasLimit      interface{}
-	simulationEVMTimeout    interface{}
-	corsOrigins             interface{}
-	wsOrigins               interface{}
-	filterTimeout           interface{}
-	checkTxTimeout          interface{}
-	maxTxPoolTxs            interface{}
-	slow                    interface{}
-	denyList                interface{}
-	maxLogNoBlock           interface{}
-	maxBlocksForLog         interface{}
-	maxSubscriptionsNewHead interface{}
-	enableTestAPI           interface{}
+	httpEnabled                   interface{}
+	httpPort                      interface{}
+	wsEnabled                     interface{}
+	wsPort                        interface{}
+	readTimeout                   interface{}
+	readHeaderTimeout             interface{}
+	writeTimeout                  interface{}
+	idleTimeout                   interface{}
+	simulationGasLimit            interface{}
+	simulationEVMTimeout          interface{}
+	corsOrigins                   interface{}
+	wsOrigins                     interface{}
+	filterTimeout                 interface{}
+	checkTxTimeout                interface{}
+	maxTxPoolTxs                  interface{}
+	slow                          interface{}
+	denyList                      interface{}
+	maxLogNoBlock                 interface{}
+	maxBlocksForLog               interface{}
+	maxTraceFilterBlocks          interface{}
+	maxSubscriptionsNewHead       interface{}
+	maxSubscriptionsNewPendingTxs interface{}
+	maxSubscriptionsSyncing       interface{}
+	enableLogIndex                interface{}
+	rateLimitPerIP                interface{}
+	rateLimitPerIPBurst           interface{}
+	rateLimitPerJWTSubject        interface{}
+	rateLimitPerJWTSubjectBurst   interface{}
+	rateLimitMethodCosts          interface{}
+	maxConcurrentTraceCalls       interface{}
+	maxConcurrentSimulationCalls  interface{}
+	maxStorageSlotsForProof       interface{}
+	responseCacheSize             interface{}
+	enableTxAddressIndex          interface{}
+	signerBackend                 interface{}
+	signerKeyringBackend          interface{}
+	signerExternalEndpoint        interface{}
+	enableGasPriceOracle          interface{}
+	gasPriceOracleBlocks          interface{}
+	gasPriceOraclePercentile      interface{}
+	gasPriceOracleMempoolWeight   interface{}
+	enableAccountHistoryIndex     interface{}
+	enableStateDiffIndex          interface{}
+	receiptKeepRecent             interface{}
+	receiptPruneIntervalSeconds   interface{}
+	enableFlatSnapshot            interface{}
+	flatSnapshotBaseLayerInterval interface{}
+	enableTestAPI                 interface{}
 }
 
 func (o *opts) Get(k string) interface{} {
@@ -90,9 +117,90 @@ func (o *opts) Get(k string) interface{} {
 	if k == "evm.max_blocks_for_log" {
 		return o.maxBlocksForLog
 	}
+	if k == "evm.max_trace_filter_blocks" {
+		return o.maxTraceFilterBlocks
+	}
 	if k == "evm.max_subscriptions_new_head" {
 		return o.maxSubscriptionsNewHead
 	}
+	if k == "evm.max_subscriptions_new_pending_txs" {
+		return o.maxSubscriptionsNewPendingTxs
+	}
+	if k == "evm.max_subscriptions_syncing" {
+		return o.maxSubscriptionsSyncing
+	}
+	if k == "evm.enable_log_index" {
+		return o.enableLogIndex
+	}
+	if k == "evm.rate_limit_per_ip" {
+		return o.rateLimitPerIP
+	}
+	if k == "evm.rate_limit_per_ip_burst" {
+		return o.rateLimitPerIPBurst
+	}
+	if k == "evm.rate_limit_per_jwt_subject" {
+		return o.rateLimitPerJWTSubject
+	}
+	if k == "evm.rate_limit_per_jwt_subject_burst" {
+		return o.rateLimitPerJWTSubjectBurst
+	}
+	if k == "evm.rate_limit_method_costs" {
+		return o.rateLimitMethodCosts
+	}
+	if k == "evm.max_concurrent_trace_calls" {
+		return o.maxConcurrentTraceCalls
+	}
+	if k == "evm.max_concurrent_simulation_calls" {
+		return o.maxConcurrentSimulationCalls
+	}
+	if k == "evm.max_storage_slots_for_proof" {
+		return o.maxStorageSlotsForProof
+	}
+	if k == "evm.response_cache_size" {
+		return o.responseCacheSize
+	}
+	if k == "evm.enable_tx_address_index" {
+		return o.enableTxAddressIndex
+	}
+	if k == "evm.signer_backend" {
+		return o.signerBackend
+	}
+	if k == "evm.signer_keyring_backend" {
+		return o.signerKeyringBackend
+	}
+	if k == "evm.signer_external_endpoint" {
+		return o.signerExternalEndpoint
+	}
+	if k == "evm.enable_gas_price_oracle" {
+		return o.enableGasPriceOracle
+	}
+	if k == "evm.gas_price_oracle_blocks" {
+		return o.gasPriceOracleBlocks
+	}
+	if k == "evm.gas_price_oracle_percentile" {
+		return o.gasPriceOraclePercentile
+	}
+	if k == "evm.gas_price_oracle_mempool_weight" {
+		return o.gasPriceOracleMempoolWeight
+	}
+	if k == "evm.enable_account_history_index" {
+		return o.enableAccountHistoryIndex
+	}
+	if k == "evm.enable_state_diff_index" {
+		return o.enableStateDiffIndex
+	}
+	if k == "evm.receipt_keep_recent" {
+		return o.receiptKeepRecent
+	}
+	if k == "evm.receipt_prune_interval_seconds" {
+		return o.receiptPruneIntervalSeconds
+	}
+	if k == "evm.enable_flat_snapshot" {
+		return o.enableFlatSnapshot
+	}
+	if k == "evm.flat_snapshot_base_layer_interval" {
+		return o.flatSnapshotBaseLayerInterval
+	}
 	if k == "evm.enable_test_api" {
 		return o.enableTestAPI
 	}
@@ -120,8 +228,35 @@ func TestReadConfig(t *testing.T) {
 		make([]string, 0),
 		20000,
 		1000,
+		100,
+		10000,
+		10000,
 		10000,
 		false,
+		float64(10),
+		20,
+		float64(100),
+		200,
+		make([]string, 0),
+		4,
+		16,
+		1000,
+		500,
+		true,
+		"external",
+		"file",
+		"http://localhost:8550",
+		true,
+		30,
+		70.0,
+		40,
+		true,
+		true,
+		100,
+		60,
+		true,
+		1000,
+		false,
 	}
 	_, err := evmrpc.ReadConfig(&goodOpts)
 	require.Nil(t, err)
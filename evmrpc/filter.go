@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -398,25 +399,39 @@ func (f *LogFetcher) fetchBlocksByCrit(ctx context.Context, crit filters.FilterC
 	if lastToHeight > begin {
 		begin = lastToHeight
 	}
-	if !applyOpenEndedLogLimit && f.filterConfig.maxBlock > 0 && end >= (begin+f.filterConfig.maxBlock) {
+	indexedFrom, err := f.logIndexStart(crit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	// blocks served by the log index are not scanned, so only the rest count towards the cap
+	scanEnd := end
+	if indexedFrom <= end {
+		scanEnd = indexedFrom - 1
+	}
+	if !applyOpenEndedLogLimit && f.filterConfig.maxBlock > 0 && scanEnd >= (begin+f.filterConfig.maxBlock) {
 		return nil, 0, false, fmt.Errorf("a maximum of %d blocks worth of logs may be requested at a time", f.filterConfig.maxBlock)
 	}
 	// begin should always be <= end block at this point
 	if begin > end {
 		return nil, 0, false, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
 	}
-	res := make(chan *coretypes.ResultBlock, end-begin+1)
+	heights, err := f.heightsFromLogIndex(crit, begin, end, indexedFrom)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	res := make(chan *coretypes.ResultBlock, len(heights))
 	defer close(res)
-	runner := NewParallelRunner(MaxNumOfWorkers, int(end-begin+1))
+	runner := NewParallelRunner(MaxNumOfWorkers, len(heights))
 	defer runner.Done.Wait()
 	defer close(runner.Queue)
-	for height := begin; height <= end; height++ {
+	for _, height := range heights {
 		h := height
 		runner.Queue <- func() {
 			if h == 0 {
 				return
 			}
-			if len(crit.Addresses) != 0 || len(crit.Topics) != 0 {
+			// heights returned by the log index are known to contain matching logs
+			if h < indexedFrom && (len(crit.Addresses) != 0 || len(crit.Topics) != 0) {
 				providerCtx := f.ctxProvider(h)
 				blockBloom := f.k.GetBlockBloom(providerCtx)
 				if !MatchFilters(blockBloom, bloomIndexes) {
@@ -433,6 +448,53 @@ func (f *LogFetcher) fetchBlocksByCrit(ctx context.Context, crit filters.FilterC
 	return res, end, applyOpenEndedLogLimit, nil
 }
 
+// logIndexStart returns the lowest height from which on the log index can serve the given
+// criteria, or math.MaxInt64 if the index is disabled or cannot serve them.
+func (f *LogFetcher) logIndexStart(crit filters.FilterCriteria) (int64, error) {
+	if !f.k.LogIndexEnabled() {
+		return math.MaxInt64, nil
+	}
+	start, ok, err := f.k.GetLogIndexStartHeight(crit.Addresses, crit.Topics)
+	if err != nil || !ok {
+		return math.MaxInt64, err
+	}
+	return int64(start), nil //nolint:gosec
+}
+
+// heightsFromLogIndex returns the heights in [begin, end] that need to be scanned for the
+// given criteria. Heights from indexedFrom on are looked up in the log index, so only the
+// ones that contain candidate logs are returned. Heights below indexedFrom are all returned
+// and still need to be checked against the block bloom.
+func (f *LogFetcher) heightsFromLogIndex(crit filters.FilterCriteria, begin int64, end int64, indexedFrom int64) ([]int64, error) {
+	scanEnd := end
+	if indexedFrom <= end {
+		scanEnd = indexedFrom - 1
+	}
+	heights := []int64{}
+	for height := begin; height <= scanEnd; height++ {
+		heights = append(heights, height)
+	}
+	if scanEnd < end {
+		indexBegin := begin
+		if indexBegin < indexedFrom {
+			indexBegin = indexedFrom
+		}
+		indexed, ok, err := f.k.GetLogIndexHeights(f.ctxProvider(LatestCtxHeight), uint64(indexBegin), uint64(end), crit.Addresses, crit.Topics) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// the index can no longer serve the criteria
+			for height := indexBegin; height <= end; height++ {
+				heights = append(heights, height)
+			}
+			return heights, nil
+		}
+		heights = append(heights, utils.Map(indexed, func(h uint64) int64 { return int64(h) })...) //nolint:gosec
+	}
+	return heights, nil
+}
+
 func matchTopics(topics [][]common.Hash, eventTopics []common.Hash) bool {
 	for i, topicList := range topics {
 		if len(topicList) == 0 {
@@ -0,0 +1,236 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+type GasPriceOracleConfig struct {
+	// number of most recent blocks whose priority fees are sampled
+	Blocks int64
+	// gas weighted percentile of the sampled priority fees that is suggested
+	Percentile float64
+	// weight in percent of the mempool priority fees in the suggestion, the remainder
+	// going to the priority fees of recent blocks
+	MempoolWeight int64
+	// maximum number of unconfirmed txs sampled
+	MaxMempoolTxs int
+}
+
+// GasPriceOracle suggests priority fees from the priority fees paid in recent blocks,
+// blended with the priority fees offered by pending txs. Suggestions are computed once per
+// height.
+type GasPriceOracle struct {
+	tmClient    rpcclient.Client
+	keeper      *keeper.Keeper
+	ctxProvider func(int64) sdk.Context
+	txDecoder   sdk.TxDecoder
+	config      GasPriceOracleConfig
+
+	mu         sync.Mutex
+	lastHeight int64
+	last       *GasPriceBreakdown
+}
+
+// GasPriceBreakdown explains how a gas price suggestion was derived.
+type GasPriceBreakdown struct {
+	BlockNumber          hexutil.Uint64 `json:"blockNumber"`
+	BaseFeePerGas        *hexutil.Big   `json:"baseFeePerGas"`
+	Percentile           float64        `json:"percentile"`
+	Blocks               hexutil.Uint64 `json:"blocks"`
+	BlockSamples         hexutil.Uint64 `json:"blockSamples"`
+	BlockPriorityFee     *hexutil.Big   `json:"blockPriorityFee"`
+	MempoolSamples       hexutil.Uint64 `json:"mempoolSamples"`
+	MempoolPriorityFee   *hexutil.Big   `json:"mempoolPriorityFee"`
+	MempoolWeight        hexutil.Uint64 `json:"mempoolWeight"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	GasPrice             *hexutil.Big   `json:"gasPrice"`
+}
+
+// NewGasPriceOracleConfig returns the oracle settings of config.
+func NewGasPriceOracleConfig(config Config) (GasPriceOracleConfig, error) {
+	if config.GasPriceOracleBlocks <= 0 {
+		return GasPriceOracleConfig{}, errors.New("gas_price_oracle_blocks must be positive")
+	}
+	if config.GasPriceOraclePercentile < 0 || config.GasPriceOraclePercentile > 100 {
+		return GasPriceOracleConfig{}, errors.New("gas_price_oracle_percentile must be between 0 and 100")
+	}
+	if config.GasPriceOracleMempoolWeight < 0 || config.GasPriceOracleMempoolWeight > 100 {
+		return GasPriceOracleConfig{}, errors.New("gas_price_oracle_mempool_weight must be between 0 and 100")
+	}
+	return GasPriceOracleConfig{
+		Blocks:        config.GasPriceOracleBlocks,
+		Percentile:    config.GasPriceOraclePercentile,
+		MempoolWeight: config.GasPriceOracleMempoolWeight,
+		MaxMempoolTxs: int(config.MaxTxPoolTxs),
+	}, nil
+}
+
+func NewGasPriceOracle(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config GasPriceOracleConfig) *GasPriceOracle {
+	return &GasPriceOracle{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, config: config}
+}
+
+// Suggest returns the suggestion for the latest height.
+func (o *GasPriceOracle) Suggest(ctx context.Context) (*GasPriceBreakdown, error) {
+	height := o.ctxProvider(LatestCtxHeight).BlockHeight()
+	o.mu.Lock()
+	if o.last != nil && o.lastHeight == height {
+		defer o.mu.Unlock()
+		return o.last, nil
+	}
+	o.mu.Unlock()
+
+	res, err := o.suggest(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if height >= o.lastHeight {
+		o.lastHeight = height
+		o.last = res
+	}
+	return res, nil
+}
+
+func (o *GasPriceOracle) suggest(ctx context.Context, height int64) (*GasPriceBreakdown, error) {
+	baseFee := o.keeper.GetCurrBaseFeePerGas(o.ctxProvider(height)).TruncateInt().BigInt()
+	blockSamples, blockGasUsed, blocks := o.blockSamples(ctx, height)
+	mempoolSamples, mempoolGas, err := o.mempoolSamples(ctx, baseFee)
+	if err != nil {
+		return nil, err
+	}
+	res := &GasPriceBreakdown{
+		BlockNumber:    hexutil.Uint64(height),
+		BaseFeePerGas:  (*hexutil.Big)(baseFee),
+		Percentile:     o.config.Percentile,
+		Blocks:         hexutil.Uint64(blocks),
+		BlockSamples:   hexutil.Uint64(len(blockSamples)),
+		MempoolSamples: hexutil.Uint64(len(mempoolSamples)),
+		MempoolWeight:  hexutil.Uint64(o.config.MempoolWeight),
+	}
+	var blockFee, mempoolFee *big.Int
+	if len(blockSamples) > 0 {
+		blockFee = CalculatePercentiles([]float64{o.config.Percentile}, blockSamples, blockGasUsed)[0].ToInt()
+		res.BlockPriorityFee = (*hexutil.Big)(blockFee)
+	}
+	if len(mempoolSamples) > 0 {
+		mempoolFee = CalculatePercentiles([]float64{o.config.Percentile}, mempoolSamples, mempoolGas)[0].ToInt()
+		res.MempoolPriorityFee = (*hexutil.Big)(mempoolFee)
+	}
+	var priorityFee *big.Int
+	switch {
+	case blockFee != nil && mempoolFee != nil:
+		priorityFee = new(big.Int).Mul(blockFee, big.NewInt(100-o.config.MempoolWeight))
+		priorityFee.Add(priorityFee, new(big.Int).Mul(mempoolFee, big.NewInt(o.config.MempoolWeight)))
+		priorityFee.Div(priorityFee, big.NewInt(100))
+	case blockFee != nil:
+		priorityFee = blockFee
+	case mempoolFee != nil:
+		priorityFee = mempoolFee
+	default:
+		priorityFee = big.NewInt(defaultPriorityFeePerGas)
+	}
+	res.MaxPriorityFeePerGas = (*hexutil.Big)(priorityFee)
+	res.GasPrice = (*hexutil.Big)(new(big.Int).Add(baseFee, priorityFee))
+	return res, nil
+}
+
+// blockSamples returns the priority fees paid by the EVM txs of the blocks in the window
+// ending at height, weighted by gas used. Pruned blocks are skipped.
+func (o *GasPriceOracle) blockSamples(ctx context.Context, height int64) ([]GasAndReward, uint64, int) {
+	samples := []GasAndReward{}
+	totalGasUsed := uint64(0)
+	blocks := 0
+	latestCtx := o.ctxProvider(LatestCtxHeight)
+	for h := height; h > height-o.config.Blocks && h > 0; h-- {
+		blockNum := h
+		block, err := blockByNumber(ctx, o.tmClient, &blockNum)
+		if err != nil {
+			// block pruned from tendermint store
+			continue
+		}
+		baseFee := o.baseFee(h)
+		if baseFee == nil {
+			continue
+		}
+		blocks++
+		for _, txbz := range block.Block.Txs {
+			ethtx := getEthTxForTxBz(txbz, o.txDecoder)
+			if ethtx == nil {
+				continue
+			}
+			receipt, err := o.keeper.GetReceipt(latestCtx, ethtx.Hash())
+			if err != nil || receipt.BlockNumber != uint64(h) {
+				continue
+			}
+			reward := new(big.Int).Sub(new(big.Int).SetUint64(receipt.EffectiveGasPrice), baseFee)
+			if reward.Sign() < 0 {
+				reward.SetInt64(0)
+			}
+			samples = append(samples, GasAndReward{GasUsed: receipt.GasUsed, Reward: reward})
+			totalGasUsed += receipt.GasUsed
+		}
+	}
+	return samples, totalGasUsed, blocks
+}
+
+// mempoolSamples returns the effective priority fees offered by unconfirmed EVM txs at
+// the given base fee, weighted by gas limit. Txs whose fee cap is below the base fee are
+// skipped.
+func (o *GasPriceOracle) mempoolSamples(ctx context.Context, baseFee *big.Int) ([]GasAndReward, uint64, error) {
+	limit := o.config.MaxMempoolTxs
+	res, err := o.tmClient.UnconfirmedTxs(ctx, nil, &limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	samples := []GasAndReward{}
+	totalGas := uint64(0)
+	for _, txbz := range res.Txs {
+		ethtx := getEthTxForTxBz(txbz, o.txDecoder)
+		if ethtx == nil {
+			continue
+		}
+		tip, err := ethtx.EffectiveGasTip(baseFee)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, GasAndReward{GasUsed: ethtx.Gas(), Reward: tip})
+		totalGas += ethtx.Gas()
+	}
+	return samples, totalGas, nil
+}
+
+func (o *GasPriceOracle) baseFee(height int64) (res *big.Int) {
+	defer func() {
+		if err := recover(); err != nil {
+			res = nil
+		}
+	}()
+	return o.keeper.GetCurrBaseFeePerGas(o.ctxProvider(height)).TruncateInt().BigInt()
+}
+
+type SeiGasPriceAPI struct {
+	oracle         *GasPriceOracle
+	connectionType ConnectionType
+}
+
+func NewSeiGasPriceAPI(oracle *GasPriceOracle, connectionType ConnectionType) *SeiGasPriceAPI {
+	return &SeiGasPriceAPI{oracle: oracle, connectionType: connectionType}
+}
+
+// GasPriceBreakdown returns the gas price suggestion of the oracle together with the
+// samples it was derived from.
+func (a *SeiGasPriceAPI) GasPriceBreakdown(ctx context.Context) (result *GasPriceBreakdown, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("sei_gasPriceBreakdown", a.connectionType, startTime, returnErr == nil)
+	return a.oracle.Suggest(ctx)
+}
@@ -0,0 +1,65 @@
+package evmrpc_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasPriceBreakdown(t *testing.T) {
+	resObj := sendSeiRequestGood(t, "gasPriceBreakdown")
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x8", result["blockNumber"])
+	baseFee := hexutil.MustDecodeBig(result["baseFeePerGas"].(string))
+	priorityFee := hexutil.MustDecodeBig(result["maxPriorityFeePerGas"].(string))
+	gasPrice := hexutil.MustDecodeBig(result["gasPrice"].(string))
+	require.Equal(t, new(big.Int).Add(baseFee, priorityFee), gasPrice)
+	require.Equal(t, float64(evmrpc.DefaultConfig.GasPriceOraclePercentile), result["percentile"])
+}
+
+func TestGasPriceOracle(t *testing.T) {
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	cfg, err := evmrpc.NewGasPriceOracleConfig(evmrpc.DefaultConfig)
+	require.Nil(t, err)
+	oracle := evmrpc.NewGasPriceOracle(&MockClient{}, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), cfg)
+	suggestion, err := oracle.Suggest(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, hexutil.Uint64(Ctx.BlockHeight()), suggestion.BlockNumber)
+	require.Equal(t, new(big.Int).Add(suggestion.BaseFeePerGas.ToInt(), suggestion.MaxPriorityFeePerGas.ToInt()), suggestion.GasPrice.ToInt())
+
+	// suggestions are cached per height
+	cached, err := oracle.Suggest(context.Background())
+	require.Nil(t, err)
+	require.Same(t, suggestion, cached)
+
+	// eth_gasPrice and eth_maxPriorityFeePerGas follow the oracle when it is enabled
+	api := evmrpc.NewInfoAPI(&MockClient{}, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), nil, 1024, evmrpc.ConnectionTypeHTTP, oracle)
+	gasPrice, err := api.GasPrice(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, suggestion.GasPrice, gasPrice)
+	priorityFee, err := api.MaxPriorityFeePerGas(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, suggestion.MaxPriorityFeePerGas, priorityFee)
+}
+
+func TestNewGasPriceOracleConfig(t *testing.T) {
+	cfg := evmrpc.DefaultConfig
+	cfg.GasPriceOracleBlocks = 0
+	_, err := evmrpc.NewGasPriceOracleConfig(cfg)
+	require.NotNil(t, err)
+
+	cfg = evmrpc.DefaultConfig
+	cfg.GasPriceOraclePercentile = 101
+	_, err = evmrpc.NewGasPriceOracleConfig(cfg)
+	require.NotNil(t, err)
+
+	cfg = evmrpc.DefaultConfig
+	cfg.GasPriceOracleMempoolWeight = -1
+	_, err = evmrpc.NewGasPriceOracleConfig(cfg)
+	require.NotNil(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 )
@@ -25,13 +26,14 @@ type InfoAPI struct {
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
 	txDecoder      sdk.TxDecoder
-	homeDir        string
+	signer         Signer
 	connectionType ConnectionType
 	maxBlocks      int64
+	gasPriceOracle *GasPriceOracle
 }
 
-func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, homeDir string, maxBlocks int64, connectionType ConnectionType) *InfoAPI {
-	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, homeDir: homeDir, connectionType: connectionType, maxBlocks: maxBlocks}
+func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, signer Signer, maxBlocks int64, connectionType ConnectionType, gasPriceOracle *GasPriceOracle) *InfoAPI {
+	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, signer: signer, connectionType: connectionType, maxBlocks: maxBlocks, gasPriceOracle: gasPriceOracle}
 }
 
 type FeeHistoryResult struct {
@@ -63,19 +65,23 @@ func (i *InfoAPI) Coinbase() (common.Address, error) {
 func (i *InfoAPI) Accounts() (result []common.Address, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_Accounts", i.connectionType, startTime, returnErr == nil)
-	kb, err := getTestKeyring(i.homeDir)
+	accounts, err := i.signer.Accounts()
 	if err != nil {
 		return []common.Address{}, err
 	}
-	for addr := range getAddressPrivKeyMap(kb) {
-		result = append(result, common.HexToAddress(addr))
-	}
-	return result, nil
+	return accounts, nil
 }
 
 func (i *InfoAPI) GasPrice(ctx context.Context) (result *hexutil.Big, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_GasPrice", i.connectionType, startTime, returnErr == nil)
+	if i.gasPriceOracle != nil {
+		suggestion, err := i.gasPriceOracle.Suggest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return suggestion.GasPrice, nil
+	}
 	baseFee := i.keeper.GetCurrBaseFeePerGas(i.ctxProvider(LatestCtxHeight)).TruncateInt().BigInt()
 	totalGasUsed, err := i.getCongestionData(ctx, nil)
 	if err != nil {
@@ -174,11 +180,18 @@ func (i *InfoAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64
 			// either height is pruned or before EVM is introduced. Skipping
 			continue
 		}
-		result.GasUsedRatio = append(result.GasUsedRatio, GasUsedRatio)
-		baseFee := i.safeGetBaseFee(blockNum)
-		if baseFee == nil {
-			// the block has been pruned
-			continue
+		var baseFee *big.Int
+		if record, ok := i.keeper.GetBaseFeeRecord(i.ctxProvider(LatestCtxHeight), blockNum); ok {
+			result.GasUsedRatio = append(result.GasUsedRatio, gasUsedRatio(record))
+			baseFee = record.BaseFee.TruncateInt().BigInt()
+		} else {
+			// the block is older than the base fee history
+			result.GasUsedRatio = append(result.GasUsedRatio, GasUsedRatio)
+			baseFee = i.safeGetBaseFee(blockNum)
+			if baseFee == nil {
+				// the block has been pruned
+				continue
+			}
 		}
 		result.BaseFee = append(result.BaseFee, (*hexutil.Big)(baseFee))
 		height := blockNum
@@ -202,6 +215,13 @@ func (i *InfoAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error
 	// so a default value is returned.
 	startTime := time.Now()
 	defer recordMetrics("eth_maxPriorityFeePerGas", i.connectionType, startTime, true)
+	if i.gasPriceOracle != nil {
+		suggestion, err := i.gasPriceOracle.Suggest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return suggestion.MaxPriorityFeePerGas, nil
+	}
 	totalGasUsed, err := i.getCongestionData(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -234,6 +254,19 @@ func (i *InfoAPI) safeGetBaseFee(targetHeight int64) (res *big.Int) {
 	return
 }
 
+// gasUsedRatio reports the gas used relative to twice the target, so that a block at the
+// target has a ratio of 0.5 like an Ethereum block at the EIP-1559 target.
+func gasUsedRatio(record types.BaseFeeRecord) float64 {
+	if record.GasTarget == 0 {
+		return GasUsedRatio
+	}
+	ratio := float64(record.GasUsed) / float64(2*record.GasTarget)
+	if ratio > 1 {
+		return 1
+	}
+	return ratio
+}
+
 type GasAndReward struct {
 	GasUsed uint64
 	Reward  *big.Int
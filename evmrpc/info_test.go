@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
 	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +32,7 @@ func TestChainID(t *testing.T) {
 
 func TestAccounts(t *testing.T) {
 	homeDir := t.TempDir()
-	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, homeDir, 1024, evmrpc.ConnectionTypeHTTP)
+	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, evmrpc.NewKeyringSigner(homeDir, keyring.BackendTest, ""), 1024, evmrpc.ConnectionTypeHTTP, nil)
 	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
 	clientCtx, err := config.ReadFromClientConfig(clientCtx)
 	require.Nil(t, err)
@@ -125,6 +126,20 @@ func TestFeeHistory(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(8) // Reset context to a new block height
 }
 
+func TestFeeHistoryFromBaseFeeHistory(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	defer func() { Ctx = Ctx.WithBlockHeight(8) }()
+	EVMKeeper.SetBaseFeeRecord(Ctx, 10, types.BaseFeeRecord{Height: 1, BaseFee: sdk.NewDec(2000000000), GasUsed: 750000, GasTarget: 500000})
+	// clear the history
+	defer EVMKeeper.SetBaseFeeRecord(Ctx, 0, types.BaseFeeRecord{Height: 1})
+
+	resObj := sendRequestGood(t, "feeHistory", 1, "0x1", []interface{}{0.5})
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x1", result["oldestBlock"])
+	require.Equal(t, []interface{}{"0x77359400"}, result["baseFeePerGas"])
+	require.Equal(t, []interface{}{0.75}, result["gasUsedRatio"])
+}
+
 func TestCalculatePercentiles(t *testing.T) {
 	// all empty
 	result := evmrpc.CalculatePercentiles([]float64{}, []evmrpc.GasAndReward{}, 0)
@@ -203,7 +218,7 @@ func TestGasPriceLogic(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		i := evmrpc.NewInfoAPI(nil, nil, nil, nil, t.TempDir(), 1024, evmrpc.ConnectionTypeHTTP)
+		i := evmrpc.NewInfoAPI(nil, nil, nil, nil, nil, 1024, evmrpc.ConnectionTypeHTTP, nil)
 		gasPrice, err := i.GasPriceHelper(
 			context.Background(),
 			test.baseFee,
@@ -17,6 +17,7 @@
 package evmrpc
 
 import (
+	"context"
 	"net/http"
 	"strings"
 	"time"
@@ -75,6 +76,7 @@ func (handler *jwtHandler) ServeHTTP(out http.ResponseWriter, r *http.Request) {
 	case time.Until(claims.IssuedAt.Time) > JwtExpiryTimeout:
 		http.Error(out, "future token", http.StatusUnauthorized)
 	default:
-		handler.next.ServeHTTP(out, r)
+		// expose the subject to the handlers down the stack, e.g. for rate limiting
+		handler.next.ServeHTTP(out, r.WithContext(context.WithValue(r.Context(), jwtSubjectKey{}, claims.Subject)))
 	}
 }
@@ -0,0 +1,195 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	DefaultLogsPageSize = 1000
+	// logsPageBlocks bounds the number of blocks scanned by one page if max_blocks_for_log
+	// is not set
+	logsPageBlocks = 2000
+)
+
+// LogsPage is a page of logs returned by sei_getLogsPaginated.
+type LogsPage struct {
+	Logs []*ethtypes.Log `json:"logs"`
+	// NextCursor resumes the query after the last log of the page. It is empty once the
+	// whole range has been scanned.
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// logsCursor is the position of the next log to return, together with the last height of
+// the range so that every page of a query covers the same range.
+type logsCursor struct {
+	height   int64
+	txIndex  uint32
+	logIndex uint32
+	toBlock  int64
+}
+
+type SeiLogsAPI struct {
+	logFetcher     *LogFetcher
+	filterConfig   *FilterConfig
+	ctxProvider    func(int64) sdk.Context
+	connectionType ConnectionType
+}
+
+func NewSeiLogsAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SeiLogsAPI {
+	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, txConfig: txConfig, filterConfig: filterConfig, includeSyntheticReceipts: true}
+	return &SeiLogsAPI{logFetcher: logFetcher, filterConfig: filterConfig, ctxProvider: ctxProvider, connectionType: connectionType}
+}
+
+// GetLogsPaginated returns the logs matching crit in chain order, at most pageSize at a
+// time. Unlike eth_getLogs it never fails because of the size of the range: each page
+// scans a bounded number of blocks and returns a cursor to pass back to resume the query
+// after the last returned log. A page may hold fewer than pageSize logs, or none, while
+// the cursor is not empty.
+func (a *SeiLogsAPI) GetLogsPaginated(ctx context.Context, crit filters.FilterCriteria, cursor *string, pageSize *uint) (result *LogsPage, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("sei_getLogsPaginated", a.connectionType, startTime, returnErr == nil)
+	if crit.BlockHash != nil {
+		return nil, errors.New("blockHash is not supported, use eth_getLogs instead")
+	}
+	size := uint(DefaultLogsPageSize)
+	if a.filterConfig.maxLog > 0 && int64(size) > a.filterConfig.maxLog {
+		size = uint(a.filterConfig.maxLog)
+	}
+	if pageSize != nil {
+		size = *pageSize
+	}
+	if size == 0 {
+		return nil, errors.New("pageSize must be positive")
+	}
+	if a.filterConfig.maxLog > 0 && int64(size) > a.filterConfig.maxLog {
+		return nil, fmt.Errorf("pageSize must be at most %d", a.filterConfig.maxLog)
+	}
+
+	latest := a.ctxProvider(LatestCtxHeight).BlockHeight()
+	var pos logsCursor
+	if cursor != nil && *cursor != "" {
+		var err error
+		if pos, err = decodeLogsCursor(*cursor); err != nil {
+			return nil, err
+		}
+	} else {
+		pos.height, pos.toBlock = latest, latest
+		if crit.FromBlock != nil {
+			pos.height = getHeightFromBigIntBlockNumber(latest, crit.FromBlock)
+		}
+		if crit.ToBlock != nil {
+			pos.toBlock = getHeightFromBigIntBlockNumber(latest, crit.ToBlock)
+		}
+		if pos.height > pos.toBlock {
+			return nil, fmt.Errorf("fromBlock %d is after toBlock %d", pos.height, pos.toBlock)
+		}
+	}
+	if pos.height < 1 {
+		pos.height = 1
+	}
+	if pos.height > latest {
+		// the range extends past the latest block, which the caller can poll for
+		return &LogsPage{Logs: []*ethtypes.Log{}, NextCursor: encodeLogsCursor(pos)}, nil
+	}
+
+	maxBlocks := int64(logsPageBlocks)
+	if a.filterConfig.maxBlock > 0 {
+		maxBlocks = a.filterConfig.maxBlock
+	}
+	end := pos.toBlock
+	if end >= pos.height+maxBlocks {
+		end = pos.height + maxBlocks - 1
+	}
+	if end > latest {
+		end = latest
+	}
+	logs, next, err := a.logFetcher.getLogsPage(ctx, crit, pos, end, size)
+	if err != nil {
+		return nil, err
+	}
+	result = &LogsPage{Logs: logs}
+	if next.height <= next.toBlock {
+		result.NextCursor = encodeLogsCursor(next)
+	}
+	return result, nil
+}
+
+// getLogsPage returns up to size logs matching crit from pos up to height end, and the
+// position of the log that follows them.
+func (f *LogFetcher) getLogsPage(ctx context.Context, crit filters.FilterCriteria, pos logsCursor, end int64, size uint) ([]*ethtypes.Log, logsCursor, error) {
+	bloomIndexes := EncodeFilters(crit.Addresses, crit.Topics)
+	indexedFrom, err := f.logIndexStart(crit)
+	if err != nil {
+		return nil, logsCursor{}, err
+	}
+	heights, err := f.heightsFromLogIndex(crit, pos.height, end, indexedFrom)
+	if err != nil {
+		return nil, logsCursor{}, err
+	}
+	res := []*ethtypes.Log{}
+	for _, height := range heights {
+		if height < indexedFrom && (len(crit.Addresses) != 0 || len(crit.Topics) != 0) {
+			if !MatchFilters(f.k.GetBlockBloom(f.ctxProvider(height)), bloomIndexes) {
+				continue
+			}
+		}
+		h := height
+		block, err := blockByNumberWithRetry(ctx, f.tmClient, &h, 1)
+		if err != nil {
+			return nil, logsCursor{}, err
+		}
+		logs := f.GetLogsForBlock(block, crit, bloomIndexes)
+		sort.SliceStable(logs, func(i, j int) bool {
+			if logs[i].TxIndex != logs[j].TxIndex {
+				return logs[i].TxIndex < logs[j].TxIndex
+			}
+			return logs[i].Index < logs[j].Index
+		})
+		for _, log := range logs {
+			if height == pos.height && (uint32(log.TxIndex) < pos.txIndex || (uint32(log.TxIndex) == pos.txIndex && uint32(log.Index) < pos.logIndex)) {
+				// returned by a previous page
+				continue
+			}
+			if uint(len(res)) == size {
+				return res, logsCursor{height: height, txIndex: uint32(log.TxIndex), logIndex: uint32(log.Index), toBlock: pos.toBlock}, nil
+			}
+			res = append(res, log)
+		}
+	}
+	return res, logsCursor{height: end + 1, toBlock: pos.toBlock}, nil
+}
+
+func encodeLogsCursor(c logsCursor) string {
+	bz := make([]byte, 24)
+	binary.BigEndian.PutUint64(bz[:8], uint64(c.height))
+	binary.BigEndian.PutUint32(bz[8:12], c.txIndex)
+	binary.BigEndian.PutUint32(bz[12:16], c.logIndex)
+	binary.BigEndian.PutUint64(bz[16:], uint64(c.toBlock))
+	return hexutil.Encode(bz)
+}
+
+func decodeLogsCursor(cursor string) (logsCursor, error) {
+	bz, err := hexutil.Decode(cursor)
+	if err != nil || len(bz) != 24 {
+		return logsCursor{}, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	return logsCursor{
+		height:   int64(binary.BigEndian.Uint64(bz[:8])),
+		txIndex:  binary.BigEndian.Uint32(bz[8:12]),
+		logIndex: binary.BigEndian.Uint32(bz[12:16]),
+		toBlock:  int64(binary.BigEndian.Uint64(bz[16:])),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package evmrpc_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLogsPaginated(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(8)
+	filterCriteria := map[string]interface{}{
+		"fromBlock": "0x1",
+		"toBlock":   "0x8",
+		"topics":    [][]common.Hash{{}, {common.HexToHash("0x456")}},
+	}
+	expected := sendSeiRequestGood(t, "getLogs", filterCriteria)["result"].([]interface{})
+	require.NotEmpty(t, expected)
+
+	// walking the range one log at a time returns the same logs in the same order
+	got := []interface{}{}
+	var cursor interface{}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 100)
+		resObj := sendSeiRequestGood(t, "getLogsPaginated", filterCriteria, cursor, 1)
+		result := resObj["result"].(map[string]interface{})
+		logs := result["logs"].([]interface{})
+		require.LessOrEqual(t, len(logs), 1)
+		got = append(got, logs...)
+		next, ok := result["nextCursor"]
+		if !ok {
+			break
+		}
+		cursor = next
+	}
+	require.Equal(t, expected, got)
+
+	resObj := sendSeiRequestGood(t, "getLogsPaginated", filterCriteria, nil, len(expected))
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, expected, result["logs"])
+	require.Nil(t, result["nextCursor"])
+}
+
+func TestGetLogsPaginatedErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		crit     map[string]interface{}
+		cursor   interface{}
+		pageSize interface{}
+		err      string
+	}{
+		{
+			name:     "page size above maximum",
+			crit:     map[string]interface{}{"fromBlock": "0x1", "toBlock": "0x2"},
+			pageSize: 11,
+			err:      "pageSize must be at most 10",
+		},
+		{
+			name:     "zero page size",
+			crit:     map[string]interface{}{"fromBlock": "0x1", "toBlock": "0x2"},
+			pageSize: 0,
+			err:      "pageSize must be positive",
+		},
+		{
+			name: "from block after to block",
+			crit: map[string]interface{}{"fromBlock": "0x3", "toBlock": "0x2"},
+			err:  "fromBlock 3 is after toBlock 2",
+		},
+		{
+			name:   "invalid cursor",
+			crit:   map[string]interface{}{"fromBlock": "0x1", "toBlock": "0x2"},
+			cursor: "0x1234",
+			err:    "invalid cursor 0x1234",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resObj := sendSeiRequestGood(t, "getLogsPaginated", tt.crit, tt.cursor, tt.pageSize)
+			require.Equal(t, tt.err, resObj["error"].(map[string]interface{})["message"])
+		})
+	}
+}
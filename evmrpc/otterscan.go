@@ -0,0 +1,517 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// OtsAPILevel is the Otterscan API level implemented by OtterscanAPI.
+const OtsAPILevel = 8
+
+// Internal operation types as defined by Otterscan.
+const (
+	OtsOpTransfer     = 0
+	OtsOpSelfDestruct = 1
+	OtsOpCreate       = 2
+	OtsOpCreate2      = 3
+)
+
+var ErrTxAddressIndexDisabled = errors.New("transaction address index is disabled, set enable_tx_address_index to use this method")
+
+// OtterscanAPI implements the ots namespace used by the Otterscan block explorer. The
+// search methods are backed by the transaction address index, which only covers the
+// sender, recipient and created contract of each transaction.
+type OtterscanAPI struct {
+	tmClient       rpcclient.Client
+	keeper         *keeper.Keeper
+	ctxProvider    func(int64) sdk.Context
+	blockAPI       *BlockAPI
+	txAPI          *TransactionAPI
+	debugAPI       *DebugAPI
+	connectionType ConnectionType
+}
+
+func NewOtterscanAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, blockAPI *BlockAPI, txAPI *TransactionAPI, debugAPI *DebugAPI, connectionType ConnectionType) *OtterscanAPI {
+	return &OtterscanAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, blockAPI: blockAPI, txAPI: txAPI, debugAPI: debugAPI, connectionType: connectionType}
+}
+
+type OtsInternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+type OtsTraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+type OtsContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+type OtsSearchResult struct {
+	Txs       []*ethapi.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+	FirstPage bool                     `json:"firstPage"`
+	LastPage  bool                     `json:"lastPage"`
+}
+
+// otsCallFrame is the output format of the callTracer.
+type otsCallFrame struct {
+	Type   string         `json:"type"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+	Error  string         `json:"error"`
+	Calls  []otsCallFrame `json:"calls"`
+}
+
+//nolint:revive
+func (a *OtterscanAPI) GetApiLevel() uint64 {
+	startTime := time.Now()
+	defer recordMetrics("ots_getApiLevel", a.connectionType, startTime, true)
+	return OtsAPILevel
+}
+
+func (a *OtterscanAPI) HasCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (result bool, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_hasCode", a.connectionType, startTime, returnErr == nil)
+	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	blockNumber, err := GetBlockNumberByNrOrHash(ctx, a.tmClient, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	if blockNumber != nil {
+		sdkCtx = a.ctxProvider(*blockNumber)
+		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
+			return false, err
+		}
+	}
+	return len(a.keeper.GetCode(sdkCtx, address)) > 0, nil
+}
+
+// GetInternalOperations returns the value transfers, contract creations and self-destructs
+// performed by internal calls of a transaction.
+func (a *OtterscanAPI) GetInternalOperations(ctx context.Context, hash common.Hash) (result []*OtsInternalOperation, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getInternalOperations", a.connectionType, startTime, returnErr == nil)
+	root, err := a.callTrace(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	result = []*OtsInternalOperation{}
+	var walk func(frame *otsCallFrame)
+	walk = func(frame *otsCallFrame) {
+		for i := range frame.Calls {
+			call := &frame.Calls[i]
+			op := &OtsInternalOperation{From: call.From, To: call.To, Value: call.Value}
+			switch call.Type {
+			case "CALL":
+				if call.Value != nil && call.Value.ToInt().Sign() > 0 {
+					op.Type = OtsOpTransfer
+					result = append(result, op)
+				}
+			case "CREATE":
+				op.Type = OtsOpCreate
+				result = append(result, op)
+			case "CREATE2":
+				op.Type = OtsOpCreate2
+				result = append(result, op)
+			case "SELFDESTRUCT":
+				op.Type = OtsOpSelfDestruct
+				result = append(result, op)
+			}
+			walk(call)
+		}
+	}
+	walk(root)
+	return result, nil
+}
+
+// TraceTransaction returns every call frame of a transaction in execution order.
+func (a *OtterscanAPI) TraceTransaction(ctx context.Context, hash common.Hash) (result []*OtsTraceEntry, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_traceTransaction", a.connectionType, startTime, returnErr == nil)
+	root, err := a.callTrace(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	result = []*OtsTraceEntry{}
+	var walk func(frame *otsCallFrame, depth int)
+	walk = func(frame *otsCallFrame, depth int) {
+		result = append(result, &OtsTraceEntry{
+			Type:   frame.Type,
+			Depth:  depth,
+			From:   frame.From,
+			To:     frame.To,
+			Value:  frame.Value,
+			Input:  frame.Input,
+			Output: frame.Output,
+		})
+		for i := range frame.Calls {
+			walk(&frame.Calls[i], depth+1)
+		}
+	}
+	walk(root, 0)
+	return result, nil
+}
+
+// GetTransactionError returns the revert data of a failed transaction, or empty bytes if
+// it succeeded.
+func (a *OtterscanAPI) GetTransactionError(ctx context.Context, hash common.Hash) (result hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getTransactionError", a.connectionType, startTime, returnErr == nil)
+	root, err := a.callTrace(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if root.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return root.Output, nil
+}
+
+func (a *OtterscanAPI) GetBlockDetails(ctx context.Context, number rpc.BlockNumber) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getBlockDetails", a.connectionType, startTime, returnErr == nil)
+	block, err := a.blockAPI.GetBlockByNumber(ctx, number, false)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+func (a *OtterscanAPI) GetBlockDetailsByHash(ctx context.Context, hash common.Hash) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getBlockDetailsByHash", a.connectionType, startTime, returnErr == nil)
+	block, err := a.blockAPI.GetBlockByHash(ctx, hash, false)
+	if err != nil {
+		return nil, err
+	}
+	return a.blockDetails(ctx, block)
+}
+
+// GetBlockTransactions returns a page of the transactions of a block together with their
+// receipts. Pages are counted from the end of the block.
+func (a *OtterscanAPI) GetBlockTransactions(ctx context.Context, number rpc.BlockNumber, pageNumber uint64, pageSize uint64) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getBlockTransactions", a.connectionType, startTime, returnErr == nil)
+	block, err := a.blockAPI.GetBlockByNumber(ctx, number, true)
+	if err != nil {
+		return nil, err
+	}
+	blockNumber := rpc.BlockNumber(block["number"].(*hexutil.Big).ToInt().Int64())
+	receipts, err := a.blockAPI.GetBlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(blockNumber))
+	if err != nil {
+		return nil, err
+	}
+	fullBlock := copyFields(block)
+	txs, _ := block["transactions"].([]interface{})
+	fullBlock["transactionCount"] = len(txs)
+	fullBlock["logsBloom"] = nil
+
+	pageEnd := len(txs) - int(pageNumber*pageSize)
+	pageStart := pageEnd - int(pageSize)
+	if pageEnd < 0 {
+		pageEnd = 0
+	}
+	if pageStart < 0 {
+		pageStart = 0
+	}
+	fullBlock["transactions"] = txs[pageStart:pageEnd]
+	pageHashes := map[common.Hash]struct{}{}
+	for _, tx := range txs[pageStart:pageEnd] {
+		if rpcTx, ok := tx.(*ethapi.RPCTransaction); ok {
+			pageHashes[rpcTx.Hash] = struct{}{}
+		}
+	}
+	pageReceipts := []map[string]interface{}{}
+	for _, receipt := range receipts {
+		hash, _ := receipt["transactionHash"].(common.Hash)
+		if _, ok := pageHashes[hash]; !ok {
+			continue
+		}
+		r := copyFields(receipt)
+		r["logs"] = nil
+		r["logsBloom"] = nil
+		pageReceipts = append(pageReceipts, r)
+	}
+	return map[string]interface{}{
+		"fullblock": fullBlock,
+		"receipts":  pageReceipts,
+	}, nil
+}
+
+// SearchTransactionsBefore returns the transactions of address in blocks below
+// blockNumber, newest first, or the latest transactions if blockNumber is 0. The page is
+// extended to include every transaction of its last block.
+func (a *OtterscanAPI) SearchTransactionsBefore(ctx context.Context, address common.Address, blockNumber uint64, pageSize uint16) (result *OtsSearchResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_searchTransactionsBefore", a.connectionType, startTime, returnErr == nil)
+	toHeight := ^uint64(0)
+	if blockNumber > 0 {
+		toHeight = blockNumber - 1
+	}
+	entries, exhausted, err := a.searchTxAddressIndex(address, 0, toHeight, true, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	result, err = a.searchResult(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+	result.FirstPage = blockNumber == 0
+	result.LastPage = exhausted
+	return result, nil
+}
+
+// SearchTransactionsAfter returns the transactions of address in blocks above blockNumber,
+// newest first, or the earliest transactions if blockNumber is 0. The page is extended to
+// include every transaction of its last block.
+func (a *OtterscanAPI) SearchTransactionsAfter(ctx context.Context, address common.Address, blockNumber uint64, pageSize uint16) (result *OtsSearchResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_searchTransactionsAfter", a.connectionType, startTime, returnErr == nil)
+	fromHeight := uint64(0)
+	if blockNumber > 0 {
+		fromHeight = blockNumber + 1
+	}
+	entries, exhausted, err := a.searchTxAddressIndex(address, fromHeight, ^uint64(0), false, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	result, err = a.searchResult(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+	result.FirstPage = exhausted
+	result.LastPage = blockNumber == 0
+	return result, nil
+}
+
+// GetContractCreator returns the transaction that deployed the contract at address and its
+// sender, or nil if address is not a contract. Only contracts deployed by a top-level
+// transaction can be found.
+func (a *OtterscanAPI) GetContractCreator(ctx context.Context, address common.Address) (result *OtsContractCreator, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getContractCreator", a.connectionType, startTime, returnErr == nil)
+	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	if len(a.keeper.GetCode(sdkCtx, address)) == 0 {
+		return nil, nil
+	}
+	var iterErr error
+	enabled, err := a.keeper.IterateTxAddressIndex(sdkCtx, address, 0, ^uint64(0), false, func(e keeper.TxAddressIndexEntry) bool {
+		receipt, err := a.keeper.GetReceipt(sdkCtx, e.TxHash)
+		if err != nil {
+			if !strings.Contains(err.Error(), "not found") {
+				iterErr = err
+				return false
+			}
+			return true
+		}
+		if receipt.ContractAddress != "" && common.HexToAddress(receipt.ContractAddress) == address {
+			result = &OtsContractCreator{Hash: e.TxHash, Creator: common.HexToAddress(receipt.From)}
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrTxAddressIndexDisabled
+	}
+	return result, iterErr
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by address with
+// the given nonce, or nil if there is none.
+func (a *OtterscanAPI) GetTransactionBySenderAndNonce(ctx context.Context, address common.Address, nonce uint64) (result *common.Hash, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("ots_getTransactionBySenderAndNonce", a.connectionType, startTime, returnErr == nil)
+	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	var iterErr error
+	enabled, err := a.keeper.IterateTxAddressIndex(sdkCtx, address, 0, ^uint64(0), false, func(e keeper.TxAddressIndexEntry) bool {
+		receipt, err := a.keeper.GetReceipt(sdkCtx, e.TxHash)
+		if err != nil {
+			if !strings.Contains(err.Error(), "not found") {
+				iterErr = err
+				return false
+			}
+			return true
+		}
+		if common.HexToAddress(receipt.From) != address {
+			return true
+		}
+		height := int64(e.Height)
+		block, err := blockByNumberWithRetry(ctx, a.tmClient, &height, 1)
+		if err != nil {
+			iterErr = err
+			return false
+		}
+		if int(e.TxIndex) >= len(block.Block.Txs) {
+			return true
+		}
+		etx := getEthTxForTxBz(block.Block.Txs[e.TxIndex], a.txAPI.txConfig.TxDecoder())
+		if etx == nil || etx.Hash() != e.TxHash {
+			return true
+		}
+		if etx.Nonce() == nonce {
+			hash := e.TxHash
+			result = &hash
+		}
+		// nonces of a sender only increase along the chain
+		return etx.Nonce() < nonce
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrTxAddressIndexDisabled
+	}
+	return result, iterErr
+}
+
+func (a *OtterscanAPI) callTrace(ctx context.Context, hash common.Hash) (*otsCallFrame, error) {
+	tracer := "callTracer"
+	res, err := a.debugAPI.traceTransaction(ctx, hash, &tracers.TraceConfig{Tracer: &tracer})
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	frame := &otsCallFrame{}
+	if err := json.Unmarshal(bz, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+func (a *OtterscanAPI) blockDetails(ctx context.Context, block map[string]interface{}) (map[string]interface{}, error) {
+	number := rpc.BlockNumber(block["number"].(*hexutil.Big).ToInt().Int64())
+	receipts, err := a.blockAPI.GetBlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	totalFees := new(big.Int)
+	for _, receipt := range receipts {
+		gasUsed, _ := receipt["gasUsed"].(hexutil.Uint64)
+		gasPrice, _ := receipt["effectiveGasPrice"].(*hexutil.Big)
+		if gasPrice == nil {
+			continue
+		}
+		totalFees.Add(totalFees, new(big.Int).Mul(new(big.Int).SetUint64(uint64(gasUsed)), gasPrice.ToInt()))
+	}
+	details := copyFields(block)
+	txs, _ := block["transactions"].([]interface{})
+	details["transactionCount"] = len(txs)
+	details["logsBloom"] = nil
+	delete(details, "transactions")
+	zero := (*hexutil.Big)(big.NewInt(0))
+	return map[string]interface{}{
+		"block": details,
+		// Sei does not mint block or uncle rewards on the EVM side
+		"issuance": map[string]interface{}{
+			"blockReward": zero,
+			"uncleReward": zero,
+			"issuance":    zero,
+		},
+		"totalFees": (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// searchTxAddressIndex collects at least pageSize entries of address, or all entries of the
+// range if there are fewer, without splitting a block across pages. The second return
+// value is true if the range has no further entries.
+func (a *OtterscanAPI) searchTxAddressIndex(address common.Address, fromHeight, toHeight uint64, reverse bool, pageSize int) ([]keeper.TxAddressIndexEntry, bool, error) {
+	entries := []keeper.TxAddressIndexEntry{}
+	exhausted := true
+	enabled, err := a.keeper.IterateTxAddressIndex(a.ctxProvider(LatestCtxHeight), address, fromHeight, toHeight, reverse, func(e keeper.TxAddressIndexEntry) bool {
+		if len(entries) >= pageSize && e.Height != entries[len(entries)-1].Height {
+			exhausted = false
+			return false
+		}
+		entries = append(entries, e)
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if !enabled {
+		return nil, false, ErrTxAddressIndexDisabled
+	}
+	return entries, exhausted, nil
+}
+
+func (a *OtterscanAPI) searchResult(ctx context.Context, entries []keeper.TxAddressIndexEntry) (*OtsSearchResult, error) {
+	result := &OtsSearchResult{Txs: []*ethapi.RPCTransaction{}, Receipts: []map[string]interface{}{}}
+	blocks := map[uint64]*coretypes.ResultBlock{}
+	for _, e := range entries {
+		block, ok := blocks[e.Height]
+		if !ok {
+			height := int64(e.Height)
+			b, err := blockByNumberWithRetry(ctx, a.tmClient, &height, 1)
+			if err != nil {
+				return nil, err
+			}
+			block, blocks[e.Height] = b, b
+		}
+		tx, err := a.txAPI.getTransactionWithBlock(block, hexutil.Uint(e.TxIndex))
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || tx.Hash != e.TxHash {
+			continue
+		}
+		receipt, err := a.txAPI.GetTransactionReceipt(ctx, e.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			continue
+		}
+		r := copyFields(receipt)
+		r["timestamp"] = hexutil.Uint64(block.Block.Time.Unix())
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, r)
+	}
+	return result, nil
+}
+
+// copyFields returns a shallow copy of an encoded block or receipt, which may be shared
+// through the response cache.
+func copyFields(fields map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		res[k] = v
+	}
+	return res
+}
@@ -0,0 +1,77 @@
+package evmrpc_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOtsGetApiLevel(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "ots", "getApiLevel")
+	require.Equal(t, float64(evmrpc.OtsAPILevel), resObj["result"])
+}
+
+func TestOtsHasCode(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "ots", "hasCode", "0x1234567890123456789023456789012345678901", "latest")
+	require.Equal(t, true, resObj["result"])
+	resObj = sendRequestGoodWithNamespace(t, "ots", "hasCode", "0x0000000000000000000000000000000000000abc", "latest")
+	require.Equal(t, false, resObj["result"])
+}
+
+func TestOtsTraceTransaction(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "ots", "traceTransaction", DebugTraceHashHex)
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	entry := result[0].(map[string]interface{})
+	require.Equal(t, "CALL", entry["type"])
+	require.Equal(t, float64(0), entry["depth"])
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", entry["from"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", entry["to"])
+	require.Equal(t, "0x3e8", entry["value"])
+	require.Equal(t, "0x616263", entry["input"])
+
+	resObj = sendRequestGoodWithNamespace(t, "ots", "getInternalOperations", DebugTraceHashHex)
+	require.Empty(t, resObj["result"])
+}
+
+func TestOtsGetBlockDetails(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "ots", "getBlockDetails", "0x8")
+	result := resObj["result"].(map[string]interface{})
+	block := result["block"].(map[string]interface{})
+	require.Equal(t, "0x8", block["number"])
+	require.NotContains(t, block, "transactions")
+	require.Contains(t, block, "transactionCount")
+	require.Nil(t, block["logsBloom"])
+	require.Contains(t, result, "totalFees")
+	issuance := result["issuance"].(map[string]interface{})
+	require.Equal(t, "0x0", issuance["blockReward"])
+}
+
+func TestOtsGetBlockTransactions(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "ots", "getBlockTransactions", "0x8", 0, 10)
+	result := resObj["result"].(map[string]interface{})
+	fullBlock := result["fullblock"].(map[string]interface{})
+	txs := fullBlock["transactions"].([]interface{})
+	require.NotEmpty(t, txs)
+	receipts := result["receipts"].([]interface{})
+	require.Len(t, receipts, len(txs))
+	for i, tx := range txs {
+		receipt := receipts[i].(map[string]interface{})
+		require.Equal(t, tx.(map[string]interface{})["hash"], receipt["transactionHash"])
+		require.Nil(t, receipt["logs"])
+	}
+
+	// pages past the end of the block are empty
+	resObj = sendRequestGoodWithNamespace(t, "ots", "getBlockTransactions", "0x8", 100, 10)
+	result = resObj["result"].(map[string]interface{})
+	require.Empty(t, result["fullblock"].(map[string]interface{})["transactions"])
+	require.Empty(t, result["receipts"])
+}
+
+func TestOtsSearchTransactionsIndexDisabled(t *testing.T) {
+	for _, method := range []string{"searchTransactionsBefore", "searchTransactionsAfter"} {
+		resObj := sendRequestGoodWithNamespace(t, "ots", method, "0x1234567890123456789012345678901234567890", 0, 10)
+		require.Equal(t, evmrpc.ErrTxAddressIndexDisabled.Error(), resObj["error"].(map[string]interface{})["message"])
+	}
+}
@@ -0,0 +1,366 @@
+package evmrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// RateLimitErrorCode is the JSON-RPC error code returned for rejected requests, as used
+	// by EIP-1474 for "limit exceeded".
+	RateLimitErrorCode = -32005
+
+	// maxRateLimitBuckets bounds the number of clients whose buckets are kept in memory.
+	maxRateLimitBuckets = 100_000
+	// maxRateLimitBodySize matches the request size limit of the go-ethereum RPC server.
+	maxRateLimitBodySize = 5 * 1024 * 1024
+
+	rateLimitReasonIP          = "ip"
+	rateLimitReasonJWTSubject  = "jwt_subject"
+	rateLimitReasonTrace       = "trace_concurrency"
+	rateLimitReasonSimulation  = "simulation_concurrency"
+	rateLimitWSConnectEndpoint = "ws_connect"
+)
+
+// tracingMethodPrefixes and simulationMethods classify the expensive methods that are
+// subject to the concurrency caps.
+var (
+	tracingMethodPrefixes = []string{"debug_trace", "trace_", "sei_trace"}
+	simulationMethods     = map[string]struct{}{
+		"eth_call":                  {},
+		"eth_estimateGas":           {},
+		"eth_estimateGasAfterCalls": {},
+		"eth_createAccessList":      {},
+		"eth_simulateV1":            {},
+	}
+)
+
+type jwtSubjectKey struct{}
+
+// RateLimitConfig configures request budgeting for the EVM RPC servers. Every request is
+// charged the cost of its methods (1 unless overridden in MethodCosts) against a token
+// bucket. Requests authenticated with a JWT are charged against a bucket for the token's
+// subject if PerJWTSubject is set, and against a bucket for the client IP otherwise. Zero
+// values disable the corresponding limit.
+type RateLimitConfig struct {
+	PerIP                    float64
+	PerIPBurst               int
+	PerJWTSubject            float64
+	PerJWTSubjectBurst       int
+	MethodCosts              map[string]int
+	MaxConcurrentTraces      int
+	MaxConcurrentSimulations int
+}
+
+type RateLimiter struct {
+	config         RateLimitConfig
+	ipBuckets      *lru.Cache[string, *rate.Limiter]
+	subjectBuckets *lru.Cache[string, *rate.Limiter]
+	traceSem       chan struct{}
+	simulationSem  chan struct{}
+}
+
+// NewRateLimiter returns nil if the config does not enable any limit. Method costs above
+// the burst of an enabled bucket are rejected since such calls could never be allowed.
+func NewRateLimiter(config RateLimitConfig) (*RateLimiter, error) {
+	if config.PerIP <= 0 && config.PerJWTSubject <= 0 && config.MaxConcurrentTraces <= 0 && config.MaxConcurrentSimulations <= 0 {
+		return nil, nil
+	}
+	for method, cost := range config.MethodCosts {
+		if config.PerIP > 0 && cost > burstOf(config.PerIP, config.PerIPBurst) {
+			return nil, fmt.Errorf("cost %d of %s exceeds the per-IP burst of %d", cost, method, burstOf(config.PerIP, config.PerIPBurst))
+		}
+		if config.PerJWTSubject > 0 && cost > burstOf(config.PerJWTSubject, config.PerJWTSubjectBurst) {
+			return nil, fmt.Errorf("cost %d of %s exceeds the per-JWT-subject burst of %d", cost, method, burstOf(config.PerJWTSubject, config.PerJWTSubjectBurst))
+		}
+	}
+	l := &RateLimiter{config: config}
+	l.ipBuckets, _ = lru.New[string, *rate.Limiter](maxRateLimitBuckets)
+	l.subjectBuckets, _ = lru.New[string, *rate.Limiter](maxRateLimitBuckets)
+	if config.MaxConcurrentTraces > 0 {
+		l.traceSem = make(chan struct{}, config.MaxConcurrentTraces)
+	}
+	if config.MaxConcurrentSimulations > 0 {
+		l.simulationSem = make(chan struct{}, config.MaxConcurrentSimulations)
+	}
+	return l, nil
+}
+
+// NewRateLimiterFromConfig builds the rate limiter configured in the [evm] section of the
+// app config, or returns nil if no limit is configured. The same limiter should be shared
+// by the HTTP and websocket servers so that clients have a single budget.
+func NewRateLimiterFromConfig(config Config) (*RateLimiter, error) {
+	costs, err := ParseMethodCosts(config.RateLimitMethodCosts)
+	if err != nil {
+		return nil, err
+	}
+	return NewRateLimiter(RateLimitConfig{
+		PerIP:                    config.RateLimitPerIP,
+		PerIPBurst:               config.RateLimitPerIPBurst,
+		PerJWTSubject:            config.RateLimitPerJWTSubject,
+		PerJWTSubjectBurst:       config.RateLimitPerJWTSubjectBurst,
+		MethodCosts:              costs,
+		MaxConcurrentTraces:      config.MaxConcurrentTraceCalls,
+		MaxConcurrentSimulations: config.MaxConcurrentSimulationCalls,
+	})
+}
+
+// ParseMethodCosts parses entries of the form "method=cost". A method ending with "*"
+// matches every method with that prefix.
+func ParseMethodCosts(entries []string) (map[string]int, error) {
+	costs := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid method cost %q, expected method=cost", entry)
+		}
+		var cost int
+		if _, err := fmt.Sscanf(strings.TrimSpace(parts[1]), "%d", &cost); err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid cost in method cost %q", entry)
+		}
+		costs[strings.TrimSpace(parts[0])] = cost
+	}
+	return costs, nil
+}
+
+func (l *RateLimiter) methodCost(method string) int {
+	if cost, ok := l.config.MethodCosts[method]; ok {
+		return cost
+	}
+	// the longest matching prefix wins
+	cost, matched := 1, 0
+	for pattern, c := range l.config.MethodCosts {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && len(prefix) >= matched && strings.HasPrefix(method, prefix) {
+			cost, matched = c, len(prefix)
+		}
+	}
+	return cost
+}
+
+// requestCost returns the methods of reqs and their total cost. Malformed requests cost 1.
+func (l *RateLimiter) requestCost(reqs []rpcRequestHeader) ([]string, int) {
+	methods := make([]string, 0, len(reqs))
+	cost := 0
+	for _, req := range reqs {
+		methods = append(methods, req.Method)
+		cost += l.methodCost(req.Method)
+	}
+	if len(reqs) == 0 {
+		cost = 1
+	}
+	return methods, cost
+}
+
+// allow charges cost against the bucket of the request's client and returns the reason
+// for a rejection, or an empty string if the request is allowed.
+func (l *RateLimiter) allow(r *http.Request, cost int) string {
+	if subject, ok := r.Context().Value(jwtSubjectKey{}).(string); ok && subject != "" && l.config.PerJWTSubject > 0 {
+		if !bucket(l.subjectBuckets, subject, l.config.PerJWTSubject, l.config.PerJWTSubjectBurst).AllowN(time.Now(), cost) {
+			return rateLimitReasonJWTSubject
+		}
+		return ""
+	}
+	if l.config.PerIP > 0 && !bucket(l.ipBuckets, clientIP(r), l.config.PerIP, l.config.PerIPBurst).AllowN(time.Now(), cost) {
+		return rateLimitReasonIP
+	}
+	return ""
+}
+
+// acquire reserves a concurrency slot for each class of expensive methods in methods and
+// returns a function that releases them, or the reason for a rejection.
+func (l *RateLimiter) acquire(methods []string) (func(), string) {
+	var trace, simulation bool
+	for _, method := range methods {
+		trace = trace || isTracingMethod(method)
+		_, isSimulation := simulationMethods[method]
+		simulation = simulation || isSimulation
+	}
+	var acquired []chan struct{}
+	release := func() {
+		for _, sem := range acquired {
+			<-sem
+		}
+	}
+	for _, class := range []struct {
+		needed bool
+		sem    chan struct{}
+		reason string
+	}{
+		{trace, l.traceSem, rateLimitReasonTrace},
+		{simulation, l.simulationSem, rateLimitReasonSimulation},
+	} {
+		if !class.needed || class.sem == nil {
+			continue
+		}
+		select {
+		case class.sem <- struct{}{}:
+			acquired = append(acquired, class.sem)
+		default:
+			release()
+			return nil, class.reason
+		}
+	}
+	return release, ""
+}
+
+func bucket(buckets *lru.Cache[string, *rate.Limiter], key string, limit float64, burst int) *rate.Limiter {
+	if b, ok := buckets.Get(key); ok {
+		return b
+	}
+	b := rate.NewLimiter(rate.Limit(limit), burstOf(limit, burst))
+	buckets.Add(key, b)
+	return b
+}
+
+// burstOf returns the burst of a bucket, which defaults to one second worth of tokens.
+func burstOf(limit float64, burst int) int {
+	if burst > 0 {
+		return burst
+	}
+	if int(limit) < 1 {
+		return 1
+	}
+	return int(limit)
+}
+
+func isTracingMethod(method string) bool {
+	for _, prefix := range tracingMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// rpcRequestHeader holds the fields of a JSON-RPC request needed for rate limiting.
+type rpcRequestHeader struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+type rateLimitHandler struct {
+	limiter        *RateLimiter
+	connectionType ConnectionType
+	next           http.Handler
+}
+
+func newRateLimitHandler(limiter *RateLimiter, connectionType ConnectionType, next http.Handler) http.Handler {
+	if limiter == nil {
+		return next
+	}
+	return &rateLimitHandler{limiter: limiter, connectionType: connectionType, next: next}
+}
+
+// ServeHTTP charges HTTP requests the cost of every method they call. Websocket
+// connections are charged once when they are opened, and their messages are charged by
+// the websocket handler.
+func (h *rateLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.connectionType == ConnectionTypeWS {
+		if reason := h.limiter.allow(r, 1); reason != "" {
+			recordRateLimitMetrics(rateLimitWSConnectEndpoint, h.connectionType, reason)
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRateLimitBodySize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxRateLimitBodySize {
+		http.Error(w, fmt.Sprintf("request body larger than %d bytes", maxRateLimitBodySize), http.StatusRequestEntityTooLarge)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	reqs, isBatch := parseRPCRequestHeaders(body)
+	methods, cost := h.limiter.requestCost(reqs)
+	if reason := h.limiter.allow(r, cost); reason != "" {
+		h.reject(w, reqs, methods, isBatch, reason, "rate limit exceeded")
+		return
+	}
+	release, reason := h.limiter.acquire(methods)
+	if reason != "" {
+		h.reject(w, reqs, methods, isBatch, reason, "too many concurrent requests")
+		return
+	}
+	defer release()
+	h.next.ServeHTTP(w, r)
+}
+
+func (h *rateLimitHandler) reject(w http.ResponseWriter, reqs []rpcRequestHeader, methods []string, isBatch bool, reason string, message string) {
+	for _, method := range methods {
+		recordRateLimitMetrics(method, h.connectionType, reason)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(rateLimitResponse(reqs, isBatch, message))
+}
+
+// rateLimitResponse returns the JSON-RPC error response to a rejected (batch) request.
+func rateLimitResponse(reqs []rpcRequestHeader, isBatch bool, message string) interface{} {
+	responses := make([]map[string]interface{}, 0, len(reqs))
+	for _, req := range reqs {
+		id := req.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses = append(responses, map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      id,
+			"error":   map[string]interface{}{"code": RateLimitErrorCode, "message": message},
+		})
+	}
+	if isBatch {
+		return responses
+	}
+	if len(responses) == 0 {
+		return map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      nil,
+			"error":   map[string]interface{}{"code": RateLimitErrorCode, "message": message},
+		}
+	}
+	return responses[0]
+}
+
+// parseRPCRequestHeaders extracts the ids and methods of a single or batch JSON-RPC
+// request. Malformed bodies yield no requests and are left for the RPC server to reject.
+func parseRPCRequestHeaders(body []byte) ([]rpcRequestHeader, bool) {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var reqs []rpcRequestHeader
+		if err := json.Unmarshal(trimmed, &reqs); err != nil {
+			return nil, true
+		}
+		return reqs, true
+	}
+	var req rpcRequestHeader
+	if err := json.Unmarshal(trimmed, &req); err != nil {
+		return nil, false
+	}
+	return []rpcRequestHeader{req}, false
+}
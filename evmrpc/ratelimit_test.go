@@ -0,0 +1,136 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitPerIP(t *testing.T) {
+	costs, err := evmrpc.ParseMethodCosts([]string{"test_greet=2"})
+	require.Nil(t, err)
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 0.001, PerIPBurst: 3, MethodCosts: costs})
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	// the first call costs 2 of the 3 tokens
+	resp := rpcRequest(t, url, "test_greet")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// the second call exceeds the budget
+	resp = rpcRequest(t, url, "test_greet")
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	res := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(body, &res))
+	require.Equal(t, float64(1), res["id"])
+	require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+
+	// a call with the default cost of 1 still fits
+	resp = rpcRequest(t, url, testMethod)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestRateLimitBatch(t *testing.T) {
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 0.001, PerIPBurst: 2})
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+	url := "http://" + srv.ListenAddr()
+
+	// every request in the batch is charged
+	resp := batchRpcRequest(t, url, []string{"test_greet", "test_greet", "test_greet"})
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	res := []map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(body, &res))
+	require.Len(t, res, 3)
+	for _, r := range res {
+		require.Equal(t, float64(evmrpc.RateLimitErrorCode), r["error"].(map[string]interface{})["code"])
+	}
+
+	resp2 := batchRpcRequest(t, url, []string{"test_greet", "test_greet"})
+	require.Equal(t, http.StatusOK, resp2.StatusCode)
+	resp2.Body.Close()
+}
+
+func TestParseMethodCosts(t *testing.T) {
+	costs, err := evmrpc.ParseMethodCosts(evmrpc.DefaultRateLimitMethodCosts)
+	require.Nil(t, err)
+	require.Equal(t, 10, costs["eth_getLogs"])
+	require.Equal(t, 20, costs["debug_trace*"])
+
+	_, err = evmrpc.ParseMethodCosts([]string{"eth_getLogs"})
+	require.NotNil(t, err)
+	_, err = evmrpc.ParseMethodCosts([]string{"eth_getLogs=abc"})
+	require.NotNil(t, err)
+	_, err = evmrpc.ParseMethodCosts([]string{"eth_getLogs=-1"})
+	require.NotNil(t, err)
+}
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{MethodCosts: map[string]int{"eth_call": 5}})
+	require.Nil(t, err)
+	require.Nil(t, limiter)
+}
+
+func TestNewRateLimiterCostAboveBurst(t *testing.T) {
+	// a call costing more than the burst could never be served
+	_, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 5, MethodCosts: map[string]int{"eth_getLogs": 10}})
+	require.NotNil(t, err)
+	_, err = evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 5, PerIPBurst: 10, MethodCosts: map[string]int{"eth_getLogs": 10}})
+	require.Nil(t, err)
+	_, err = evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerJWTSubject: 1, MethodCosts: map[string]int{"eth_getLogs": 2}})
+	require.NotNil(t, err)
+}
+
+func TestRateLimitBodyTooLarge(t *testing.T) {
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 1000})
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, false, &evmrpc.WsConfig{}, nil)
+	defer srv.Stop()
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"test_greet","params":["` + strings.Repeat("a", 5*1024*1024) + `"]}`
+	resp := baseRpcRequest(t, "http://"+srv.ListenAddr(), body)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+}
+
+func TestRateLimitWebsocketMessages(t *testing.T) {
+	limiter, err := evmrpc.NewRateLimiter(evmrpc.RateLimitConfig{PerIP: 0.001, PerIPBurst: 3})
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{}, true, &evmrpc.WsConfig{RPCEndpointConfig: evmrpc.RPCEndpointConfig{RateLimiter: limiter}}, nil)
+	defer srv.Stop()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+srv.ListenAddr(), nil)
+	require.Nil(t, err)
+	defer conn.Close()
+
+	// opening the connection costs 1 token, which leaves room for two messages
+	for i := 1; i <= 3; i++ {
+		require.Nil(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": i, "method": "rpc_modules"}))
+		res := map[string]interface{}{}
+		require.Nil(t, conn.ReadJSON(&res))
+		require.Equal(t, float64(i), res["id"])
+		if i < 3 {
+			require.Nil(t, res["error"])
+			continue
+		}
+		// the connection stays open but the message is rejected
+		require.Equal(t, float64(evmrpc.RateLimitErrorCode), res["error"].(map[string]interface{})["code"])
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package evmrpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsReadBuffer       = 1024
+	wsWriteBuffer      = 1024
+	wsPingInterval     = 30 * time.Second
+	wsPingWriteTimeout = 5 * time.Second
+	wsPongTimeout      = 30 * time.Second
+	wsDefaultReadLimit = 32 * 1024 * 1024
+)
+
+var wsBufferPool = new(sync.Pool)
+
+// newRateLimitedWSHandler serves JSON-RPC over websocket like rpc.Server.WebsocketHandler,
+// except that every message is charged against the limiter before it reaches srv. Rejected
+// messages are answered with rate limit errors and the connection stays open. Concurrency
+// caps only apply to HTTP requests.
+func newRateLimitedWSHandler(srv *rpc.Server, allowedOrigins []string, limiter *RateLimiter) http.Handler {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  wsReadBuffer,
+		WriteBufferSize: wsWriteBuffer,
+		WriteBufferPool: wsBufferPool,
+		CheckOrigin:     wsHandshakeValidator(allowedOrigins),
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		wc := &rateLimitedWSConn{
+			conn:         conn,
+			limiter:      limiter,
+			req:          r,
+			pongReceived: make(chan struct{}),
+			closed:       make(chan struct{}),
+		}
+		conn.SetReadLimit(wsDefaultReadLimit)
+		conn.SetPongHandler(func(string) error {
+			select {
+			case wc.pongReceived <- struct{}{}:
+			case <-wc.closed:
+			}
+			return nil
+		})
+		go wc.pingLoop()
+		srv.ServeCodec(rpc.NewFuncCodec(wc, wc.encode, wc.decode), 0)
+		close(wc.closed)
+	})
+}
+
+// rateLimitedWSConn is the transport of a rate limited websocket connection. All writes
+// go through mu since rate limit errors are written from the reading goroutine.
+type rateLimitedWSConn struct {
+	conn    *websocket.Conn
+	limiter *RateLimiter
+	req     *http.Request
+
+	mu           sync.Mutex
+	pongReceived chan struct{}
+	closed       chan struct{}
+}
+
+func (wc *rateLimitedWSConn) Close() error {
+	return wc.conn.Close()
+}
+
+func (wc *rateLimitedWSConn) SetWriteDeadline(t time.Time) error {
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	return wc.conn.SetWriteDeadline(t)
+}
+
+func (wc *rateLimitedWSConn) RemoteAddr() string {
+	return wc.conn.RemoteAddr().String()
+}
+
+func (wc *rateLimitedWSConn) encode(v interface{}, _ bool) error {
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+	return wc.conn.WriteJSON(v)
+}
+
+// decode reads the next message that fits into the client's budget.
+func (wc *rateLimitedWSConn) decode(v interface{}) error {
+	for {
+		var msg json.RawMessage
+		if err := wc.conn.ReadJSON(&msg); err != nil {
+			return err
+		}
+		reqs, isBatch := parseRPCRequestHeaders(msg)
+		methods, cost := wc.limiter.requestCost(reqs)
+		reason := wc.limiter.allow(wc.req, cost)
+		if reason == "" {
+			*(v.(*json.RawMessage)) = msg
+			return nil
+		}
+		for _, method := range methods {
+			recordRateLimitMetrics(method, ConnectionTypeWS, reason)
+		}
+		if err := wc.encode(rateLimitResponse(reqs, isBatch, "rate limit exceeded"), true); err != nil {
+			return err
+		}
+	}
+}
+
+// pingLoop sends periodic ping frames and closes the connection if no pong arrives.
+func (wc *rateLimitedWSConn) pingLoop() {
+	pingTimer := time.NewTicker(wsPingInterval)
+	defer pingTimer.Stop()
+	for {
+		select {
+		case <-wc.closed:
+			return
+		case <-pingTimer.C:
+			wc.mu.Lock()
+			_ = wc.conn.SetWriteDeadline(time.Now().Add(wsPingWriteTimeout))
+			_ = wc.conn.WriteMessage(websocket.PingMessage, nil)
+			_ = wc.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+			wc.mu.Unlock()
+		case <-wc.pongReceived:
+			_ = wc.conn.SetReadDeadline(time.Time{})
+		}
+	}
+}
+
+// wsHandshakeValidator returns a handler that verifies the origin during the
+// websocket upgrade process. When a '*' is specified as an allowed origins all
+// connections are accepted.
+func wsHandshakeValidator(allowedOrigins []string) func(*http.Request) bool {
+	origins := []string{}
+	allowAllOrigins := false
+
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			allowAllOrigins = true
+		}
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	// allow localhost if no allowedOrigins are specified.
+	if len(origins) == 0 {
+		origins = append(origins, "http://localhost")
+		if hostname, err := os.Hostname(); err == nil {
+			origins = append(origins, "http://"+hostname)
+		}
+	}
+
+	return func(req *http.Request) bool {
+		// Skip origin verification if no Origin header is present. The origin check
+		// is supposed to protect against browser based attacks. Browsers always set
+		// Origin. Non-browser software can put anything in origin and checking it doesn't
+		// provide additional security.
+		if _, ok := req.Header["Origin"]; !ok {
+			return true
+		}
+		// Verify origin against allow list.
+		origin := strings.ToLower(req.Header.Get("Origin"))
+		if allowAllOrigins {
+			return true
+		}
+		for _, allowed := range origins {
+			if ruleAllowsOrigin(allowed, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ruleAllowsOrigin(allowedOrigin string, browserOrigin string) bool {
+	allowedScheme, allowedHostname, allowedPort, err := parseOriginURL(allowedOrigin)
+	if err != nil {
+		return false
+	}
+	browserScheme, browserHostname, browserPort, err := parseOriginURL(browserOrigin)
+	if err != nil {
+		return false
+	}
+	if allowedScheme != "" && allowedScheme != browserScheme {
+		return false
+	}
+	if allowedHostname != "" && allowedHostname != browserHostname {
+		return false
+	}
+	if allowedPort != "" && allowedPort != browserPort {
+		return false
+	}
+	return true
+}
+
+func parseOriginURL(origin string) (string, string, string, error) {
+	parsedURL, err := url.Parse(strings.ToLower(origin))
+	if err != nil {
+		return "", "", "", err
+	}
+	var scheme, hostname, port string
+	if strings.Contains(origin, "://") {
+		scheme = parsedURL.Scheme
+		hostname = parsedURL.Hostname()
+		port = parsedURL.Port()
+	} else {
+		scheme = ""
+		hostname = parsedURL.Scheme
+		port = parsedURL.Opaque
+		if hostname == "" {
+			hostname = origin
+		}
+	}
+	return scheme, hostname, port, nil
+}
@@ -55,7 +55,8 @@ type WsConfig struct {
 }
 
 type RPCEndpointConfig struct {
-	JwtSecret              []byte // optional JWT secret
+	JwtSecret              []byte       // optional JWT secret
+	RateLimiter            *RateLimiter // optional request budgeting
 	batchItemLimit         int
 	batchResponseSizeLimit int
 }
@@ -304,7 +305,7 @@ func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 	}
 	h.HTTPConfig = config
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
+		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret, config.RateLimiter),
 		server:  srv,
 	})
 	return nil
@@ -336,8 +337,12 @@ func (h *HTTPServer) EnableWS(apis []rpc.API, config WsConfig) error {
 		return err
 	}
 	h.WsConfig = config
+	wsHandler := srv.WebsocketHandler(config.Origins)
+	if config.RateLimiter != nil {
+		wsHandler = newRateLimitedWSHandler(srv, config.Origins, config.RateLimiter)
+	}
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.JwtSecret),
+		Handler: NewWSHandlerStack(wsHandler, config.JwtSecret, config.RateLimiter),
 		server:  srv,
 	})
 	return nil
@@ -376,10 +381,12 @@ func (h *HTTPServer) wsAllowed() bool {
 }
 
 // NewHTTPHandlerStack returns wrapped http-related handlers
-func NewHTTPHandlerStack(srv http.Handler, cors []string, vhosts []string, JwtSecret []byte) http.Handler {
+func NewHTTPHandlerStack(srv http.Handler, cors []string, vhosts []string, JwtSecret []byte, limiter *RateLimiter) http.Handler {
 	// Wrap the CORS-handler within a host-handler
 	handler := newCorsHandler(srv, cors)
 	handler = newVHostHandler(vhosts, handler)
+	// the rate limiter runs after JWT authentication so that it can see the token subject
+	handler = newRateLimitHandler(limiter, ConnectionTypeHTTP, handler)
 	if len(JwtSecret) != 0 {
 		handler = newJWTHandler(JwtSecret, handler)
 	}
@@ -387,11 +394,12 @@ func NewHTTPHandlerStack(srv http.Handler, cors []string, vhosts []string, JwtSe
 }
 
 // NewWSHandlerStack returns a wrapped ws-related handler.
-func NewWSHandlerStack(srv http.Handler, JwtSecret []byte) http.Handler {
+func NewWSHandlerStack(srv http.Handler, JwtSecret []byte, limiter *RateLimiter) http.Handler {
+	handler := newRateLimitHandler(limiter, ConnectionTypeWS, srv)
 	if len(JwtSecret) != 0 {
-		return NewWSConnectionHandler(newJWTHandler(JwtSecret, srv))
+		return NewWSConnectionHandler(newJWTHandler(JwtSecret, handler))
 	}
-	return NewWSConnectionHandler(srv)
+	return NewWSConnectionHandler(handler)
 }
 
 func newCorsHandler(srv http.Handler, allowedOrigins []string) http.Handler {
@@ -0,0 +1,206 @@
+package evmrpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SeiCallTracerName is the name of a call tracer that, in addition to the EVM call frames
+// reported by geth's callTracer, reports the CosmWasm executions and queries made by
+// precompiles, the Cosmos events emitted by the modules precompiles call into, and the
+// EVM calls made back into the EVM by CosmWasm contracts.
+const SeiCallTracerName = "seiCallTracer"
+
+// CosmosEventFrameType is the type of the frames of Cosmos events.
+const CosmosEventFrameType = "COSMOS_EVENT"
+
+type seiCallFrame struct {
+	Type       string            `json:"type"`
+	From       string            `json:"from,omitempty"`
+	To         string            `json:"to,omitempty"`
+	Value      *hexutil.Big      `json:"value,omitempty"`
+	Gas        *hexutil.Uint64   `json:"gas,omitempty"`
+	GasUsed    *hexutil.Uint64   `json:"gasUsed,omitempty"`
+	Input      hexutil.Bytes     `json:"input,omitempty"`
+	Msg        json.RawMessage   `json:"msg,omitempty"`
+	Output     hexutil.Bytes     `json:"output,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Event      string            `json:"event,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Calls      []*seiCallFrame   `json:"calls,omitempty"`
+}
+
+type seiCallTracer struct {
+	hooks *tracing.Hooks
+	// frames that have been entered but not exited, outermost first
+	stack     []*seiCallFrame
+	root      *seiCallFrame
+	gasLimit  uint64
+	interrupt atomic.Bool
+	reason    error
+}
+
+var _ types.CrossVMTracer = &seiCallTracer{}
+
+func newSeiCallTracer(_ *tracers.Context, _ json.RawMessage) (*tracers.Tracer, error) {
+	t := &seiCallTracer{}
+	t.hooks = &tracing.Hooks{
+		OnTxStart: t.OnTxStart,
+		OnEnter:   t.OnEnter,
+		OnExit:    t.OnExit,
+		OnTxEnd:   t.OnTxEnd,
+	}
+	types.RegisterCrossVMTracer(t.hooks, t)
+	return &tracers.Tracer{
+		Hooks:     t.hooks,
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *seiCallTracer) OnTxStart(_ *tracing.VMContext, tx *ethtypes.Transaction, _ common.Address) {
+	t.gasLimit = tx.Gas()
+}
+
+func (t *seiCallTracer) OnEnter(_ int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+	if t.root == nil && t.gasLimit > 0 {
+		// like callTracer, the top call reports the gas limit of the tx rather than the
+		// gas left after intrinsic gas
+		gas = t.gasLimit
+	}
+	gasHex := hexutil.Uint64(gas)
+	frame := &seiCallFrame{
+		Type:  vm.OpCode(typ).String(),
+		From:  from.Hex(),
+		To:    to.Hex(),
+		Input: common.CopyBytes(input),
+		Gas:   &gasHex,
+	}
+	if value != nil {
+		frame.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	t.push(frame)
+}
+
+func (t *seiCallTracer) OnExit(_ int, output []byte, gasUsed uint64, err error, _ bool) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := t.pop()
+	if frame == nil {
+		return
+	}
+	gasUsedHex := hexutil.Uint64(gasUsed)
+	frame.GasUsed = &gasUsedHex
+	frame.Output = common.CopyBytes(output)
+	if err != nil {
+		frame.Error = err.Error()
+	}
+}
+
+func (t *seiCallTracer) OnTxEnd(receipt *ethtypes.Receipt, _ error) {
+	types.UnregisterCrossVMTracer(t.hooks)
+	if receipt != nil && t.root != nil {
+		gasUsed := hexutil.Uint64(receipt.GasUsed)
+		t.root.GasUsed = &gasUsed
+	}
+}
+
+func (t *seiCallTracer) OnCrossVMEnter(f types.CrossVMFrame) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := &seiCallFrame{Type: f.Type, From: f.From, To: f.To}
+	if json.Valid(f.Input) {
+		frame.Msg = common.CopyBytes(f.Input)
+	} else {
+		frame.Input = common.CopyBytes(f.Input)
+	}
+	if f.Value != nil && f.Value.Sign() != 0 {
+		frame.Value = (*hexutil.Big)(new(big.Int).Set(f.Value))
+	}
+	t.push(frame)
+}
+
+func (t *seiCallTracer) OnCrossVMExit(output []byte, err error) {
+	if t.interrupt.Load() {
+		return
+	}
+	frame := t.pop()
+	if frame == nil {
+		return
+	}
+	frame.Output = common.CopyBytes(output)
+	if err != nil {
+		frame.Error = err.Error()
+	}
+}
+
+func (t *seiCallTracer) OnCosmosEvent(event sdk.Event) {
+	if t.interrupt.Load() || len(t.stack) == 0 {
+		return
+	}
+	frame := &seiCallFrame{Type: CosmosEventFrameType, Event: event.Type, Attributes: map[string]string{}}
+	for _, attr := range event.Attributes {
+		frame.Attributes[string(attr.Key)] = string(attr.Value)
+	}
+	parent := t.stack[len(t.stack)-1]
+	parent.Calls = append(parent.Calls, frame)
+}
+
+// NestedHooks returns the same hooks as the outer EVM: frames are nested by the order in
+// which they are entered and exited rather than by depth.
+func (t *seiCallTracer) NestedHooks() *tracing.Hooks {
+	return &tracing.Hooks{OnEnter: t.OnEnter, OnExit: t.OnExit}
+}
+
+func (t *seiCallTracer) GetResult() (json.RawMessage, error) {
+	types.UnregisterCrossVMTracer(t.hooks)
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	if t.root == nil {
+		return json.RawMessage(`{}`), nil
+	}
+	return json.Marshal(t.root)
+}
+
+func (t *seiCallTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+	types.UnregisterCrossVMTracer(t.hooks)
+}
+
+func (t *seiCallTracer) push(frame *seiCallFrame) {
+	if len(t.stack) == 0 {
+		if t.root == nil {
+			t.root = frame
+		}
+	} else {
+		parent := t.stack[len(t.stack)-1]
+		parent.Calls = append(parent.Calls, frame)
+	}
+	t.stack = append(t.stack, frame)
+}
+
+func (t *seiCallTracer) pop() *seiCallFrame {
+	if len(t.stack) == 0 {
+		return nil
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	return frame
+}
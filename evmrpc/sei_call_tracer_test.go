@@ -0,0 +1,73 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceTransactionSeiCallTracer(t *testing.T) {
+	args := map[string]interface{}{"tracer": evmrpc.SeiCallTracerName}
+	resObj := sendRequestGoodWithNamespace(t, "debug", "traceTransaction", DebugTraceHashHex, args)
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, "0x5B4eba929F3811980f5AE0c5D04fa200f837DF4E", result["from"])
+	require.Equal(t, "0x30d40", result["gas"])
+	require.Equal(t, "0x616263", result["input"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", result["to"])
+	require.Equal(t, "CALL", result["type"])
+	require.Equal(t, "0x3e8", result["value"])
+}
+
+func TestSeiCallTracerCrossVMFrames(t *testing.T) {
+	tracer, err := tracers.DefaultDirectory.New(evmrpc.SeiCallTracerName, &tracers.Context{}, nil)
+	require.Nil(t, err)
+	crossVMTracer := types.GetCrossVMTracerForHooks(tracer.Hooks)
+	require.NotNil(t, crossVMTracer)
+
+	sender, pointer, wasmd := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x1002")
+	tracer.OnEnter(0, byte(vm.CALL), sender, pointer, []byte{1}, 100000, big.NewInt(0))
+	tracer.OnEnter(1, byte(vm.DELEGATECALL), pointer, wasmd, []byte{2}, 90000, nil)
+	crossVMTracer.OnCrossVMEnter(types.CrossVMFrame{Type: types.CrossVMWasmExecute, From: "sei1sender", To: "sei1contract", Input: []byte(`{"transfer":{}}`)})
+	// the contract calls back into the EVM from a new EVM
+	nested := crossVMTracer.NestedHooks()
+	nested.OnEnter(0, byte(vm.CALL), sender, common.HexToAddress("0x3"), []byte{3}, 50000, big.NewInt(0))
+	nested.OnExit(0, []byte{4}, 21000, errors.New("execution reverted"), true)
+	crossVMTracer.OnCrossVMExit([]byte("ok"), nil)
+	crossVMTracer.OnCosmosEvent(sdk.NewEvent("transfer", sdk.NewAttribute("recipient", "sei1recipient")))
+	tracer.OnExit(1, []byte{5}, 30000, nil, false)
+	tracer.OnExit(0, []byte{6}, 40000, nil, false)
+	tracer.OnTxEnd(nil, nil)
+	require.Nil(t, types.GetCrossVMTracerForHooks(tracer.Hooks))
+
+	bz, err := tracer.GetResult()
+	require.Nil(t, err)
+	result := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(bz, &result))
+	require.Equal(t, "CALL", result["type"])
+	require.Equal(t, "0x9c40", result["gasUsed"])
+	precompileCall := result["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "DELEGATECALL", precompileCall["type"])
+	calls := precompileCall["calls"].([]interface{})
+	require.Len(t, calls, 2)
+	wasmCall := calls[0].(map[string]interface{})
+	require.Equal(t, types.CrossVMWasmExecute, wasmCall["type"])
+	require.Equal(t, "sei1contract", wasmCall["to"])
+	require.Equal(t, map[string]interface{}{"transfer": map[string]interface{}{}}, wasmCall["msg"])
+	require.Equal(t, "0x6f6b", wasmCall["output"])
+	nestedCall := wasmCall["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "CALL", nestedCall["type"])
+	require.Equal(t, "execution reverted", nestedCall["error"])
+	event := calls[1].(map[string]interface{})
+	require.Equal(t, evmrpc.CosmosEventFrameType, event["type"])
+	require.Equal(t, "transfer", event["event"])
+	require.Equal(t, map[string]interface{}{"recipient": "sei1recipient"}, event["attributes"])
+}
@@ -29,7 +29,7 @@ type SendAPI struct {
 	sendConfig     *SendConfig
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
-	homeDir        string
+	signer         Signer
 	backend        *Backend
 	connectionType ConnectionType
 }
@@ -38,7 +38,7 @@ type SendConfig struct {
 	slow bool
 }
 
-func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, homeDir string, simulateConfig *SimulateConfig, app *baseapp.BaseApp,
+func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, signer Signer, simulateConfig *SimulateConfig, app *baseapp.BaseApp,
 	antehandler sdk.AnteHandler, connectionType ConnectionType) *SendAPI {
 	return &SendAPI{
 		tmClient:       tmClient,
@@ -46,7 +46,7 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 		sendConfig:     sendConfig,
 		keeper:         k,
 		ctxProvider:    ctxProvider,
-		homeDir:        homeDir,
+		signer:         signer,
 		backend:        NewBackend(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, app, antehandler),
 		connectionType: connectionType,
 	}
@@ -55,6 +55,27 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (hash common.Hash, err error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_sendRawTransaction", s.connectionType, startTime, err == nil)
+	return s.sendRawTransaction(ctx, input, "")
+}
+
+// SendRawTransactionConditional submits a transaction that is only executed if the given
+// ERC-4337 style conditions hold. The conditions are checked when the transaction enters
+// the mempool and again when it is delivered. They only apply to this submission: the same
+// signed transaction sent without them, by anyone, is executed unconditionally.
+func (s *SendAPI) SendRawTransactionConditional(ctx context.Context, input hexutil.Bytes, conditions types.TxConditions) (hash common.Hash, err error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_sendRawTransactionConditional", s.connectionType, startTime, err == nil)
+	if err = conditions.Validate(); err != nil {
+		return
+	}
+	memo, err := conditions.ToMemo()
+	if err != nil {
+		return
+	}
+	return s.sendRawTransaction(ctx, input, memo)
+}
+
+func (s *SendAPI) sendRawTransaction(ctx context.Context, input hexutil.Bytes, memo string) (hash common.Hash, err error) {
 	tx := new(ethtypes.Transaction)
 	if err = tx.UnmarshalBinary(input); err != nil {
 		return
@@ -78,6 +99,7 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		return
 	}
 	txBuilder.SetGasEstimate(gasUsedEstimate)
+	txBuilder.SetMemo(memo)
 	txbz, encodeErr := s.txConfig.TxEncoder()(txBuilder.GetTx())
 	if encodeErr != nil {
 		return hash, encodeErr
@@ -90,7 +112,7 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.CheckTx.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.CheckTx.Code, "")
+			err = checkTxError(res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
 		}
 	} else {
 		res, broadcastError := s.tmClient.BroadcastTx(ctx, txbz)
@@ -99,12 +121,35 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.Code, "")
+			err = checkTxError(res.Codespace, res.Code, res.Log)
 		}
 	}
 	return
 }
 
+// checkTxError converts the result code of a rejected transaction into an error. Unmet
+// conditions are reported with the -32003 code used by other conditional transaction
+// implementations.
+func checkTxError(codespace string, code uint32, log string) error {
+	if types.ErrTxConditionsNotMet.Is(sdkerrors.ABCIError(codespace, code, "")) {
+		return &conditionsNotMetError{msg: log}
+	}
+	return sdkerrors.ABCIError(sdkerrors.RootCodespace, code, "")
+}
+
+type conditionsNotMetError struct {
+	msg string
+}
+
+func (e *conditionsNotMetError) Error() string {
+	if e.msg == "" {
+		return types.ErrTxConditionsNotMet.Error()
+	}
+	return e.msg
+}
+
+func (e *conditionsNotMetError) ErrorCode() int { return -32003 }
+
 func (s *SendAPI) simulateTx(ctx context.Context, tx *ethtypes.Transaction) (estimate uint64, err error) {
 	var from common.Address
 	if tx.Type() == ethtypes.DynamicFeeTxType {
@@ -169,7 +214,7 @@ func (s *SendAPI) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _
 	startTime := time.Now()
 	defer recordMetrics("eth_signTransaction", s.connectionType, startTime, returnErr == nil)
 	var unsignedTx = args.ToTransaction()
-	signedTx, err := s.signTransaction(unsignedTx, args.From.Address().Hex())
+	signedTx, err := s.signTransaction(unsignedTx, args.From.Address())
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +232,7 @@ func (s *SendAPI) SendTransaction(ctx context.Context, args ethapi.TransactionAr
 		return common.Hash{}, err
 	}
 	var unsignedTx = args.ToTransaction()
-	signedTx, err := s.signTransaction(unsignedTx, args.From.Hex())
+	signedTx, err := s.signTransaction(unsignedTx, *args.From)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -198,16 +243,11 @@ func (s *SendAPI) SendTransaction(ctx context.Context, args ethapi.TransactionAr
 	return s.SendRawTransaction(ctx, data)
 }
 
-func (s *SendAPI) signTransaction(unsignedTx *ethtypes.Transaction, from string) (*ethtypes.Transaction, error) {
-	kb, err := getTestKeyring(s.homeDir)
-	if err != nil {
-		return nil, err
-	}
-	privKey, ok := getAddressPrivKeyMap(kb)[from]
-	if !ok {
+func (s *SendAPI) signTransaction(unsignedTx *ethtypes.Transaction, from common.Address) (*ethtypes.Transaction, error) {
+	chainId := s.keeper.ChainID(s.ctxProvider(LatestCtxHeight))
+	signedTx, err := s.signer.SignTx(from, unsignedTx, chainId)
+	if errors.Is(err, ErrNoHostedKey) {
 		return nil, errors.New("from address does not have hosted key")
 	}
-	chainId := s.keeper.ChainID(s.ctxProvider(LatestCtxHeight))
-	signer := ethtypes.LatestSignerForChainID(chainId)
-	return ethtypes.SignTx(unsignedTx, signer, privKey)
+	return signedTx, err
 }
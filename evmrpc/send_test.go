@@ -63,3 +63,49 @@ func TestSendRawTransaction(t *testing.T) {
 	errMap = resObj["error"].(map[string]interface{})
 	require.Equal(t, ": invalid sequence", errMap["message"].(string))
 }
+
+func TestSendRawTransactionConditional(t *testing.T) {
+	to := common.HexToAddress("010203")
+	txData := ethtypes.DynamicFeeTx{
+		Nonce:     2,
+		GasFeeCap: big.NewInt(10),
+		Gas:       1000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+		ChainID:   EVMKeeper.ChainID(Ctx),
+	}
+	key, _ := crypto.GenerateKey()
+	signer := ethtypes.LatestSignerForChainID(EVMKeeper.ChainID(Ctx))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&txData), signer, key)
+	require.Nil(t, err)
+	bz, err := tx.MarshalBinary()
+	require.Nil(t, err)
+	payload := "0x" + hex.EncodeToString(bz)
+
+	conditions := map[string]interface{}{
+		"blockNumberMax": "0x100",
+		"knownAccounts": map[string]interface{}{
+			"0x1234567890123456789012345678901234567890": map[string]interface{}{
+				common.HexToHash("0x1").Hex(): common.HexToHash("0x2").Hex(),
+			},
+		},
+	}
+	resObj := sendRequestGood(t, "sendRawTransactionConditional", payload, conditions)
+	require.Equal(t, tx.Hash().Hex(), resObj["result"].(string))
+
+	// storage roots cannot be checked
+	conditions = map[string]interface{}{
+		"knownAccounts": map[string]interface{}{
+			"0x1234567890123456789012345678901234567890": common.HexToHash("0x1").Hex(),
+		},
+	}
+	resObj = sendRequestGood(t, "sendRawTransactionConditional", payload, conditions)
+	errMap := resObj["error"].(map[string]interface{})
+	require.Contains(t, errMap["message"].(string), "storage root condition")
+
+	// empty ranges are rejected
+	conditions = map[string]interface{}{"timestampMin": "0x10", "timestampMax": "0x1"}
+	resObj = sendRequestGood(t, "sendRawTransactionConditional", payload, conditions)
+	errMap = resObj["error"].(map[string]interface{})
+	require.Equal(t, "timestampMin is greater than timestampMax", errMap["message"].(string))
+}
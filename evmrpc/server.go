@@ -37,6 +37,7 @@ func NewEVMHTTPServer(
 	ctxProvider func(int64) sdk.Context,
 	txConfig client.TxConfig,
 	homeDir string,
+	rateLimiter *RateLimiter, // shared with the websocket server, nil disables rate limiting
 	isPanicOrSyntheticTxFunc func(ctx context.Context, hash common.Hash) (bool, error), // used in *ExcludeTraceFail endpoints
 ) (EVMServer, error) {
 	httpServer := NewHTTPServer(logger, rpc.HTTPTimeouts{
@@ -49,18 +50,32 @@ func NewEVMHTTPServer(
 		return nil, err
 	}
 	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
-	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, app, antehandler, ConnectionTypeHTTP)
+	signer, err := NewSignerFromConfig(config, homeDir)
+	if err != nil {
+		return nil, err
+	}
+	gasPriceOracleConfig, err := NewGasPriceOracleConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	gasPriceOracle := NewGasPriceOracle(tmClient, k, ctxProvider, txConfig.TxDecoder(), gasPriceOracleConfig)
+	var infoGasPriceOracle *GasPriceOracle
+	if config.EnableGasPriceOracle {
+		infoGasPriceOracle = gasPriceOracle
+	}
+	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, signer, simulateConfig, app, antehandler, ConnectionTypeHTTP)
 	ctx := ctxProvider(LatestCtxHeight)
+	cache := NewResponseCache(config.ResponseCacheSize, ctxProvider)
 
-	txAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeHTTP)
-	debugAPI := NewDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, ConnectionTypeHTTP)
+	txAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfig, signer, ConnectionTypeHTTP, cache)
+	debugAPI := NewDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, ConnectionTypeHTTP, cache)
 	if isPanicOrSyntheticTxFunc == nil {
 		isPanicOrSyntheticTxFunc = func(ctx context.Context, hash common.Hash) (bool, error) {
 			return debugAPI.isPanicOrSyntheticTx(ctx, hash)
 		}
 	}
-	seiTxAPI := NewSeiTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc)
-	seiDebugAPI := NewSeiDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, ConnectionTypeHTTP)
+	seiTxAPI := NewSeiTransactionAPI(tmClient, k, ctxProvider, txConfig, signer, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc, cache)
+	seiDebugAPI := NewSeiDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, ConnectionTypeHTTP, cache)
 
 	apis := []rpc.API{
 		{
@@ -69,15 +84,15 @@ func NewEVMHTTPServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP),
+			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, cache),
 		},
 		{
 			Namespace: "sei",
-			Service:   NewSeiBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc),
+			Service:   NewSeiBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc, cache),
 		},
 		{
 			Namespace: "sei2",
-			Service:   NewSei2BlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc),
+			Service:   NewSei2BlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, isPanicOrSyntheticTxFunc, cache),
 		},
 		{
 			Namespace: "eth",
@@ -89,11 +104,11 @@ func NewEVMHTTPServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeHTTP),
+			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeHTTP, config.MaxStorageSlotsForProof),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeHTTP),
+			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), signer, config.MaxBlocksForLog, ConnectionTypeHTTP, infoGasPriceOracle),
 		},
 		{
 			Namespace: "eth",
@@ -115,10 +130,22 @@ func NewEVMHTTPServer(
 			Namespace: "sei",
 			Service:   NewFilterAPI(tmClient, k, ctxProvider, txConfig, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP, "sei"),
 		},
+		{
+			Namespace: "sei",
+			Service:   NewSeiLogsAPI(tmClient, k, ctxProvider, txConfig, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP),
+		},
 		{
 			Namespace: "sei",
 			Service:   NewAssociationAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), sendAPI, ConnectionTypeHTTP),
 		},
+		{
+			Namespace: "sei",
+			Service:   NewSeiGasPriceAPI(gasPriceOracle, ConnectionTypeHTTP),
+		},
+		{
+			Namespace: "ots",
+			Service:   NewOtterscanAPI(tmClient, k, ctxProvider, NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, cache), txAPI, debugAPI, ConnectionTypeHTTP),
+		},
 		{
 			Namespace: "txpool",
 			Service:   NewTxPoolAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), &TxPoolConfig{maxNumTxs: int(config.MaxTxPoolTxs)}, ConnectionTypeHTTP),
@@ -135,6 +162,14 @@ func NewEVMHTTPServer(
 			Namespace: "sei",
 			Service:   seiDebugAPI,
 		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, config.MaxTraceFilterBlocks, ConnectionTypeHTTP),
+		},
+		{
+			Namespace: "sei",
+			Service:   NewSeiTraceAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, app, antehandler, config.MaxTraceFilterBlocks, ConnectionTypeHTTP),
+		},
 	}
 	// Test API can only exist on non-live chain IDs.  These APIs instrument certain overrides.
 	if config.EnableTestAPI && !evmCfg.IsLiveChainID(ctx) {
@@ -150,6 +185,7 @@ func NewEVMHTTPServer(
 	if err := httpServer.EnableRPC(apis, HTTPConfig{
 		CorsAllowedOrigins: strings.Split(config.CORSOrigins, ","),
 		Vhosts:             []string{"*"},
+		RPCEndpointConfig:  RPCEndpointConfig{RateLimiter: rateLimiter},
 	}); err != nil {
 		return nil, err
 	}
@@ -166,6 +202,7 @@ func NewEVMWebSocketServer(
 	ctxProvider func(int64) sdk.Context,
 	txConfig client.TxConfig,
 	homeDir string,
+	rateLimiter *RateLimiter, // shared with the HTTP server, nil disables rate limiting
 ) (EVMServer, error) {
 	httpServer := NewHTTPServer(logger, rpc.HTTPTimeouts{
 		ReadTimeout:       config.ReadTimeout,
@@ -177,6 +214,19 @@ func NewEVMWebSocketServer(
 		return nil, err
 	}
 	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
+	signer, err := NewSignerFromConfig(config, homeDir)
+	if err != nil {
+		return nil, err
+	}
+	gasPriceOracleConfig, err := NewGasPriceOracleConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	var gasPriceOracle *GasPriceOracle
+	if config.EnableGasPriceOracle {
+		gasPriceOracle = NewGasPriceOracle(tmClient, k, ctxProvider, txConfig.TxDecoder(), gasPriceOracleConfig)
+	}
+	cache := NewResponseCache(config.ResponseCacheSize, ctxProvider)
 	apis := []rpc.API{
 		{
 			Namespace: "echo",
@@ -184,23 +234,23 @@ func NewEVMWebSocketServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeWS),
+			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeWS, cache),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeWS),
+			Service:   NewTransactionAPI(tmClient, k, ctxProvider, txConfig, signer, ConnectionTypeWS, cache),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeWS),
+			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeWS, config.MaxStorageSlotsForProof),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeWS),
+			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), signer, config.MaxBlocksForLog, ConnectionTypeWS, gasPriceOracle),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, app, antehandler, ConnectionTypeWS),
+			Service:   NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, signer, simulateConfig, app, antehandler, ConnectionTypeWS),
 		},
 		{
 			Namespace: "eth",
@@ -212,14 +262,14 @@ func NewEVMWebSocketServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSubscriptionAPI(tmClient, k, ctxProvider, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, txConfig: txConfig}, &SubscriptionConfig{subscriptionCapacity: 100, newHeadLimit: config.MaxSubscriptionsNewHead}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeWS),
+			Service:   NewSubscriptionAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, txConfig: txConfig}, &SubscriptionConfig{subscriptionCapacity: 100, newHeadLimit: config.MaxSubscriptionsNewHead, pendingTxLimit: config.MaxSubscriptionsNewPendingTxs, syncingLimit: config.MaxSubscriptionsSyncing, maxPendingTxs: int(config.MaxTxPoolTxs)}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeWS),
 		},
 		{
 			Namespace: "web3",
 			Service:   &Web3API{},
 		},
 	}
-	if err := httpServer.EnableWS(apis, WsConfig{Origins: strings.Split(config.WSOrigins, ",")}); err != nil {
+	if err := httpServer.EnableWS(apis, WsConfig{Origins: strings.Split(config.WSOrigins, ","), RPCEndpointConfig: RPCEndpointConfig{RateLimiter: rateLimiter}}); err != nil {
 		return nil, err
 	}
 	return httpServer, nil
@@ -409,6 +409,20 @@ func (c *MockClient) Subscribe(ctx context.Context, subscriber string, query str
 		return resCh, nil
 		// hardcoded test case for simplicity
 	}
+	if query == "tm.event = 'NewBlock'" {
+		resCh := make(chan coretypes.ResultEvent, 5)
+		go func() {
+			for i := int64(1); ; i++ {
+				resCh <- coretypes.ResultEvent{
+					SubscriptionID: subscriber,
+					Query:          query,
+					Data:           tmtypes.EventDataNewBlock{Block: c.mockBlock(i).Block},
+				}
+				time.Sleep(100 * time.Millisecond) // sleep a little to simulate real blocks
+			}
+		}()
+		return resCh, nil
+	}
 	return nil, errors.New("unknown query")
 }
 
@@ -489,6 +503,17 @@ func (c *MockClient) UnconfirmedTxs(ctx context.Context, page, perPage *int) (*c
 	}, nil
 }
 
+func (c *MockClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{
+			LatestBlockHeight:   MockHeight8,
+			EarliestBlockHeight: 1,
+			MaxPeerBlockHeight:  MockHeight8,
+			CatchingUp:          false,
+		},
+	}, nil
+}
+
 type MockBadClient struct {
 	MockClient
 }
@@ -553,7 +578,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
-	HttpServer, err := evmrpc.NewEVMHTTPServer(infoLog, goodConfig, &MockClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "", isPanicTxFunc)
+	HttpServer, err := evmrpc.NewEVMHTTPServer(infoLog, goodConfig, &MockClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "", nil, isPanicTxFunc)
 	if err != nil {
 		panic(err)
 	}
@@ -565,7 +590,7 @@ func init() {
 	badConfig := evmrpc.DefaultConfig
 	badConfig.HTTPPort = TestBadPort
 	badConfig.FilterTimeout = 500 * time.Millisecond
-	badHTTPServer, err := evmrpc.NewEVMHTTPServer(infoLog, badConfig, &MockBadClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "", nil)
+	badHTTPServer, err := evmrpc.NewEVMHTTPServer(infoLog, badConfig, &MockBadClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "", nil, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -574,7 +599,7 @@ func init() {
 	}
 
 	// Start ws server
-	wsServer, err := evmrpc.NewEVMWebSocketServer(infoLog, goodConfig, &MockClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "")
+	wsServer, err := evmrpc.NewEVMWebSocketServer(infoLog, goodConfig, &MockClient{}, EVMKeeper, testApp.BaseApp, testApp.AnteHandler, ctxProvider, TxConfig, "", nil)
 	if err != nil {
 		panic(err)
 	}
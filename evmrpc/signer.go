@@ -0,0 +1,229 @@
+package evmrpc
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	SignerBackendKeyring  = "keyring"
+	SignerBackendExternal = "external"
+
+	// SignerKeyringPassphraseEnv holds the passphrase of the "file" keyring backend, which
+	// cannot be prompted for while the node is running.
+	SignerKeyringPassphraseEnv = "SEI_EVM_SIGNER_KEYRING_PASSPHRASE"
+)
+
+var ErrNoHostedKey = errors.New("address does not have hosted key")
+
+// Signer signs transactions and messages on behalf of the accounts hosted by the node for
+// eth_sendTransaction, eth_signTransaction, eth_sign and eth_accounts.
+type Signer interface {
+	// Accounts returns the addresses the signer can sign for.
+	Accounts() ([]common.Address, error)
+	// SignTx signs tx with the key of from for the given chain.
+	SignTx(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error)
+	// SignText signs the EIP-191 personal message hash of text with the key of addr.
+	SignText(addr common.Address, text []byte) ([]byte, error)
+}
+
+// NewSignerFromConfig returns the signer selected by config. Keyrings are looked up under
+// homeDir.
+func NewSignerFromConfig(config Config, homeDir string) (Signer, error) {
+	switch config.SignerBackend {
+	case "", SignerBackendKeyring:
+		backend := config.SignerKeyringBackend
+		if backend == "" {
+			backend = keyring.BackendTest
+		}
+		return NewKeyringSigner(homeDir, backend, os.Getenv(SignerKeyringPassphraseEnv)), nil
+	case SignerBackendExternal:
+		if config.SignerExternalEndpoint == "" {
+			return nil, errors.New("signer_external_endpoint must be set for the external signer")
+		}
+		return NewExternalSigner(config.SignerExternalEndpoint), nil
+	default:
+		return nil, fmt.Errorf("unknown signer backend %q", config.SignerBackend)
+	}
+}
+
+// KeyringSigner signs with the secp256k1 keys of a Cosmos SDK keyring. The keyring is
+// reopened on every call so that keys added while the node is running are picked up.
+type KeyringSigner struct {
+	homeDir    string
+	backend    string
+	passphrase string
+}
+
+func NewKeyringSigner(homeDir string, backend string, passphrase string) *KeyringSigner {
+	return &KeyringSigner{homeDir: homeDir, backend: backend, passphrase: passphrase}
+}
+
+func (s *KeyringSigner) Accounts() ([]common.Address, error) {
+	keys, err := s.keys()
+	if err != nil {
+		return nil, err
+	}
+	return keys.Accounts()
+}
+
+func (s *KeyringSigner) SignTx(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	keys, err := s.keys()
+	if err != nil {
+		return nil, err
+	}
+	return keys.SignTx(from, tx, chainID)
+}
+
+func (s *KeyringSigner) SignText(addr common.Address, text []byte) ([]byte, error) {
+	keys, err := s.keys()
+	if err != nil {
+		return nil, err
+	}
+	return keys.SignText(addr, text)
+}
+
+func (s *KeyringSigner) keys() (*PrivateKeySigner, error) {
+	clientCtx := client.Context{}.WithViper("").WithHomeDir(s.homeDir)
+	clientCtx, err := config.ReadFromClientConfig(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	if s.backend == keyring.BackendFile {
+		clientCtx = clientCtx.WithInput(&repeatedLineReader{line: s.passphrase + "\n"})
+	}
+	kb, err := client.NewKeyringFromBackend(clientCtx, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	return NewPrivateKeySigner(getAddressPrivKeyMap(kb)), nil
+}
+
+// repeatedLineReader answers every passphrase prompt of a file keyring with the same line.
+type repeatedLineReader struct {
+	line   string
+	offset int
+}
+
+func (r *repeatedLineReader) Read(p []byte) (int, error) {
+	if len(r.line) == 0 {
+		return 0, io.EOF
+	}
+	n := 0
+	for n < len(p) {
+		copied := copy(p[n:], r.line[r.offset:])
+		n += copied
+		r.offset = (r.offset + copied) % len(r.line)
+	}
+	return n, nil
+}
+
+// PrivateKeySigner signs with private keys held in memory. Besides backing KeyringSigner,
+// it stands in for a remote signer in tests.
+type PrivateKeySigner struct {
+	keys map[common.Address]*ecdsa.PrivateKey
+}
+
+// NewPrivateKeySigner returns a signer for the given keys, indexed by the hex encoding of
+// their addresses.
+func NewPrivateKeySigner(keys map[string]*ecdsa.PrivateKey) *PrivateKeySigner {
+	res := &PrivateKeySigner{keys: map[common.Address]*ecdsa.PrivateKey{}}
+	for _, key := range keys {
+		res.keys[crypto.PubkeyToAddress(key.PublicKey)] = key
+	}
+	return res
+}
+
+func (s *PrivateKeySigner) Accounts() ([]common.Address, error) {
+	res := []common.Address{}
+	for addr := range s.keys {
+		res = append(res, addr)
+	}
+	return res, nil
+}
+
+func (s *PrivateKeySigner) SignTx(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	key, ok := s.keys[from]
+	if !ok {
+		return nil, ErrNoHostedKey
+	}
+	return ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), key)
+}
+
+func (s *PrivateKeySigner) SignText(addr common.Address, text []byte) ([]byte, error) {
+	key, ok := s.keys[addr]
+	if !ok {
+		return nil, ErrNoHostedKey
+	}
+	return crypto.Sign(accounts.TextHash(text), key)
+}
+
+// ExternalSigner forwards signing requests to a Clef-compatible signer over HTTP or IPC,
+// so that the node never holds the keys. The connection is established on first use so
+// that the node can start before the signer does.
+type ExternalSigner struct {
+	endpoint string
+
+	mu     sync.Mutex
+	signer *external.ExternalSigner
+}
+
+func NewExternalSigner(endpoint string) *ExternalSigner {
+	return &ExternalSigner{endpoint: endpoint}
+}
+
+func (s *ExternalSigner) Accounts() ([]common.Address, error) {
+	signer, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	res := []common.Address{}
+	for _, account := range signer.Accounts() {
+		res = append(res, account.Address)
+	}
+	return res, nil
+}
+
+func (s *ExternalSigner) SignTx(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	signer, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignTx(accounts.Account{Address: from}, tx, chainID)
+}
+
+func (s *ExternalSigner) SignText(addr common.Address, text []byte) ([]byte, error) {
+	signer, err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	return signer.SignText(accounts.Account{Address: addr}, text)
+}
+
+func (s *ExternalSigner) connect() (*external.ExternalSigner, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.signer != nil {
+		return s.signer, nil
+	}
+	signer, err := external.NewExternalSigner(s.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to external signer at %s: %w", s.endpoint, err)
+	}
+	s.signer = signer
+	return signer, nil
+}
@@ -0,0 +1,162 @@
+package evmrpc_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/config"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClef serves the subset of the Clef external API used by ExternalSigner.
+type fakeClef struct {
+	signer *evmrpc.PrivateKeySigner
+}
+
+func (c *fakeClef) Version() string {
+	return "6.0.0"
+}
+
+func (c *fakeClef) List() ([]common.Address, error) {
+	return c.signer.Accounts()
+}
+
+func (c *fakeClef) SignTransaction(args apitypes.SendTxArgs) (map[string]interface{}, error) {
+	signed, err := c.signer.SignTx(args.From.Address(), args.ToTransaction(), args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signed.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil
+}
+
+func (c *fakeClef) SignData(_ string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return c.signer.SignText(addr.Address(), data)
+}
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.Nil(t, err)
+	return key, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func testSigner(t *testing.T, signer evmrpc.Signer, addr common.Address) {
+	accts, err := signer.Accounts()
+	require.Nil(t, err)
+	require.Equal(t, []common.Address{addr}, accts)
+
+	chainID := big.NewInt(713715)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1000000000),
+		GasFeeCap: big.NewInt(2000000000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(10),
+	})
+	signed, err := signer.SignTx(addr, tx, chainID)
+	require.Nil(t, err)
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), signed)
+	require.Nil(t, err)
+	require.Equal(t, addr, sender)
+
+	sig, err := signer.SignText(addr, []byte("data"))
+	require.Nil(t, err)
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte("data")), sig)
+	require.Nil(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*pub))
+
+	_, err = signer.SignText(common.HexToAddress("0xabc"), []byte("data"))
+	require.NotNil(t, err)
+}
+
+func TestPrivateKeySigner(t *testing.T) {
+	key, addr := newTestKey(t)
+	signer := evmrpc.NewPrivateKeySigner(map[string]*ecdsa.PrivateKey{addr.Hex(): key})
+	testSigner(t, signer, addr)
+	_, err := signer.SignText(common.HexToAddress("0xabc"), []byte("data"))
+	require.ErrorIs(t, err, evmrpc.ErrNoHostedKey)
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, addr := newTestKey(t)
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("account", &fakeClef{signer: evmrpc.NewPrivateKeySigner(map[string]*ecdsa.PrivateKey{addr.Hex(): key})}))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	defer server.Stop()
+	testSigner(t, evmrpc.NewExternalSigner(httpServer.URL), addr)
+}
+
+func TestExternalSignerUnreachable(t *testing.T) {
+	signer := evmrpc.NewExternalSigner("http://127.0.0.1:1")
+	_, err := signer.Accounts()
+	require.NotNil(t, err)
+	require.True(t, strings.Contains(err.Error(), "failed to connect to external signer"))
+}
+
+func TestKeyringSignerFileBackend(t *testing.T) {
+	homeDir := t.TempDir()
+	passphrase := "12345678"
+	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
+	clientCtx, err := config.ReadFromClientConfig(clientCtx)
+	require.Nil(t, err)
+	clientCtx = clientCtx.WithInput(strings.NewReader(strings.Repeat(passphrase+"\n", 4)))
+	kb, err := client.NewKeyringFromBackend(clientCtx, keyring.BackendFile)
+	require.Nil(t, err)
+	entropySeed, err := bip39.NewEntropy(256)
+	require.Nil(t, err)
+	mnemonic, err := bip39.NewMnemonic(entropySeed)
+	require.Nil(t, err)
+	algos, _ := kb.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(string(hd.Secp256k1Type), algos)
+	require.Nil(t, err)
+	_, err = kb.NewAccount("test", mnemonic, "", hd.CreateHDPath(sdk.GetConfig().GetCoinType(), 0, 0).String(), algo)
+	require.Nil(t, err)
+
+	signer := evmrpc.NewKeyringSigner(homeDir, keyring.BackendFile, passphrase)
+	accts, err := signer.Accounts()
+	require.Nil(t, err)
+	require.Len(t, accts, 1)
+	testSigner(t, signer, accts[0])
+}
+
+func TestNewSignerFromConfig(t *testing.T) {
+	cfg := evmrpc.DefaultConfig
+	signer, err := evmrpc.NewSignerFromConfig(cfg, t.TempDir())
+	require.Nil(t, err)
+	require.IsType(t, &evmrpc.KeyringSigner{}, signer)
+
+	cfg.SignerBackend = evmrpc.SignerBackendExternal
+	_, err = evmrpc.NewSignerFromConfig(cfg, t.TempDir())
+	require.NotNil(t, err)
+	cfg.SignerExternalEndpoint = "http://localhost:8550"
+	signer, err = evmrpc.NewSignerFromConfig(cfg, t.TempDir())
+	require.Nil(t, err)
+	require.IsType(t, &evmrpc.ExternalSigner{}, signer)
+
+	cfg.SignerBackend = "unknown"
+	_, err = evmrpc.NewSignerFromConfig(cfg, t.TempDir())
+	require.NotNil(t, err)
+}
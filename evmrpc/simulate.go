@@ -208,6 +208,7 @@ func (b *Backend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHas
 	if err := CheckVersion(sdkCtx, b.keeper); err != nil {
 		return nil, nil, err
 	}
+	sdkCtx = b.keeper.FlatSnapshotContext(sdkCtx)
 	return state.NewDBImpl(sdkCtx, b.keeper, true), b.getHeader(big.NewInt(height)), nil
 }
 
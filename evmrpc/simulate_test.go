@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -174,6 +175,95 @@ func TestCall(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(8)
 }
 
+func TestSimulateV1(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+
+	_, from := testkeeper.MockAddressPair()
+	_, to := testkeeper.MockAddressPair()
+	_, contractAddr := testkeeper.MockAddressPair()
+	abi, err := simplestorage.SimplestorageMetaData.GetAbi()
+	require.Nil(t, err)
+	set, err := abi.Pack("set", big.NewInt(20))
+	require.Nil(t, err)
+	get, err := abi.Pack("get")
+	require.Nil(t, err)
+	// runtime code of SimpleStorage, as returned by executing its init code in TestCall
+	runtimeCode := "0x608060405234801561000f575f80fd5b506004361061003f575f3560e01c806360fe47b1146100435780636d4ce63c1461005f5780639c3674fc1461007d575b5f80fd5b61005d6004803603810190610058919061010a565b610087565b005b6100676100c7565b6040516100749190610144565b60405180910390f35b6100856100cf565b005b805f819055507f0de2d86113046b9e8bb6b785e96a6228f6803952bf53a40b68a36dce316218c1816040516100bc9190610144565b60405180910390a150565b5f8054905090565b5f80fd5b5f80fd5b5f819050919050565b6100e9816100d7565b81146100f3575f80fd5b50565b5f81359050610104816100e0565b92915050565b5f6020828403121561011f5761011e6100d3565b5b5f61012c848285016100f6565b91505092915050565b61013e816100d7565b82525050565b5f6020820190506101575f830184610135565b9291505056fea2646970667358221220bb55137839ea2afda11ab2d30ad07fee30bb9438caaa46e30ccd1053ed72439064736f6c63430008150033"
+	opts := map[string]interface{}{
+		"traceTransfers": true,
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{
+				"stateOverrides": map[string]interface{}{
+					contractAddr.Hex(): map[string]interface{}{"code": runtimeCode},
+					from.Hex():         map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+				},
+				"calls": []interface{}{
+					map[string]interface{}{
+						"from":  from.Hex(),
+						"to":    contractAddr.Hex(),
+						"input": fmt.Sprintf("%#x", set),
+					},
+					map[string]interface{}{
+						"from":  from.Hex(),
+						"to":    to.Hex(),
+						"value": "0xe8d4a51000",
+					},
+				},
+			},
+			map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{
+						"from":  from.Hex(),
+						"to":    contractAddr.Hex(),
+						"input": fmt.Sprintf("%#x", get),
+					},
+				},
+			},
+		},
+	}
+	resObj := sendRequestGood(t, "simulateV1", opts, "latest")
+	require.Nil(t, resObj["error"])
+	blocks := resObj["result"].([]interface{})
+	require.Len(t, blocks, 2)
+
+	block1 := blocks[0].(map[string]interface{})
+	calls := block1["calls"].([]interface{})
+	require.Len(t, calls, 2)
+	setRes := calls[0].(map[string]interface{})
+	require.Equal(t, "0x1", setRes["status"])
+	setLogs := setRes["logs"].([]interface{})
+	require.Len(t, setLogs, 1)
+	require.Equal(t, strings.ToLower(contractAddr.Hex()), setLogs[0].(map[string]interface{})["address"])
+	transferRes := calls[1].(map[string]interface{})
+	require.Equal(t, "0x1", transferRes["status"])
+	transferLogs := transferRes["logs"].([]interface{})
+	require.Len(t, transferLogs, 1)
+	transferLog := transferLogs[0].(map[string]interface{})
+	require.Equal(t, "0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee", transferLog["address"])
+	require.Equal(t, "0x1", transferLog["logIndex"])
+	require.Equal(t, block1["hash"], transferLog["blockHash"])
+	require.Len(t, block1["transactions"].([]interface{}), 2)
+
+	block2 := blocks[1].(map[string]interface{})
+	require.Equal(t, block1["hash"], block2["parentHash"])
+	getRes := block2["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0x1", getRes["status"])
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000014", getRes["returnData"])
+
+	// block numbers must be strictly increasing
+	opts = map[string]interface{}{
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": "0x10"}},
+			map[string]interface{}{"blockOverrides": map[string]interface{}{"number": "0x10"}},
+		},
+	}
+	resObj = sendRequestGood(t, "simulateV1", opts, "latest")
+	errMap := resObj["error"].(map[string]interface{})
+	require.Equal(t, float64(-38020), errMap["code"])
+
+	Ctx = Ctx.WithBlockHeight(8)
+}
+
 func TestEthCallHighAmount(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(1)
 	_, from := testkeeper.MockAddressPair()
@@ -0,0 +1,478 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sei-protocol/sei-chain/precompiles/wasmd"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+)
+
+// maxSimulateBlocks caps the number of blocks a single eth_simulateV1 request may simulate.
+const maxSimulateBlocks = 256
+
+// simulateBlockTimestampIncrement is the default time gap between consecutive simulated blocks.
+const simulateBlockTimestampIncrement = 1
+
+// Error codes defined by the eth_simulateV1 specification.
+const (
+	simErrCodeReverted         = 3
+	simErrCodeVMError          = -32015
+	simErrCodeInvalidParams    = -32602
+	simErrCodeBlockNumberOrder = -38020
+	simErrCodeBlockTimeOrder   = -38021
+	simErrCodeGasLimitReached  = -38015
+	simErrCodeClientLimit      = -38026
+)
+
+var (
+	// transferAddress is the address used as the emitter of synthetic ETH transfer logs,
+	// following the convention used by other clients for eth_simulateV1.
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// transferTopic is the ERC20 Transfer event signature.
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// SimulateBlock is a single block to simulate in eth_simulateV1.
+type SimulateBlock struct {
+	BlockOverrides *ethapi.BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *ethapi.StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []ethapi.TransactionArgs `json:"calls"`
+}
+
+// SimulateOpts are the inputs to eth_simulateV1.
+type SimulateOpts struct {
+	BlockStateCalls        []SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers         bool            `json:"traceTransfers"`
+	Validation             bool            `json:"validation"`
+	ReturnFullTransactions bool            `json:"returnFullTransactions"`
+}
+
+// SimulateCallResult is the outcome of a single simulated call.
+type SimulateCallResult struct {
+	ReturnValue hexutil.Bytes    `json:"returnData"`
+	Logs        []*ethtypes.Log  `json:"logs"`
+	GasUsed     hexutil.Uint64   `json:"gasUsed"`
+	Status      hexutil.Uint64   `json:"status"`
+	Error       *SimulateCallErr `json:"error,omitempty"`
+}
+
+type SimulateCallErr struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimulateError is returned for request-level failures of eth_simulateV1 and
+// carries the error code defined by the specification.
+type SimulateError struct {
+	error
+	code int
+}
+
+func (e *SimulateError) ErrorCode() int {
+	return e.code
+}
+
+// SimulateV1 executes a sequence of blocks, each with its own block and state overrides and
+// a list of calls, on top of the state at blockNrOrHash. State changes made by a call are
+// visible to all subsequent calls, including those in later blocks. When Validation is set,
+// calls are subject to the same nonce, sender, balance and base fee checks as signed
+// transactions would be.
+func (s *SimulationAPI) SimulateV1(ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("eth_simulateV1", s.connectionType, startTime, returnErr)
+	defer func() {
+		if r := recover(); r != nil {
+			returnErr = fmt.Errorf("something went wrong: %v", r)
+		}
+	}()
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, &SimulateError{errors.New("empty input"), simErrCodeInvalidParams}
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, &SimulateError{fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), maxSimulateBlocks), simErrCodeClientLimit}
+	}
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	height, err := s.backend.getBlockHeight(ctx, bNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := s.backend.ctxProvider(height).WithIsEVM(true)
+	if err := CheckVersion(sdkCtx, s.backend.keeper); err != nil {
+		return nil, err
+	}
+	var cancel context.CancelFunc
+	if timeout := s.backend.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	gasCap := s.backend.RPCGasCap()
+	if gasCap == 0 {
+		gasCap = math.MaxUint64 / 2
+	}
+	sim := &simulator{
+		backend:   s.backend,
+		opts:      &opts,
+		db:        state.NewDBImpl(sdkCtx, s.backend.keeper, true),
+		base:      s.backend.getHeader(big.NewInt(height)),
+		gasBudget: gasCap,
+		hashes:    map[uint64]common.Hash{},
+		chainID:   s.backend.keeper.ChainID(sdkCtx),
+	}
+	return sim.execute(ctx)
+}
+
+// simulator holds the state shared by all simulated blocks of one eth_simulateV1 request.
+type simulator struct {
+	backend *Backend
+	opts    *SimulateOpts
+	db      *state.DBImpl
+	base    *ethtypes.Header
+	chainID *big.Int
+	// remaining gas across all calls of the request, bounded by SimulateConfig.GasCap
+	gasBudget uint64
+	// hashes of already simulated blocks, served to the BLOCKHASH opcode
+	hashes map[uint64]common.Hash
+}
+
+func (sim *simulator) execute(ctx context.Context) ([]map[string]interface{}, error) {
+	headers, err := sim.makeHeaders()
+	if err != nil {
+		return nil, err
+	}
+	results := make([]map[string]interface{}, 0, len(headers))
+	parent := sim.base
+	for i, block := range sim.opts.BlockStateCalls {
+		header := headers[i]
+		header.ParentHash = parent.Hash()
+		res, err := sim.processBlock(ctx, &block, header)
+		if err != nil {
+			return nil, err
+		}
+		sim.hashes[header.Number.Uint64()] = header.Hash()
+		results = append(results, res)
+		parent = header
+	}
+	return results, nil
+}
+
+// makeHeaders derives the header of every simulated block from the base block and the
+// block overrides, making sure block numbers and timestamps are strictly increasing.
+func (sim *simulator) makeHeaders() ([]*ethtypes.Header, error) {
+	prevNumber := sim.base.Number.Uint64()
+	prevTime := sim.base.Time
+	headers := make([]*ethtypes.Header, 0, len(sim.opts.BlockStateCalls))
+	for _, block := range sim.opts.BlockStateCalls {
+		overrides := block.BlockOverrides
+		number := prevNumber + 1
+		if overrides != nil && overrides.Number != nil {
+			number = overrides.Number.ToInt().Uint64()
+			if number <= prevNumber {
+				return nil, &SimulateError{fmt.Errorf("block numbers must be in order: %d <= %d", number, prevNumber), simErrCodeBlockNumberOrder}
+			}
+		}
+		timestamp := prevTime + simulateBlockTimestampIncrement
+		if overrides != nil && overrides.Time != nil {
+			timestamp = uint64(*overrides.Time)
+			if timestamp <= prevTime {
+				return nil, &SimulateError{fmt.Errorf("block timestamps must be in order: %d <= %d", timestamp, prevTime), simErrCodeBlockTimeOrder}
+			}
+		}
+		gasLimit := sim.base.GasLimit
+		if overrides != nil && overrides.GasLimit != nil {
+			gasLimit = uint64(*overrides.GasLimit)
+		}
+		baseFee := new(big.Int).Set(sim.base.BaseFee)
+		if overrides != nil && overrides.BaseFee != nil {
+			baseFee = overrides.BaseFee.ToInt()
+		}
+		headers = append(headers, &ethtypes.Header{
+			Number:        new(big.Int).SetUint64(number),
+			Time:          timestamp,
+			GasLimit:      gasLimit,
+			BaseFee:       baseFee,
+			Difficulty:    common.Big0,
+			UncleHash:     ethtypes.EmptyUncleHash,
+			ExcessBlobGas: sim.base.ExcessBlobGas,
+		})
+		prevNumber, prevTime = number, timestamp
+	}
+	return headers, nil
+}
+
+func (sim *simulator) processBlock(ctx context.Context, block *SimulateBlock, header *ethtypes.Header) (map[string]interface{}, error) {
+	blockCtx, err := sim.backend.keeper.GetVMBlockContext(sim.db.Ctx(), core.GasPool(header.GasLimit))
+	if err != nil {
+		return nil, err
+	}
+	block.BlockOverrides.Apply(blockCtx)
+	blockCtx.BlockNumber = header.Number
+	blockCtx.Time = header.Time
+	blockCtx.GasLimit = header.GasLimit
+	blockCtx.BaseFee = header.BaseFee
+	header.Coinbase = blockCtx.Coinbase
+	if blockCtx.Random != nil {
+		header.MixDigest = *blockCtx.Random
+	}
+	getHash := blockCtx.GetHash
+	blockCtx.GetHash = func(n uint64) common.Hash {
+		if h, ok := sim.hashes[n]; ok {
+			return h
+		}
+		return getHash(n)
+	}
+	if err := block.StateOverrides.Apply(sim.db); err != nil {
+		return nil, &SimulateError{err, simErrCodeInvalidParams}
+	}
+
+	var (
+		gp       = new(core.GasPool).AddGas(header.GasLimit)
+		txs      = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders  = make([]common.Address, 0, len(block.Calls))
+		calls    = make([]SimulateCallResult, 0, len(block.Calls))
+		logCount = 0
+		gasUsed  = uint64(0)
+	)
+	for i := range block.Calls {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", sim.backend.RPCEVMTimeout())
+		}
+		args := block.Calls[i]
+		tx, callRes, err := sim.processCall(ctx, &args, header, blockCtx, gp, i, logCount)
+		if err != nil {
+			return nil, err
+		}
+		logCount += len(callRes.Logs)
+		gasUsed += uint64(callRes.GasUsed)
+		txs = append(txs, tx)
+		senders = append(senders, simulateSender(&args))
+		calls = append(calls, *callRes)
+	}
+	header.GasUsed = gasUsed
+	blockHash := header.Hash()
+	for _, call := range calls {
+		for _, l := range call.Logs {
+			l.BlockHash = blockHash
+		}
+	}
+
+	res := ethapi.RPCMarshalHeader(header)
+	if sim.opts.ReturnFullTransactions {
+		chainConfig := sim.backend.ChainConfig()
+		fullTxs := make([]*ethapi.RPCTransaction, 0, len(txs))
+		for i, tx := range txs {
+			rpcTx := ethapi.NewRPCTransaction(tx, blockHash, header.Number.Uint64(), header.Time, uint64(i), header.BaseFee, chainConfig)
+			// simulated calls are unsigned, so the sender cannot be recovered from the signature
+			rpcTx.From = senders[i]
+			fullTxs = append(fullTxs, rpcTx)
+		}
+		res["transactions"] = fullTxs
+	} else {
+		hashes := make([]common.Hash, 0, len(txs))
+		for _, tx := range txs {
+			hashes = append(hashes, tx.Hash())
+		}
+		res["transactions"] = hashes
+	}
+	res["calls"] = calls
+	return res, nil
+}
+
+func (sim *simulator) processCall(
+	ctx context.Context,
+	args *ethapi.TransactionArgs,
+	header *ethtypes.Header,
+	blockCtx *vm.BlockContext,
+	gp *core.GasPool,
+	txIndex int,
+	logOffset int,
+) (*ethtypes.Transaction, *SimulateCallResult, error) {
+	if sim.gasBudget == 0 {
+		return nil, nil, &SimulateError{errors.New("gas cap exhausted"), simErrCodeClientLimit}
+	}
+	if args.Nonce == nil {
+		nonce := hexutil.Uint64(sim.db.GetNonce(simulateSender(args)))
+		args.Nonce = &nonce
+	}
+	if args.Gas == nil {
+		gas := min(gp.Gas(), sim.gasBudget)
+		args.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if uint64(*args.Gas) > gp.Gas() {
+		return nil, nil, &SimulateError{fmt.Errorf("block gas limit reached: %d >= %d", uint64(*args.Gas), gp.Gas()), simErrCodeGasLimitReached}
+	}
+	if err := args.CallDefaults(sim.gasBudget, blockCtx.BaseFee, sim.chainID); err != nil {
+		return nil, nil, &SimulateError{err, simErrCodeInvalidParams}
+	}
+	tx := args.ToTransaction()
+	msg := args.ToMessage(blockCtx.BaseFee)
+	msg.SkipAccountChecks = !sim.opts.Validation
+
+	tracer := newSimulateTracer(sim.opts.TraceTransfers, header.Number.Uint64(), tx.Hash(), uint(txIndex), uint(logOffset))
+	sim.db.WithCtx(sim.db.Ctx().WithEVMEntryViaWasmdPrecompile(wasmd.IsWasmdCall(args.To)))
+	sim.db.SetLogger(tracer.Hooks())
+	vmConfig := &vm.Config{NoBaseFee: !sim.opts.Validation, Tracer: tracer.Hooks()}
+	evm := sim.backend.GetEVM(ctx, msg, sim.db, sim.base, vmConfig, blockCtx)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+	result, err := core.ApplyMessage(evm, msg, gp)
+	if err != nil {
+		if sim.opts.Validation {
+			return nil, nil, &SimulateError{err, simErrCodeInvalidParams}
+		}
+		return nil, nil, fmt.Errorf("err: %w (supplied gas %d)", err, msg.GasLimit)
+	}
+	if err := sim.db.Err(); err != nil {
+		return nil, nil, err
+	}
+	if evm.Cancelled() {
+		return nil, nil, fmt.Errorf("execution aborted (timeout = %v)", sim.backend.RPCEVMTimeout())
+	}
+	if result.UsedGas > sim.gasBudget {
+		sim.gasBudget = 0
+	} else {
+		sim.gasBudget -= result.UsedGas
+	}
+
+	callRes := &SimulateCallResult{
+		ReturnValue: result.Return(),
+		Logs:        tracer.Logs(),
+		GasUsed:     hexutil.Uint64(result.UsedGas),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if result.Failed() {
+		callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if errors.Is(result.Err, vm.ErrExecutionReverted) {
+			revertErr := NewRevertError(result)
+			callRes.Error = &SimulateCallErr{Code: simErrCodeReverted, Message: revertErr.Error(), Data: revertErr.reason}
+		} else {
+			callRes.Error = &SimulateCallErr{Code: simErrCodeVMError, Message: result.Err.Error()}
+		}
+	}
+	return tx, callRes, nil
+}
+
+func simulateSender(args *ethapi.TransactionArgs) common.Address {
+	if args.From == nil {
+		return common.Address{}
+	}
+	return *args.From
+}
+
+// simulateTracer collects the logs emitted by a simulated call, dropping logs of reverted
+// call frames and optionally adding synthetic logs for ETH value transfers.
+type simulateTracer struct {
+	// logs of each call frame on the current call stack
+	frames         [][]*ethtypes.Log
+	traceTransfers bool
+	blockNumber    uint64
+	txHash         common.Hash
+	txIndex        uint
+	logIndex       uint
+}
+
+func newSimulateTracer(traceTransfers bool, blockNumber uint64, txHash common.Hash, txIndex uint, logIndex uint) *simulateTracer {
+	return &simulateTracer{
+		frames:         [][]*ethtypes.Log{{}},
+		traceTransfers: traceTransfers,
+		blockNumber:    blockNumber,
+		txHash:         txHash,
+		txIndex:        txIndex,
+		logIndex:       logIndex,
+	}
+}
+
+func (t *simulateTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+		OnLog:   t.onLog,
+	}
+}
+
+func (t *simulateTracer) onEnter(depth int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	if depth > 0 {
+		t.frames = append(t.frames, []*ethtypes.Log{})
+	}
+	if t.traceTransfers && vm.OpCode(typ) != vm.DELEGATECALL && value != nil && value.Sign() > 0 {
+		t.captureTransfer(from, to, value)
+	}
+}
+
+func (t *simulateTracer) onExit(depth int, _ []byte, _ uint64, _ error, reverted bool) {
+	if depth == 0 {
+		if reverted {
+			t.frames[0] = []*ethtypes.Log{}
+		}
+		return
+	}
+	size := len(t.frames)
+	if size <= 1 {
+		return
+	}
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	if !reverted {
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+func (t *simulateTracer) onLog(l *ethtypes.Log) {
+	t.captureLog(l.Address, l.Topics, l.Data)
+}
+
+func (t *simulateTracer) captureTransfer(from, to common.Address, value *big.Int) {
+	topics := []common.Hash{
+		transferTopic,
+		common.BytesToHash(from.Bytes()),
+		common.BytesToHash(to.Bytes()),
+	}
+	t.captureLog(transferAddress, topics, common.BigToHash(value).Bytes())
+}
+
+func (t *simulateTracer) captureLog(address common.Address, topics []common.Hash, data []byte) {
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], &ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: t.blockNumber,
+		TxHash:      t.txHash,
+		TxIndex:     t.txIndex,
+	})
+}
+
+// Logs returns the logs of the call in emission order, with block-wide log indexes.
+func (t *simulateTracer) Logs() []*ethtypes.Log {
+	logs := t.frames[0]
+	for i, l := range logs {
+		l.Index = t.logIndex + uint(i)
+	}
+	return logs
+}
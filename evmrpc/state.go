@@ -5,16 +5,22 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/proof"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -24,14 +30,15 @@ import (
 )
 
 type StateAPI struct {
-	tmClient       rpcclient.Client
-	keeper         *keeper.Keeper
-	ctxProvider    func(int64) sdk.Context
-	connectionType ConnectionType
+	tmClient                rpcclient.Client
+	keeper                  *keeper.Keeper
+	ctxProvider             func(int64) sdk.Context
+	connectionType          ConnectionType
+	maxStorageSlotsForProof int
 }
 
-func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType) *StateAPI {
-	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, connectionType: connectionType}
+func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType, maxStorageSlotsForProof int) *StateAPI {
+	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, connectionType: connectionType, maxStorageSlotsForProof: maxStorageSlotsForProof}
 }
 
 func (a *StateAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (result *hexutil.Big, returnErr error) {
@@ -50,6 +57,7 @@ func (a *StateAPI) GetBalance(ctx context.Context, address common.Address, block
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 			return nil, err
 		}
+		sdkCtx = a.keeper.FlatSnapshotContext(sdkCtx)
 	}
 	statedb := state.NewDBImpl(sdkCtx, a.keeper, true)
 	return (*hexutil.Big)(statedb.GetBalance(address)), nil
@@ -71,6 +79,7 @@ func (a *StateAPI) GetCode(ctx context.Context, address common.Address, blockNrO
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 			return nil, err
 		}
+		sdkCtx = a.keeper.FlatSnapshotContext(sdkCtx)
 	}
 	code := a.keeper.GetCode(sdkCtx, address)
 	return code, nil
@@ -92,6 +101,7 @@ func (a *StateAPI) GetStorageAt(ctx context.Context, address common.Address, hex
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 			return nil, err
 		}
+		sdkCtx = a.keeper.FlatSnapshotContext(sdkCtx)
 	}
 	key, _, err := decodeHash(hexKey)
 	if err != nil {
@@ -111,9 +121,28 @@ type ProofResult struct {
 	StorageProof []*crypto.ProofOps `json:"storageProof"`
 }
 
-func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (result *ProofResult, returnErr error) {
+const (
+	// ProofFormatIAVL is the default eth_getProof format, a ProofResult.
+	ProofFormatIAVL = "iavl"
+	// ProofFormatMPT makes eth_getProof return a proof.AccountResult.
+	ProofFormatMPT = "mpt"
+)
+
+// GetProof returns IAVL proofs of the storage values of an address by default. With format
+// set to ProofFormatMPT, it instead returns the account and storage values along with
+// Merkle-Patricia proofs that can be checked with standard Ethereum libraries, and
+// multistore proofs that bind those values to the app hash. See the x/evm/proof package for
+// that format and a verifier.
+func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash, format *string) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getProof", a.connectionType, startTime, returnErr == nil)
+	proofFormat := ProofFormatIAVL
+	if format != nil {
+		proofFormat = *format
+	}
+	if proofFormat != ProofFormatIAVL && proofFormat != ProofFormatMPT {
+		return nil, fmt.Errorf("unknown proof format %s", proofFormat)
+	}
 	var block *coretypes.ResultBlock
 	var err error
 	if blockNr, ok := blockNrOrHash.Number(); ok {
@@ -128,10 +157,18 @@ func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storage
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(block.Block.Height)
+	height := block.Block.Height
+	sdkCtx := a.ctxProvider(height)
 	if err := CheckVersion(sdkCtx, a.keeper); err != nil {
 		return nil, err
 	}
+	if proofFormat == ProofFormatIAVL {
+		return a.iavlProof(sdkCtx, address, storageKeys, height)
+	}
+	return a.accountProof(ctx, sdkCtx, address, storageKeys, height)
+}
+
+func (a *StateAPI) iavlProof(sdkCtx sdk.Context, address common.Address, storageKeys []string, height int64) (*ProofResult, error) {
 	var iavl *iavlstore.Store
 	s := sdkCtx.MultiStore().GetKVStore((a.keeper.GetStoreKey()))
 OUTER:
@@ -156,7 +193,7 @@ OUTER:
 		qres := iavl.Query(abci.RequestQuery{
 			Path:   "/key",
 			Data:   formattedKey,
-			Height: block.Block.Height,
+			Height: height,
 			Prove:  true,
 		})
 		proofResult.HexValues = append(proofResult.HexValues, hex.EncodeToString(qres.Value))
@@ -166,6 +203,124 @@ OUTER:
 	return &proofResult, nil
 }
 
+func (a *StateAPI) accountProof(ctx context.Context, sdkCtx sdk.Context, address common.Address, storageKeys []string, height int64) (*proof.AccountResult, error) {
+	var err error
+	slots := make([]common.Hash, 0, len(storageKeys))
+	for _, hexKey := range storageKeys {
+		key, _, err := decodeHash(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode storage key: %s", err)
+		}
+		slots = append(slots, key)
+	}
+
+	res := &proof.AccountResult{Address: address, Height: hexutil.Uint64(height)}
+	if res.CommitmentProofs.SeiAddress, err = a.storeProof(ctx, proof.EVMStoreName, types.EVMAddressToSeiAddressKey(address), height); err != nil {
+		return nil, err
+	}
+	seiAddr := sdk.AccAddress(address[:])
+	if len(res.CommitmentProofs.SeiAddress.Value) > 0 {
+		seiAddr = sdk.AccAddress(res.CommitmentProofs.SeiAddress.Value)
+	}
+	if res.CommitmentProofs.Nonce, err = a.storeProof(ctx, proof.EVMStoreName, append(types.NonceKeyPrefix, address[:]...), height); err != nil {
+		return nil, err
+	}
+	if res.CommitmentProofs.CodeHash, err = a.storeProof(ctx, proof.EVMStoreName, append(types.CodeHashKeyPrefix, address[:]...), height); err != nil {
+		return nil, err
+	}
+	if res.CommitmentProofs.Balance, err = a.storeProof(ctx, proof.BankStoreName, banktypes.CreatePrefixedAccountStoreKey(seiAddr, []byte(proof.BaseDenom)), height); err != nil {
+		return nil, err
+	}
+	if res.CommitmentProofs.WeiBalance, err = a.storeProof(ctx, proof.BankStoreName, append(banktypes.WeiBalancesPrefix, seiAddr...), height); err != nil {
+		return nil, err
+	}
+
+	// the proven balance includes locked coins since those are not committed per account
+	usei := a.keeper.BankKeeper().GetBalance(sdkCtx, seiAddr, proof.BaseDenom).Amount
+	wei := a.keeper.BankKeeper().GetWeiBalance(sdkCtx, seiAddr)
+	balance := usei.Mul(state.SdkUseiToSweiMultiplier).Add(wei).BigInt()
+	res.Balance = (*hexutil.Big)(balance)
+	res.Nonce = hexutil.Uint64(a.keeper.GetNonce(sdkCtx, address))
+	res.CodeHash = ethtypes.EmptyCodeHash
+	if bz := a.keeper.PrefixStore(sdkCtx, types.CodeHashKeyPrefix).Get(address[:]); bz != nil {
+		res.CodeHash = common.BytesToHash(bz)
+	}
+
+	storageTrie, err := a.storageTrie(sdkCtx, address)
+	if err != nil {
+		return nil, err
+	}
+	res.StorageHash = storageTrie.Hash()
+	for _, slot := range slots {
+		commitmentProof, err := a.storeProof(ctx, proof.EVMStoreName, append(types.StateKey(address), slot[:]...), height)
+		if err != nil {
+			return nil, err
+		}
+		trieProof, err := proof.Prove(storageTrie, proof.StorageTrieKey(slot))
+		if err != nil {
+			return nil, err
+		}
+		res.StorageProof = append(res.StorageProof, proof.StorageResult{
+			Key:             slot,
+			Value:           (*hexutil.Big)(new(big.Int).SetBytes(commitmentProof.Value)),
+			Proof:           trieProof,
+			CommitmentProof: commitmentProof,
+		})
+	}
+
+	account, err := proof.AccountTrieValue(uint64(res.Nonce), balance, res.StorageHash, res.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	stateTrie := proof.NewTrie()
+	if err := stateTrie.Update(proof.AccountTrieKey(address), account); err != nil {
+		return nil, err
+	}
+	res.StateRoot = stateTrie.Hash()
+	if res.AccountProof, err = proof.Prove(stateTrie, proof.AccountTrieKey(address)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// storeProof queries a key of an application store with a proof against the app hash.
+func (a *StateAPI) storeProof(ctx context.Context, storeName string, key []byte, height int64) (*proof.StoreProof, error) {
+	res, err := a.tmClient.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", storeName), key, rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("failed to query proof for key %X in store %s: %s", key, storeName, res.Response.Log)
+	}
+	return &proof.StoreProof{StoreName: storeName, Key: key, Value: res.Response.Value, ProofOps: res.Response.ProofOps}, nil
+}
+
+// storageTrie builds a Merkle-Patricia trie out of all storage slots of an account, of
+// which there may be at most maxStorageSlotsForProof.
+func (a *StateAPI) storageTrie(sdkCtx sdk.Context, address common.Address) (*trie.Trie, error) {
+	t := proof.NewTrie()
+	iter := prefix.NewStore(sdkCtx.KVStore(a.keeper.GetStoreKey()), types.StateKey(address)).Iterator(nil, nil)
+	defer iter.Close()
+	numSlots := 0
+	for ; iter.Valid(); iter.Next() {
+		numSlots++
+		if numSlots > a.maxStorageSlotsForProof {
+			return nil, fmt.Errorf("account has more than %d storage slots", a.maxStorageSlotsForProof)
+		}
+		value, err := proof.StorageTrieValue(common.BytesToHash(iter.Value()))
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+		if err := t.Update(proof.StorageTrieKey(common.BytesToHash(iter.Key())), value); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
 func (a *StateAPI) GetNonce(_ context.Context, address common.Address) uint64 {
 	startTime := time.Now()
 	defer recordMetrics("eth_getNonce", a.connectionType, startTime, true)
@@ -0,0 +1,325 @@
+package evmrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+const (
+	// MaxModifiedAccountsBlockRange is the largest number of blocks the modified accounts
+	// of which can be queried at once.
+	MaxModifiedAccountsBlockRange = 1000
+	// MaxStorageRangeResults is the largest number of storage slots returned by
+	// debug_storageRangeAt.
+	MaxStorageRangeResults = 1024
+	// MaxDumpBlockAccounts is the largest number of accounts returned by debug_dumpBlock.
+	// Larger dumps are truncated and point to the next account.
+	MaxDumpBlockAccounts = 1000
+	// MaxDumpBlockStorageSlots is the largest number of storage slots debug_dumpBlock
+	// returns per account. The rest can be paged through with debug_storageRangeAt.
+	MaxDumpBlockStorageSlots = 1024
+)
+
+var ErrStateDiffIndexDisabled = errors.New("state diff index is disabled, set enable_state_diff_index to use this method")
+
+// StorageEntry is a storage slot returned by debug_storageRangeAt.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// StorageRangeResult is a page of the storage of a contract. Like geth's, storage is keyed
+// by the hash of the slot, but slots are ordered and paged by the slot itself since Sei
+// does not store contract storage in hashed tries.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last slot
+}
+
+// DumpBlockResult is a page of accounts returned by debug_dumpBlock. Unlike geth's, Next is
+// hex encoded so that it can be passed back as start.
+type DumpBlockResult struct {
+	ethstate.Dump
+	Next hexutil.Bytes `json:"next,omitempty"` // nil if Accounts includes the last account
+}
+
+// Diff is the value of a field before and after a block.
+type Diff[T any] struct {
+	From T `json:"from"`
+	To   T `json:"to"`
+}
+
+// AccountDiff is the change a block made to an account. Fields the block did not change
+// are omitted.
+type AccountDiff struct {
+	Balance *Diff[*hexutil.Big]                `json:"balance,omitempty"`
+	Nonce   *Diff[hexutil.Uint64]              `json:"nonce,omitempty"`
+	Code    *Diff[hexutil.Bytes]               `json:"code,omitempty"`
+	Storage map[common.Hash]*Diff[common.Hash] `json:"storage,omitempty"`
+}
+
+// GetModifiedAccountsByNumber returns the accounts modified by the blocks after startNum
+// up to and including endNum, or by startNum alone if endNum is not given.
+func (api *DebugAPI) GetModifiedAccountsByNumber(ctx context.Context, startNum rpc.BlockNumber, endNum *rpc.BlockNumber) (result []common.Address, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getModifiedAccountsByNumber", api.connectionType, startTime, returnErr == nil)
+	start, err := api.heightByNumber(ctx, startNum)
+	if err != nil {
+		return nil, err
+	}
+	end := start
+	if endNum != nil {
+		if end, err = api.heightByNumber(ctx, *endNum); err != nil {
+			return nil, err
+		}
+	}
+	return api.getModifiedAccounts(start, end, endNum != nil)
+}
+
+// GetModifiedAccountsByHash returns the accounts modified by the blocks after startHash
+// up to and including endHash, or by startHash alone if endHash is not given.
+func (api *DebugAPI) GetModifiedAccountsByHash(ctx context.Context, startHash common.Hash, endHash *common.Hash) (result []common.Address, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getModifiedAccountsByHash", api.connectionType, startTime, returnErr == nil)
+	start, err := api.heightByHash(ctx, startHash)
+	if err != nil {
+		return nil, err
+	}
+	end := start
+	if endHash != nil {
+		if end, err = api.heightByHash(ctx, *endHash); err != nil {
+			return nil, err
+		}
+	}
+	return api.getModifiedAccounts(start, end, endHash != nil)
+}
+
+func (api *DebugAPI) getModifiedAccounts(start int64, end int64, isRange bool) ([]common.Address, error) {
+	if isRange {
+		if start >= end {
+			return nil, fmt.Errorf("start block %d must be before end block %d", start, end)
+		}
+		// the accounts modified since start, like geth
+		start++
+	}
+	if end-start+1 > MaxModifiedAccountsBlockRange {
+		return nil, fmt.Errorf("block range %d-%d exceeds the maximum of %d blocks", start, end, MaxModifiedAccountsBlockRange)
+	}
+	seen := map[common.Address]struct{}{}
+	res := []common.Address{}
+	enabled, err := api.keeper.IterateModifiedAccounts(uint64(start), uint64(end), func(_ uint64, addr common.Address, _ []common.Hash) bool {
+		if _, ok := seen[addr]; !ok {
+			seen[addr] = struct{}{}
+			res = append(res, addr)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrStateDiffIndexDisabled
+	}
+	sort.Slice(res, func(i, j int) bool { return bytes.Compare(res[i][:], res[j][:]) < 0 })
+	return res, nil
+}
+
+// GetStateDiffByNumber returns the balance, nonce, code and storage changes the block made
+// to each account it modified.
+func (api *DebugAPI) GetStateDiffByNumber(ctx context.Context, number rpc.BlockNumber) (result map[common.Address]*AccountDiff, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getStateDiffByNumber", api.connectionType, startTime, returnErr == nil)
+	height, err := api.heightByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	preCtx, postCtx := api.ctxProvider(height-1), api.ctxProvider(height)
+	res := map[common.Address]*AccountDiff{}
+	enabled, err := api.keeper.IterateModifiedAccounts(uint64(height), uint64(height), func(_ uint64, addr common.Address, slots []common.Hash) bool {
+		res[addr] = api.accountDiff(preCtx, postCtx, addr, slots)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, ErrStateDiffIndexDisabled
+	}
+	return res, nil
+}
+
+func (api *DebugAPI) accountDiff(preCtx sdk.Context, postCtx sdk.Context, addr common.Address, slots []common.Hash) *AccountDiff {
+	diff := &AccountDiff{}
+	preBalance := api.keeper.GetBalance(preCtx, api.keeper.GetSeiAddressOrDefault(preCtx, addr))
+	postBalance := api.keeper.GetBalance(postCtx, api.keeper.GetSeiAddressOrDefault(postCtx, addr))
+	if preBalance.Cmp(postBalance) != 0 {
+		diff.Balance = &Diff[*hexutil.Big]{From: (*hexutil.Big)(preBalance), To: (*hexutil.Big)(postBalance)}
+	}
+	if preNonce, postNonce := api.keeper.GetNonce(preCtx, addr), api.keeper.GetNonce(postCtx, addr); preNonce != postNonce {
+		diff.Nonce = &Diff[hexutil.Uint64]{From: hexutil.Uint64(preNonce), To: hexutil.Uint64(postNonce)}
+	}
+	if preCode, postCode := api.keeper.GetCode(preCtx, addr), api.keeper.GetCode(postCtx, addr); !bytes.Equal(preCode, postCode) {
+		diff.Code = &Diff[hexutil.Bytes]{From: preCode, To: postCode}
+	}
+	for _, slot := range slots {
+		preValue, postValue := api.keeper.GetState(preCtx, addr, slot), api.keeper.GetState(postCtx, addr, slot)
+		if preValue == postValue {
+			continue
+		}
+		if diff.Storage == nil {
+			diff.Storage = map[common.Hash]*Diff[common.Hash]{}
+		}
+		diff.Storage[slot] = &Diff[common.Hash]{From: preValue, To: postValue}
+	}
+	return diff
+}
+
+// StorageRangeAt returns up to maxResult storage slots of contractAddress, starting from
+// keyStart, in the state right before the transaction at txIndex in the block is executed.
+func (api *DebugAPI) StorageRangeAt(ctx context.Context, blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (result *StorageRangeResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_storageRangeAt", api.connectionType, startTime, returnErr == nil)
+	if maxResult <= 0 {
+		return nil, errors.New("maxResult must be positive")
+	}
+	if maxResult > MaxStorageRangeResults {
+		return nil, fmt.Errorf("maxResult must be at most %d", MaxStorageRangeResults)
+	}
+	block, err := api.backend.BlockByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	_, _, statedb, release, err := api.backend.StateAtTransaction(ctx, block, txIndex, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	sdkCtx := statedb.(*state.DBImpl).Ctx()
+	res := &StorageRangeResult{Storage: map[common.Hash]StorageEntry{}}
+	api.keeper.IterateStorage(sdkCtx, contractAddress, keyStart, func(key common.Hash, val common.Hash) bool {
+		if len(res.Storage) == maxResult {
+			res.NextKey = &key
+			return true
+		}
+		res.Storage[crypto.Keccak256Hash(key[:])] = StorageEntry{Key: &key, Value: val}
+		return false
+	})
+	return res, nil
+}
+
+// DumpBlock returns up to maxResults accounts, starting from the account start, that have
+// a nonce, code or an associated Sei address at the end of the block, along with their
+// balance and first MaxDumpBlockStorageSlots storage slots. Storage is keyed by slot.
+func (api *DebugAPI) DumpBlock(ctx context.Context, number rpc.BlockNumber, start *hexutil.Bytes, maxResults *int) (result *DumpBlockResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_dumpBlock", api.connectionType, startTime, returnErr == nil)
+	limit := MaxDumpBlockAccounts
+	if maxResults != nil {
+		if *maxResults <= 0 {
+			return nil, errors.New("maxResults must be positive")
+		}
+		if *maxResults > MaxDumpBlockAccounts {
+			return nil, fmt.Errorf("maxResults must be at most %d", MaxDumpBlockAccounts)
+		}
+		limit = *maxResults
+	}
+	var startKey []byte
+	if start != nil && len(*start) > 0 {
+		if len(*start) != common.AddressLength {
+			return nil, fmt.Errorf("start must be a %d byte address", common.AddressLength)
+		}
+		startKey = *start
+	}
+	height, err := api.heightByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	block, err := blockByNumberWithRetry(ctx, api.tmClient, &height, 1)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := api.ctxProvider(height)
+	// each key space is sorted by address, so the first limit+1 accounts of their union are
+	// among the first limit+1 accounts of each
+	seen := map[common.Address]struct{}{}
+	for _, pref := range [][]byte{types.NonceKeyPrefix, types.CodeKeyPrefix, types.EVMAddressToSeiAddressKeyPrefix} {
+		api.collectDumpAddresses(sdkCtx, pref, startKey, limit+1, seen)
+	}
+	addrs := make([]common.Address, 0, len(seen))
+	for addr := range seen {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	res := &DumpBlockResult{Dump: ethstate.Dump{Root: fmt.Sprintf("%x", block.Block.AppHash), Accounts: map[string]ethstate.DumpAccount{}}}
+	for i, addr := range addrs {
+		if i == limit {
+			res.Next = addr.Bytes()
+			break
+		}
+		res.Accounts[addr.Hex()] = api.dumpAccount(sdkCtx, addr)
+	}
+	return res, nil
+}
+
+// collectDumpAddresses adds the first limit addresses at or after start under pref to seen.
+func (api *DebugAPI) collectDumpAddresses(ctx sdk.Context, pref []byte, start []byte, limit int, seen map[common.Address]struct{}) {
+	iter := api.keeper.PrefixStore(ctx, pref).Iterator(start, nil)
+	defer iter.Close()
+	for i := 0; iter.Valid() && i < limit; iter.Next() {
+		seen[common.BytesToAddress(iter.Key())] = struct{}{}
+		i++
+	}
+}
+
+func (api *DebugAPI) dumpAccount(ctx sdk.Context, addr common.Address) ethstate.DumpAccount {
+	account := ethstate.DumpAccount{
+		Balance:  api.keeper.GetBalance(ctx, api.keeper.GetSeiAddressOrDefault(ctx, addr)).String(),
+		Nonce:    api.keeper.GetNonce(ctx, addr),
+		CodeHash: api.keeper.GetCodeHash(ctx, addr).Bytes(),
+		Code:     api.keeper.GetCode(ctx, addr),
+	}
+	api.keeper.IterateStorage(ctx, addr, nil, func(key common.Hash, val common.Hash) bool {
+		if account.Storage == nil {
+			account.Storage = map[common.Hash]string{}
+		}
+		account.Storage[key] = common.Bytes2Hex(common.TrimLeftZeroes(val[:]))
+		return len(account.Storage) == MaxDumpBlockStorageSlots
+	})
+	return account
+}
+
+func (api *DebugAPI) heightByNumber(ctx context.Context, number rpc.BlockNumber) (int64, error) {
+	numberPtr, err := getBlockNumber(ctx, api.tmClient, number)
+	if err != nil {
+		return 0, err
+	}
+	if numberPtr != nil {
+		return *numberPtr, nil
+	}
+	block, err := blockByNumberWithRetry(ctx, api.tmClient, nil, 1)
+	if err != nil {
+		return 0, err
+	}
+	return block.Block.Height, nil
+}
+
+func (api *DebugAPI) heightByHash(ctx context.Context, hash common.Hash) (int64, error) {
+	block, err := blockByHashWithRetry(ctx, api.tmClient, hash[:], 1)
+	if err != nil {
+		return 0, err
+	}
+	return block.Block.Height, nil
+}
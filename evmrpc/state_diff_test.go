@@ -0,0 +1,107 @@
+package evmrpc_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetModifiedAccountsIndexDisabled(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getModifiedAccountsByNumber", "0x8")
+	require.Equal(t, evmrpc.ErrStateDiffIndexDisabled.Error(), resObj["error"].(map[string]interface{})["message"])
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getStateDiffByNumber", "0x8")
+	require.Equal(t, evmrpc.ErrStateDiffIndexDisabled.Error(), resObj["error"].(map[string]interface{})["message"])
+}
+
+func TestGetModifiedAccounts(t *testing.T) {
+	EVMKeeper.SetStateDiffIndexEnabled(true)
+	defer EVMKeeper.SetStateDiffIndexEnabled(false)
+	_, addr := testkeeper.MockAddressPair()
+	require.Nil(t, EVMKeeper.SetModifiedAccounts(MockHeight8, map[common.Address][]common.Hash{addr: {common.HexToHash("0x1")}}))
+	expected := strings.ToLower(addr.Hex())
+
+	for _, params := range [][]interface{}{{"0x8"}, {"0x7", "0x8"}} {
+		resObj := sendRequestGoodWithNamespace(t, "debug", "getModifiedAccountsByNumber", params...)
+		require.Contains(t, resObj["result"], expected)
+	}
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getModifiedAccountsByHash", TestBlockHash)
+	require.Contains(t, resObj["result"], expected)
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getModifiedAccountsByNumber", "0x8", "0x8")
+	require.Equal(t, "start block 8 must be before end block 8", resObj["error"].(map[string]interface{})["message"])
+
+	// the test context provider returns the same state for every height
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getStateDiffByNumber", "0x8")
+	require.Equal(t, map[string]interface{}{}, resObj["result"].(map[string]interface{})[expected])
+}
+
+func TestStorageRangeAt(t *testing.T) {
+	_, addr := testkeeper.MockAddressPair()
+	slot1, slot2 := common.HexToHash("0x1"), common.HexToHash("0x2")
+	EVMKeeper.SetState(Ctx, addr, slot1, common.HexToHash("0xa"))
+	EVMKeeper.SetState(Ctx, addr, slot2, common.HexToHash("0xb"))
+
+	resObj := sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", DebugTraceBlockHash, 0, addr, "0x", 1)
+	result := resObj["result"].(map[string]interface{})
+	require.Equal(t, map[string]interface{}{
+		crypto.Keccak256Hash(slot1[:]).Hex(): map[string]interface{}{"key": slot1.Hex(), "value": common.HexToHash("0xa").Hex()},
+	}, result["storage"])
+	require.Equal(t, slot2.Hex(), result["nextKey"])
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", DebugTraceBlockHash, 0, addr, slot2.Hex(), 2)
+	result = resObj["result"].(map[string]interface{})
+	require.Len(t, result["storage"], 1)
+	require.Nil(t, result["nextKey"])
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", DebugTraceBlockHash, 0, addr, "0x", evmrpc.MaxStorageRangeResults+1)
+	require.Equal(t, "maxResult must be at most 1024", resObj["error"].(map[string]interface{})["message"])
+}
+
+func TestDumpBlock(t *testing.T) {
+	_, addr := testkeeper.MockAddressPair()
+	EVMKeeper.SetNonce(Ctx, addr, 3)
+	EVMKeeper.SetState(Ctx, addr, common.HexToHash("0x1"), common.HexToHash("0x5"))
+
+	resObj := sendRequestGoodWithNamespace(t, "debug", "dumpBlock", "0x8")
+	result := resObj["result"].(map[string]interface{})
+	require.NotEmpty(t, result["root"])
+	require.Nil(t, result["next"])
+	account := result["accounts"].(map[string]interface{})[addr.Hex()].(map[string]interface{})
+	require.Equal(t, float64(3), account["nonce"])
+	require.Equal(t, "0", account["balance"])
+	require.Equal(t, map[string]interface{}{common.HexToHash("0x1").Hex(): "05"}, account["storage"])
+}
+
+func TestDumpBlockPagination(t *testing.T) {
+	_, addr := testkeeper.MockAddressPair()
+	EVMKeeper.SetNonce(Ctx, addr, 1)
+	for i := 0; i <= evmrpc.MaxDumpBlockStorageSlots; i++ {
+		EVMKeeper.SetState(Ctx, addr, common.BigToHash(big.NewInt(int64(i+1))), common.HexToHash("0x1"))
+	}
+
+	// the page starts at start and storage is capped
+	resObj := sendRequestGoodWithNamespace(t, "debug", "dumpBlock", "0x8", addr.Hex(), 1)
+	result := resObj["result"].(map[string]interface{})
+	accounts := result["accounts"].(map[string]interface{})
+	require.Len(t, accounts, 1)
+	account := accounts[addr.Hex()].(map[string]interface{})
+	require.Len(t, account["storage"], evmrpc.MaxDumpBlockStorageSlots)
+
+	// the next page starts after it
+	if next, ok := result["next"].(string); ok {
+		resObj = sendRequestGoodWithNamespace(t, "debug", "dumpBlock", "0x8", next, 1)
+		accounts = resObj["result"].(map[string]interface{})["accounts"].(map[string]interface{})
+		require.Len(t, accounts, 1)
+		require.Nil(t, accounts[addr.Hex()])
+	}
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "dumpBlock", "0x8", "0x", evmrpc.MaxDumpBlockAccounts+1)
+	require.Equal(t, "maxResults must be at most 1000", resObj["error"].(map[string]interface{})["message"])
+	resObj = sendRequestGoodWithNamespace(t, "debug", "dumpBlock", "0x8", "0x01", 1)
+	require.Equal(t, "start must be a 20 byte address", resObj["error"].(map[string]interface{})["message"])
+}
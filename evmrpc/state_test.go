@@ -6,18 +6,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sei-protocol/sei-chain/app"
 	"github.com/sei-protocol/sei-chain/evmrpc"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/proof"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
 )
 
 func TestGetBalance(t *testing.T) {
@@ -205,6 +212,21 @@ func TestGetStorageAt(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(8)
 }
 
+// proofClient answers store queries with the test app so that proofs can be verified
+// against its app hash.
+type proofClient struct {
+	MockClient
+	app *app.App
+}
+
+func (c *proofClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res, err := c.app.Query(ctx, &abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: *res}, nil
+}
+
 func TestGetProof(t *testing.T) {
 	testApp := app.Setup(false, false)
 	_, evmAddr := testkeeper.MockAddressPair()
@@ -216,7 +238,7 @@ func TestGetProof(t *testing.T) {
 		_, err := testApp.Commit(context.Background())
 		require.Nil(t, err)
 	}
-	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP)
+	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, 1000)
 	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000616263", testApp.EvmKeeper.GetState(testApp.GetCheckCtx(), evmAddr, common.BytesToHash(key)).Hex())
 	tests := []struct {
 		key         string
@@ -241,11 +263,88 @@ func TestGetProof(t *testing.T) {
 	}
 	for _, test := range tests {
 		bptr := &rpc.BlockNumberOrHash{BlockNumber: &test.blockNr}
-		res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{test.key}, *bptr)
+		res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{test.key}, *bptr, nil)
 		require.Nil(t, err)
-		vals := res.HexValues
+		vals := res.(*evmrpc.ProofResult).HexValues
 		require.Equal(t, common.BytesToHash(test.expectedVal), common.HexToHash(vals[0]))
-		proofs := res.StorageProof
+		proofs := res.(*evmrpc.ProofResult).StorageProof
 		require.Equal(t, "ics23:iavl", proofs[0].Ops[0].Type)
 	}
+
+	unknown := "unknown"
+	_, err := stateAPI.GetProof(context.Background(), evmAddr, []string{string(key)}, rpc.BlockNumberOrHashWithNumber(8), &unknown)
+	require.NotNil(t, err)
+}
+
+func TestGetProofMPT(t *testing.T) {
+	testApp := app.Setup(false, false)
+	seiAddr, evmAddr := testkeeper.MockAddressPair()
+	key, val := common.HexToHash("0x1234"), common.HexToHash("0xabc")
+	ctx := testApp.GetContextForDeliverTx([]byte{})
+	testApp.EvmKeeper.SetState(ctx, evmAddr, key, val)
+	testApp.EvmKeeper.SetState(ctx, evmAddr, common.HexToHash("0x5678"), common.HexToHash("0xdef"))
+	testApp.EvmKeeper.SetNonce(ctx, evmAddr, 3)
+	testApp.EvmKeeper.SetAddressMapping(ctx, seiAddr, evmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(testApp.EvmKeeper.GetBaseDenom(ctx), sdk.NewInt(10)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, "evm", amt))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, "evm", seiAddr, amt))
+	var appHashes [][]byte
+	for i := 0; i < MockHeight8; i++ {
+		testApp.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: int64(i + 1)})
+		testApp.SetDeliverStateToCommit()
+		_, err := testApp.Commit(context.Background())
+		require.Nil(t, err)
+		appHashes = append(appHashes, testApp.LastCommitID().Hash)
+	}
+	client := &proofClient{app: testApp}
+	stateAPI := evmrpc.NewStateAPI(client, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, 1000)
+	format := evmrpc.ProofFormatMPT
+	tests := []struct {
+		key         common.Hash
+		blockNr     rpc.BlockNumber
+		expectedVal common.Hash
+	}{
+		{
+			key:         key,
+			blockNr:     rpc.BlockNumber(-2),
+			expectedVal: val,
+		},
+		{
+			key:         key,
+			blockNr:     rpc.BlockNumber(8),
+			expectedVal: val,
+		},
+		{
+			key:         common.HexToHash("0x9999"),
+			blockNr:     rpc.BlockNumber(-2),
+			expectedVal: common.Hash{},
+		},
+	}
+	for _, test := range tests {
+		bptr := &rpc.BlockNumberOrHash{BlockNumber: &test.blockNr}
+		result, err := stateAPI.GetProof(context.Background(), evmAddr, []string{test.key.Hex()}, *bptr, &format)
+		require.Nil(t, err)
+		res := result.(*proof.AccountResult)
+		require.Equal(t, uint64(3), uint64(res.Nonce))
+		require.Equal(t, "0x9184e72a000", res.Balance.String()) // 10usei
+		require.Equal(t, ethtypes.EmptyCodeHash, res.CodeHash)
+		require.Equal(t, test.expectedVal, common.BigToHash(res.StorageProof[0].Value.ToInt()))
+		require.Equal(t, "ics23:iavl", res.StorageProof[0].CommitmentProof.ProofOps.Ops[0].Type)
+		require.Nil(t, proof.VerifyAccountResult(appHashes[res.Height-1], res))
+
+		// tampered values must not verify
+		res.Nonce++
+		require.NotNil(t, proof.VerifyAccountResult(appHashes[res.Height-1], res))
+		res.Nonce--
+		res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(42))
+		require.NotNil(t, proof.VerifyAccountResult(appHashes[res.Height-1], res))
+	}
+
+	_, err := stateAPI.GetProof(context.Background(), evmAddr, []string{"not hex"}, rpc.BlockNumberOrHashWithNumber(8), &format)
+	require.NotNil(t, err)
+
+	// the account has more storage slots than the configured maximum
+	stateAPI = evmrpc.NewStateAPI(client, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP, 1)
+	_, err = stateAPI.GetProof(context.Background(), evmAddr, []string{key.Hex()}, rpc.BlockNumberOrHashWithNumber(8), &format)
+	require.NotNil(t, err)
 }
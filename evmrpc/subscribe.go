@@ -13,9 +13,11 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -23,6 +25,8 @@ import (
 
 const SleepInterval = 5 * time.Second
 const NewHeadsListenerBuffer = 10
+const NewPendingTxsListenerBuffer = 100
+const SyncingListenerBuffer = 10
 
 type SubscriptionAPI struct {
 	tmClient            rpcclient.Client
@@ -33,24 +37,71 @@ type SubscriptionAPI struct {
 	newHeadListenersMtx *sync.RWMutex
 	newHeadListeners    map[rpc.ID]chan map[string]interface{}
 	connectionType      ConnectionType
+
+	keeper                *keeper.Keeper
+	ctxProvider           func(int64) sdk.Context
+	txDecoder             sdk.TxDecoder
+	pendingTxListenersMtx *sync.RWMutex
+	pendingTxListeners    map[rpc.ID]*pendingTxListener
+	syncingListenersMtx   *sync.RWMutex
+	syncingListeners      map[rpc.ID]chan *SyncingResult
 }
 
 type SubscriptionConfig struct {
 	subscriptionCapacity int
 	newHeadLimit         uint64
+	pendingTxLimit       uint64
+	syncingLimit         uint64
+	maxPendingTxs        int
+}
+
+// pendingTxListener keeps track of the mempool transactions already sent to a subscriber so
+// that each transaction is only notified once per subscription.
+type pendingTxListener struct {
+	c    chan *ethtypes.Transaction
+	seen map[common.Hash]struct{}
 }
 
-func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
+// SyncingResult is the payload of a `syncing` subscription notification.
+type SyncingResult struct {
+	Syncing bool                   `json:"syncing"`
+	Status  map[string]interface{} `json:"status,omitempty"`
+}
+
+func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
 	logFetcher.filterConfig = filterConfig
 	api := &SubscriptionAPI{
-		tmClient:            tmClient,
-		subscriptionManager: NewSubscriptionManager(tmClient),
-		subscriptonConfig:   subscriptionConfig,
-		logFetcher:          logFetcher,
-		newHeadListenersMtx: &sync.RWMutex{},
-		newHeadListeners:    make(map[rpc.ID]chan map[string]interface{}),
-		connectionType:      connectionType,
+		tmClient:              tmClient,
+		subscriptionManager:   NewSubscriptionManager(tmClient),
+		subscriptonConfig:     subscriptionConfig,
+		logFetcher:            logFetcher,
+		newHeadListenersMtx:   &sync.RWMutex{},
+		newHeadListeners:      make(map[rpc.ID]chan map[string]interface{}),
+		connectionType:        connectionType,
+		keeper:                k,
+		ctxProvider:           ctxProvider,
+		txDecoder:             txDecoder,
+		pendingTxListenersMtx: &sync.RWMutex{},
+		pendingTxListeners:    make(map[rpc.ID]*pendingTxListener),
+		syncingListenersMtx:   &sync.RWMutex{},
+		syncingListeners:      make(map[rpc.ID]chan *SyncingResult),
+	}
+	blockID, blockCh, err := api.subscriptionManager.Subscribe(context.Background(), NewBlockQueryBuilder(), api.subscriptonConfig.subscriptionCapacity)
+	if err != nil {
+		panic(err)
 	}
+	go func() {
+		defer func() {
+			_ = api.subscriptionManager.Unsubscribe(context.Background(), blockID)
+		}()
+		// the mempool is rechecked and the sync status can only change when a block is
+		// committed, so both are refreshed once per new block
+		var lastSyncing *SyncingResult
+		for range blockCh {
+			api.notifyPendingTxs()
+			lastSyncing = api.notifySyncing(lastSyncing)
+		}
+	}()
 	id, subCh, err := api.subscriptionManager.Subscribe(context.Background(), NewHeadQueryBuilder(), api.subscriptonConfig.subscriptionCapacity)
 	if err != nil {
 		panic(err)
@@ -85,11 +136,11 @@ func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider
 	return api
 }
 
-func handleListener(c chan map[string]interface{}, ethHeader map[string]interface{}) bool {
+func handleListener[T any](c chan T, event T) bool {
 	// if the channel is already closed, sending to it/closing it will panic
 	defer func() { _ = recover() }()
 	select {
-	case c <- ethHeader:
+	case c <- event:
 		return true
 	default:
 		// this path is hit when the buffer is full, meaning that the subscriber is not consuming
@@ -209,6 +260,203 @@ func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriter
 	return rpcSub, nil
 }
 
+// NewPendingTransactions notifies the subscriber of every EVM transaction that enters the
+// mempool, checked at every new block. Only hashes are sent unless fullTx is set.
+func (a *SubscriptionAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_newPendingTransactions", a.connectionType, time.Now(), err == nil)
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	listener := make(chan *ethtypes.Transaction, NewPendingTxsListenerBuffer)
+	a.pendingTxListenersMtx.Lock()
+	defer a.pendingTxListenersMtx.Unlock()
+	if uint64(len(a.pendingTxListeners)) >= a.subscriptonConfig.pendingTxLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	a.pendingTxListeners[rpcSub.ID] = &pendingTxListener{c: listener, seen: map[common.Hash]struct{}{}}
+
+	chainConfig := types.DefaultChainConfig().EthereumConfig(a.keeper.ChainID(a.ctxProvider(LatestCtxHeight)))
+	go func() {
+	OUTER:
+		for {
+			select {
+			case tx, ok := <-listener:
+				if !ok {
+					break OUTER
+				}
+				var res interface{} = tx.Hash()
+				if fullTx != nil && *fullTx {
+					res = ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+				}
+				if err := notifier.Notify(rpcSub.ID, res); err != nil {
+					break OUTER
+				}
+			case <-rpcSub.Err():
+				break OUTER
+			case <-notifier.Closed():
+				break OUTER
+			}
+		}
+		a.pendingTxListenersMtx.Lock()
+		defer a.pendingTxListenersMtx.Unlock()
+		delete(a.pendingTxListeners, rpcSub.ID)
+		defer func() { _ = recover() }() // might have already been closed
+		close(listener)
+	}()
+
+	return rpcSub, nil
+}
+
+// Syncing notifies the subscriber of the current sync status of the node and of every
+// subsequent change to it.
+func (a *SubscriptionAPI) Syncing(ctx context.Context) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_syncing", a.connectionType, time.Now(), err == nil)
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	current, err := a.syncStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	listener := make(chan *SyncingResult, SyncingListenerBuffer)
+	listener <- current
+	a.syncingListenersMtx.Lock()
+	defer a.syncingListenersMtx.Unlock()
+	if uint64(len(a.syncingListeners)) >= a.subscriptonConfig.syncingLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	a.syncingListeners[rpcSub.ID] = listener
+
+	go func() {
+	OUTER:
+		for {
+			select {
+			case res, ok := <-listener:
+				if !ok {
+					break OUTER
+				}
+				if err := notifier.Notify(rpcSub.ID, res); err != nil {
+					break OUTER
+				}
+			case <-rpcSub.Err():
+				break OUTER
+			case <-notifier.Closed():
+				break OUTER
+			}
+		}
+		a.syncingListenersMtx.Lock()
+		defer a.syncingListenersMtx.Unlock()
+		delete(a.syncingListeners, rpcSub.ID)
+		defer func() { _ = recover() }() // might have already been closed
+		close(listener)
+	}()
+
+	return rpcSub, nil
+}
+
+// notifyPendingTxs fetches the mempool and forwards EVM transactions to every pending
+// transaction listener that has not been notified of them yet.
+func (a *SubscriptionAPI) notifyPendingTxs() {
+	a.pendingTxListenersMtx.RLock()
+	numListeners := len(a.pendingTxListeners)
+	a.pendingTxListenersMtx.RUnlock()
+	if numListeners == 0 {
+		return
+	}
+	total := a.subscriptonConfig.maxPendingTxs
+	res, err := a.tmClient.UnconfirmedTxs(context.Background(), nil, &total)
+	if err != nil {
+		fmt.Printf("error fetching unconfirmed txs for pending tx subscriptions due to %s\n", err)
+		return
+	}
+	ethTxs := []*ethtypes.Transaction{}
+	for _, tx := range res.Txs {
+		if ethTx := getEthTxForTxBz(tx, a.txDecoder); ethTx != nil {
+			ethTxs = append(ethTxs, ethTx)
+		}
+	}
+	a.pendingTxListenersMtx.Lock()
+	defer a.pendingTxListenersMtx.Unlock()
+	toDelete := []rpc.ID{}
+	for id, l := range a.pendingTxListeners {
+		// only remember txs still in the mempool so that the seen set stays bounded
+		current := make(map[common.Hash]struct{}, len(ethTxs))
+		for _, tx := range ethTxs {
+			current[tx.Hash()] = struct{}{}
+			if _, ok := l.seen[tx.Hash()]; ok {
+				continue
+			}
+			if !handleListener(l.c, tx) {
+				toDelete = append(toDelete, id)
+				break
+			}
+		}
+		l.seen = current
+	}
+	for _, id := range toDelete {
+		delete(a.pendingTxListeners, id)
+	}
+}
+
+// notifySyncing checks the node status and notifies all syncing listeners if the node
+// started or stopped catching up since last. It returns the status to compare against next.
+func (a *SubscriptionAPI) notifySyncing(last *SyncingResult) *SyncingResult {
+	a.syncingListenersMtx.RLock()
+	numListeners := len(a.syncingListeners)
+	a.syncingListenersMtx.RUnlock()
+	if numListeners == 0 {
+		return nil
+	}
+	current, err := a.syncStatus(context.Background())
+	if err != nil {
+		fmt.Printf("error fetching node status for syncing subscriptions due to %s\n", err)
+		return last
+	}
+	if last != nil && last.Syncing == current.Syncing {
+		return last
+	}
+	a.syncingListenersMtx.Lock()
+	defer a.syncingListenersMtx.Unlock()
+	toDelete := []rpc.ID{}
+	for id, c := range a.syncingListeners {
+		if !handleListener(c, current) {
+			toDelete = append(toDelete, id)
+		}
+	}
+	for _, id := range toDelete {
+		delete(a.syncingListeners, id)
+	}
+	return current
+}
+
+func (a *SubscriptionAPI) syncStatus(ctx context.Context) (*SyncingResult, error) {
+	status, err := a.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !status.SyncInfo.CatchingUp {
+		return &SyncingResult{Syncing: false}, nil
+	}
+	highest := status.SyncInfo.MaxPeerBlockHeight
+	if highest < status.SyncInfo.LatestBlockHeight {
+		highest = status.SyncInfo.LatestBlockHeight
+	}
+	return &SyncingResult{
+		Syncing: true,
+		Status: map[string]interface{}{
+			"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+			"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+			"highestBlock":  hexutil.Uint64(highest),
+		},
+	}, nil
+}
+
 const SubscriberPrefix = "evm.rpc."
 
 type SubscriberID uint64
@@ -83,6 +83,71 @@ func TestSubscribeNewHeads(t *testing.T) {
 	}
 }
 
+func TestSubscribeNewPendingTransactions(t *testing.T) {
+	t.Parallel()
+	for _, fullTx := range []bool{false, true} {
+		recvCh, done := sendWSRequestGood(t, "subscribe", "newPendingTransactions", fullTx)
+
+		receivedSubMsg := false
+		var subscriptionId string
+		timer := time.NewTimer(5 * time.Second)
+	OUTER:
+		for {
+			select {
+			case resObj := <-recvCh:
+				if _, ok := resObj["error"]; ok {
+					t.Fatal("Received error:", resObj["error"])
+				}
+				if !receivedSubMsg {
+					subscriptionId = resObj["result"].(string)
+					receivedSubMsg = true
+					continue
+				}
+				paramMap := resObj["params"].(map[string]interface{})
+				require.Equal(t, subscriptionId, paramMap["subscription"])
+				if fullTx {
+					tx := paramMap["result"].(map[string]interface{})
+					require.Nil(t, tx["blockHash"])
+					require.NotEmpty(t, tx["hash"])
+				} else {
+					require.Regexp(t, "^0x[0-9a-f]{64}$", paramMap["result"])
+				}
+				break OUTER
+			case <-timer.C:
+				t.Fatal("No pending transaction received within 5 seconds")
+			}
+		}
+		done <- struct{}{}
+	}
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	t.Parallel()
+	recvCh, done := sendWSRequestGood(t, "subscribe", "syncing")
+	defer func() { done <- struct{}{} }()
+
+	receivedSubMsg := false
+	timer := time.NewTimer(2 * time.Second)
+	for {
+		select {
+		case resObj := <-recvCh:
+			if _, ok := resObj["error"]; ok {
+				t.Fatal("Received error:", resObj["error"])
+			}
+			if !receivedSubMsg {
+				receivedSubMsg = true
+				continue
+			}
+			// the current status is sent right after subscribing
+			result := resObj["params"].(map[string]interface{})["result"].(map[string]interface{})
+			require.Equal(t, false, result["syncing"])
+			return
+		case <-timer.C:
+			t.Fatal("No syncing status received within 2 seconds")
+		}
+	}
+}
+
 func TestSubscribeEmptyLogs(t *testing.T) {
 	t.Parallel()
 	recvCh, done := sendWSRequestGood(t, "subscribe", "logs")
@@ -94,6 +94,7 @@ func SetupTestServer(
 		a.RPCContextProvider,
 		a.GetTxConfig(),
 		"",
+		nil,
 		func(ctx context.Context, hash common.Hash) (bool, error) {
 			return false, nil
 		},
@@ -0,0 +1,664 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+var (
+	callTracerName     = "callTracer"
+	prestateTracerName = "prestateTracer"
+)
+
+// TraceAPI implements the OpenEthereum/Erigon style trace_* namespace. Traces are
+// produced by the geth callTracer and flattened into the parity trace format.
+type TraceAPI struct {
+	tracersAPI     *tracers.API
+	backend        *Backend
+	tmClient       rpcclient.Client
+	keeper         *keeper.Keeper
+	ctxProvider    func(int64) sdk.Context
+	maxFilterBlock int64
+	connectionType ConnectionType
+}
+
+type SeiTraceAPI struct {
+	traceAPI *TraceAPI
+}
+
+func NewTraceAPI(
+	tmClient rpcclient.Client,
+	k *keeper.Keeper,
+	ctxProvider func(int64) sdk.Context,
+	txDecoder sdk.TxDecoder,
+	config *SimulateConfig,
+	app *baseapp.BaseApp,
+	antehandler sdk.AnteHandler,
+	maxFilterBlock int64,
+	connectionType ConnectionType,
+) *TraceAPI {
+	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config, app, antehandler)
+	return &TraceAPI{
+		tracersAPI:     tracers.NewAPI(backend),
+		backend:        backend,
+		tmClient:       tmClient,
+		keeper:         k,
+		ctxProvider:    ctxProvider,
+		maxFilterBlock: maxFilterBlock,
+		connectionType: connectionType,
+	}
+}
+
+func NewSeiTraceAPI(
+	tmClient rpcclient.Client,
+	k *keeper.Keeper,
+	ctxProvider func(int64) sdk.Context,
+	txDecoder sdk.TxDecoder,
+	config *SimulateConfig,
+	app *baseapp.BaseApp,
+	antehandler sdk.AnteHandler,
+	maxFilterBlock int64,
+	connectionType ConnectionType,
+) *SeiTraceAPI {
+	return &SeiTraceAPI{
+		traceAPI: NewTraceAPI(tmClient, k, ctxProvider, txDecoder, config, app, antehandler, maxFilterBlock, connectionType),
+	}
+}
+
+// ParityTrace is a single flat call trace in the OpenEthereum format.
+type ParityTrace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           common.Hash  `json:"blockHash"`
+	BlockNumber         uint64       `json:"blockNumber"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash"`
+	TransactionPosition *uint64      `json:"transactionPosition"`
+	Type                string       `json:"type"`
+}
+
+type ParityCallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+type ParityCallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+type ParityCreateAction struct {
+	CreationMethod string         `json:"creationMethod"`
+	From           common.Address `json:"from"`
+	Gas            hexutil.Uint64 `json:"gas"`
+	Init           hexutil.Bytes  `json:"init"`
+	Value          *hexutil.Big   `json:"value"`
+}
+
+type ParityCreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+type ParitySuicideAction struct {
+	Address       common.Address `json:"address"`
+	RefundAddress common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big   `json:"balance"`
+}
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// TraceResults is a single element of trace_replayBlockTransactions.
+type TraceResults struct {
+	Output          hexutil.Bytes                       `json:"output"`
+	StateDiff       map[common.Address]*ParityStateDiff `json:"stateDiff"`
+	Trace           []*ParityTrace                      `json:"trace"`
+	VMTrace         interface{}                         `json:"vmTrace"`
+	TransactionHash common.Hash                         `json:"transactionHash"`
+}
+
+// ParityStateDiff describes how an account changed over a transaction. Each field is either
+// "=" (unchanged), {"+": new} (created), {"-": old} (deleted) or {"*": {"from": old, "to": new}}.
+type ParityStateDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// traceCallFrame mirrors the JSON output of the geth callTracer.
+type traceCallFrame struct {
+	Type    string            `json:"type"`
+	From    common.Address    `json:"from"`
+	To      *common.Address   `json:"to"`
+	Gas     hexutil.Uint64    `json:"gas"`
+	GasUsed hexutil.Uint64    `json:"gasUsed"`
+	Input   hexutil.Bytes     `json:"input"`
+	Output  hexutil.Bytes     `json:"output"`
+	Error   string            `json:"error"`
+	Value   *hexutil.Big      `json:"value"`
+	Calls   []*traceCallFrame `json:"calls"`
+}
+
+// traceAccount mirrors the JSON output of the geth prestateTracer.
+type traceAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+type tracePrestateDiff struct {
+	Pre  map[common.Address]*traceAccount `json:"pre"`
+	Post map[common.Address]*traceAccount `json:"post"`
+}
+
+// blockMeta is the location information attached to each flat trace of a block.
+type blockMeta struct {
+	hash   common.Hash
+	number uint64
+	txs    []*ethtypes.Transaction
+}
+
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("trace_block", api.connectionType, startTime, returnErr)
+	return api.traceBlock(ctx, number, false)
+}
+
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("trace_transaction", api.connectionType, startTime, returnErr)
+	receipt, err := api.keeper.GetReceipt(api.ctxProvider(LatestCtxHeight), hash)
+	if err != nil {
+		return nil, err
+	}
+	height := int64(receipt.BlockNumber)
+	block, err := blockByNumber(ctx, api.tmClient, &height)
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.tracersAPI.TraceTransaction(ctx, hash, &tracers.TraceConfig{Tracer: &callTracerName})
+	if err != nil {
+		return nil, err
+	}
+	frame := &traceCallFrame{}
+	if err := remarshal(res, frame); err != nil {
+		return nil, err
+	}
+	meta := &blockMeta{hash: common.BytesToHash(block.BlockID.Hash), number: uint64(height)}
+	position := uint64(receipt.TransactionIndex)
+	return flattenCallFrame(frame, meta, &hash, &position), nil
+}
+
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("trace_filter", api.connectionType, startTime, returnErr)
+	return api.filter(ctx, args, false)
+}
+
+func (api *TraceAPI) ReplayBlockTransactions(ctx context.Context, number rpc.BlockNumber, traceTypes []string) (result []*TraceResults, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("trace_replayBlockTransactions", api.connectionType, startTime, returnErr)
+	return api.replayBlockTransactions(ctx, number, traceTypes, false)
+}
+
+func (api *SeiTraceAPI) TraceBlockExcludeTraceFail(ctx context.Context, number rpc.BlockNumber) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("sei_traceBlockExcludeTraceFail", api.traceAPI.connectionType, startTime, returnErr)
+	return api.traceAPI.traceBlock(ctx, number, true)
+}
+
+func (api *SeiTraceAPI) TraceFilterExcludeTraceFail(ctx context.Context, args TraceFilterArgs) (result []*ParityTrace, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("sei_traceFilterExcludeTraceFail", api.traceAPI.connectionType, startTime, returnErr)
+	return api.traceAPI.filter(ctx, args, true)
+}
+
+func (api *SeiTraceAPI) TraceReplayBlockTransactionsExcludeTraceFail(ctx context.Context, number rpc.BlockNumber, traceTypes []string) (result []*TraceResults, returnErr error) {
+	startTime := time.Now()
+	defer recordMetricsWithError("sei_traceReplayBlockTransactionsExcludeTraceFail", api.traceAPI.connectionType, startTime, returnErr)
+	return api.traceAPI.replayBlockTransactions(ctx, number, traceTypes, true)
+}
+
+func (api *TraceAPI) traceBlock(ctx context.Context, number rpc.BlockNumber, excludeTraceFail bool) ([]*ParityTrace, error) {
+	height, err := api.backend.getBlockHeight(ctx, rpc.BlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	results, err := api.tracersAPI.TraceBlockByNumber(ctx, rpc.BlockNumber(height), &tracers.TraceConfig{Tracer: &callTracerName})
+	if err != nil {
+		return nil, err
+	}
+	meta, err := api.blockMeta(ctx, height, results, excludeTraceFail)
+	if err != nil {
+		return nil, err
+	}
+	traces := []*ParityTrace{}
+	for i, res := range results {
+		txHash := res.TxHash
+		position := api.txPosition(txHash, i)
+		if len(res.Error) > 0 {
+			if !excludeTraceFail {
+				traces = append(traces, failedTrace(meta, i, &txHash, &position, res.Error))
+			}
+			continue
+		}
+		frame := &traceCallFrame{}
+		if err := remarshal(res.Result, frame); err != nil {
+			return nil, err
+		}
+		traces = append(traces, flattenCallFrame(frame, meta, &txHash, &position)...)
+	}
+	return traces, nil
+}
+
+func (api *TraceAPI) filter(ctx context.Context, args TraceFilterArgs, excludeTraceFail bool) ([]*ParityTrace, error) {
+	latest := api.ctxProvider(LatestCtxHeight).BlockHeight()
+	begin, end := latest, latest
+	if args.FromBlock != nil {
+		begin = getHeightFromBigIntBlockNumber(latest, big.NewInt(args.FromBlock.Int64()))
+	}
+	if args.ToBlock != nil {
+		end = getHeightFromBigIntBlockNumber(latest, big.NewInt(args.ToBlock.Int64()))
+	}
+	if begin > end {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
+	}
+	if api.maxFilterBlock > 0 && end >= (begin+api.maxFilterBlock) {
+		return nil, fmt.Errorf("a maximum of %d blocks worth of traces may be requested at a time", api.maxFilterBlock)
+	}
+	fromAddresses := map[common.Address]struct{}{}
+	for _, addr := range args.FromAddress {
+		fromAddresses[addr] = struct{}{}
+	}
+	toAddresses := map[common.Address]struct{}{}
+	for _, addr := range args.ToAddress {
+		toAddresses[addr] = struct{}{}
+	}
+	var after, count uint64
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil {
+		count = *args.Count
+	}
+	traces := []*ParityTrace{}
+	skipped := uint64(0)
+	for height := begin; height <= end; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		blockTraces, err := api.traceBlock(ctx, rpc.BlockNumber(height), excludeTraceFail)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !traceMatchesAddresses(trace, fromAddresses, toAddresses) {
+				continue
+			}
+			if skipped < after {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if count > 0 && uint64(len(traces)) >= count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+func (api *TraceAPI) replayBlockTransactions(ctx context.Context, number rpc.BlockNumber, traceTypes []string, excludeTraceFail bool) ([]*TraceResults, error) {
+	var withTrace, withStateDiff bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("unknown trace type %s", traceType)
+		}
+	}
+	height, err := api.backend.getBlockHeight(ctx, rpc.BlockNumberOrHashWithNumber(number))
+	if err != nil {
+		return nil, err
+	}
+	// the call trace is always needed for the output of each transaction
+	callResults, err := api.tracersAPI.TraceBlockByNumber(ctx, rpc.BlockNumber(height), &tracers.TraceConfig{Tracer: &callTracerName})
+	if err != nil {
+		return nil, err
+	}
+	var diffResults []*tracers.TxTraceResult
+	if withStateDiff {
+		diffResults, err = api.tracersAPI.TraceBlockByNumber(ctx, rpc.BlockNumber(height), &tracers.TraceConfig{
+			Tracer:       &prestateTracerName,
+			TracerConfig: json.RawMessage(`{"diffMode":true}`),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(diffResults) != len(callResults) {
+			return nil, fmt.Errorf("inconsistent trace results for block %d", height)
+		}
+	}
+	meta, err := api.blockMeta(ctx, height, callResults, excludeTraceFail)
+	if err != nil {
+		return nil, err
+	}
+	results := []*TraceResults{}
+	for i, res := range callResults {
+		txHash := res.TxHash
+		position := api.txPosition(txHash, i)
+		if len(res.Error) > 0 {
+			if excludeTraceFail {
+				continue
+			}
+			replay := &TraceResults{Output: hexutil.Bytes{}, TransactionHash: txHash}
+			if withTrace {
+				replay.Trace = []*ParityTrace{failedTrace(meta, i, &txHash, &position, res.Error)}
+			}
+			results = append(results, replay)
+			continue
+		}
+		frame := &traceCallFrame{}
+		if err := remarshal(res.Result, frame); err != nil {
+			return nil, err
+		}
+		replay := &TraceResults{Output: frame.Output, TransactionHash: txHash}
+		if replay.Output == nil {
+			replay.Output = hexutil.Bytes{}
+		}
+		if withTrace {
+			replay.Trace = flattenCallFrame(frame, meta, &txHash, &position)
+		}
+		if withStateDiff {
+			if len(diffResults[i].Error) > 0 {
+				return nil, errors.New(diffResults[i].Error)
+			}
+			diff := &tracePrestateDiff{}
+			if err := remarshal(diffResults[i].Result, diff); err != nil {
+				return nil, err
+			}
+			replay.StateDiff = toParityStateDiff(diff)
+		}
+		results = append(results, replay)
+	}
+	return results, nil
+}
+
+// blockMeta resolves the hash of the block at the given height and, if any transaction
+// failed tracing and should be reported, the transactions themselves.
+func (api *TraceAPI) blockMeta(ctx context.Context, height int64, results []*tracers.TxTraceResult, excludeTraceFail bool) (*blockMeta, error) {
+	block, err := blockByNumber(ctx, api.tmClient, &height)
+	if err != nil {
+		return nil, err
+	}
+	meta := &blockMeta{hash: common.BytesToHash(block.BlockID.Hash), number: uint64(height)}
+	if excludeTraceFail {
+		return meta, nil
+	}
+	for _, res := range results {
+		if len(res.Error) > 0 {
+			ethBlock, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			meta.txs = ethBlock.Transactions()
+			break
+		}
+	}
+	return meta, nil
+}
+
+// txPosition returns the index of the transaction in the block as reported by its receipt,
+// which is the index used by eth_getTransactionByHash.
+func (api *TraceAPI) txPosition(hash common.Hash, fallback int) uint64 {
+	receipt, err := api.keeper.GetReceipt(api.ctxProvider(LatestCtxHeight), hash)
+	if err != nil {
+		return uint64(fallback)
+	}
+	return uint64(receipt.TransactionIndex)
+}
+
+// failedTrace builds the top-level trace of a transaction that could not be traced, e.g.
+// because it failed a nonce or balance check, from the transaction itself.
+func failedTrace(meta *blockMeta, idx int, txHash *common.Hash, position *uint64, traceErr string) *ParityTrace {
+	trace := &ParityTrace{
+		BlockHash:           meta.hash,
+		BlockNumber:         meta.number,
+		Error:               traceErr,
+		TraceAddress:        []int{},
+		TransactionHash:     txHash,
+		TransactionPosition: position,
+		Type:                "call",
+	}
+	if idx >= len(meta.txs) {
+		return trace
+	}
+	tx := meta.txs[idx]
+	from, _ := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if tx.To() == nil {
+		trace.Type = "create"
+		trace.Action = &ParityCreateAction{CreationMethod: "create", From: from, Gas: hexutil.Uint64(tx.Gas()), Init: tx.Data(), Value: (*hexutil.Big)(tx.Value())}
+		return trace
+	}
+	trace.Action = &ParityCallAction{CallType: "call", From: from, To: *tx.To(), Gas: hexutil.Uint64(tx.Gas()), Input: tx.Data(), Value: (*hexutil.Big)(tx.Value())}
+	return trace
+}
+
+// flattenCallFrame converts a nested callTracer frame into a depth-first list of flat traces.
+func flattenCallFrame(root *traceCallFrame, meta *blockMeta, txHash *common.Hash, position *uint64) []*ParityTrace {
+	traces := []*ParityTrace{}
+	var walk func(frame *traceCallFrame, traceAddress []int)
+	walk = func(frame *traceCallFrame, traceAddress []int) {
+		trace := &ParityTrace{
+			BlockHash:           meta.hash,
+			BlockNumber:         meta.number,
+			Subtraces:           len(frame.Calls),
+			TraceAddress:        traceAddress,
+			TransactionHash:     txHash,
+			TransactionPosition: position,
+		}
+		value := frame.Value
+		if value == nil {
+			value = (*hexutil.Big)(new(big.Int))
+		}
+		to := common.Address{}
+		if frame.To != nil {
+			to = *frame.To
+		}
+		switch frame.Type {
+		case "CREATE", "CREATE2":
+			trace.Type = "create"
+			trace.Action = &ParityCreateAction{CreationMethod: strings.ToLower(frame.Type), From: frame.From, Gas: frame.Gas, Init: frame.Input, Value: value}
+			if frame.Error == "" {
+				trace.Result = &ParityCreateResult{Address: to, Code: frame.Output, GasUsed: frame.GasUsed}
+			}
+		case "SELFDESTRUCT":
+			trace.Type = "suicide"
+			trace.Action = &ParitySuicideAction{Address: frame.From, RefundAddress: to, Balance: value}
+		default:
+			trace.Type = "call"
+			trace.Action = &ParityCallAction{CallType: strings.ToLower(frame.Type), From: frame.From, To: to, Gas: frame.Gas, Input: frame.Input, Value: value}
+			if frame.Error == "" {
+				output := frame.Output
+				if output == nil {
+					output = hexutil.Bytes{}
+				}
+				trace.Result = &ParityCallResult{GasUsed: frame.GasUsed, Output: output}
+			}
+		}
+		trace.Error = toParityError(frame.Error)
+		traces = append(traces, trace)
+		for i, call := range frame.Calls {
+			childAddress := make([]int, len(traceAddress)+1)
+			copy(childAddress, traceAddress)
+			childAddress[len(traceAddress)] = i
+			walk(call, childAddress)
+		}
+	}
+	walk(root, []int{})
+	return traces
+}
+
+func toParityError(err string) string {
+	switch err {
+	case "execution reverted":
+		return "Reverted"
+	case "out of gas":
+		return "Out of gas"
+	default:
+		return err
+	}
+}
+
+func traceMatchesAddresses(trace *ParityTrace, fromAddresses map[common.Address]struct{}, toAddresses map[common.Address]struct{}) bool {
+	var from, to common.Address
+	switch action := trace.Action.(type) {
+	case *ParityCallAction:
+		from, to = action.From, action.To
+	case *ParityCreateAction:
+		from = action.From
+		if result, ok := trace.Result.(*ParityCreateResult); ok {
+			to = result.Address
+		}
+	case *ParitySuicideAction:
+		from, to = action.Address, action.RefundAddress
+	}
+	if len(fromAddresses) > 0 {
+		if _, ok := fromAddresses[from]; !ok {
+			return false
+		}
+	}
+	if len(toAddresses) > 0 {
+		if _, ok := toAddresses[to]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// toParityStateDiff converts the output of the prestateTracer in diff mode into the
+// parity stateDiff format.
+func toParityStateDiff(diff *tracePrestateDiff) map[common.Address]*ParityStateDiff {
+	res := map[common.Address]*ParityStateDiff{}
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			// accounts only present in the pre-state were removed
+			accountDiff := &ParityStateDiff{
+				Balance: map[string]interface{}{"-": balanceOrZero(pre.Balance)},
+				Nonce:   map[string]interface{}{"-": hexutil.Uint64(pre.Nonce)},
+				Code:    map[string]interface{}{"-": pre.Code},
+				Storage: map[common.Hash]interface{}{},
+			}
+			for slot, val := range pre.Storage {
+				accountDiff.Storage[slot] = map[string]interface{}{"-": val}
+			}
+			res[addr] = accountDiff
+			continue
+		}
+		accountDiff := &ParityStateDiff{Balance: "=", Nonce: "=", Code: "=", Storage: map[common.Hash]interface{}{}}
+		if post.Balance != nil && balanceOrZero(post.Balance).ToInt().Cmp(balanceOrZero(pre.Balance).ToInt()) != 0 {
+			accountDiff.Balance = changedValue(balanceOrZero(pre.Balance), post.Balance)
+		}
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			accountDiff.Nonce = changedValue(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+		}
+		if post.Code != nil {
+			accountDiff.Code = changedValue(pre.Code, post.Code)
+		}
+		for slot, val := range post.Storage {
+			accountDiff.Storage[slot] = changedValue(pre.Storage[slot], val)
+		}
+		for slot, val := range pre.Storage {
+			if _, ok := post.Storage[slot]; !ok {
+				// slots missing from the post-state were cleared
+				accountDiff.Storage[slot] = changedValue(val, common.Hash{})
+			}
+		}
+		res[addr] = accountDiff
+	}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; ok {
+			continue
+		}
+		// accounts only present in the post-state were created
+		accountDiff := &ParityStateDiff{
+			Balance: map[string]interface{}{"+": balanceOrZero(post.Balance)},
+			Nonce:   map[string]interface{}{"+": hexutil.Uint64(post.Nonce)},
+			Code:    map[string]interface{}{"+": post.Code},
+			Storage: map[common.Hash]interface{}{},
+		}
+		for slot, val := range post.Storage {
+			accountDiff.Storage[slot] = map[string]interface{}{"+": val}
+		}
+		res[addr] = accountDiff
+	}
+	return res
+}
+
+func changedValue(from interface{}, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+func balanceOrZero(balance *hexutil.Big) *hexutil.Big {
+	if balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return balance
+}
+
+// remarshal converts a tracer result, which may be a json.RawMessage or a decoded value,
+// into the given typed structure.
+func remarshal(src interface{}, dst interface{}) error {
+	bz, ok := src.(json.RawMessage)
+	if !ok {
+		var err error
+		if bz, err = json.Marshal(src); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(bz, dst)
+}
@@ -0,0 +1,100 @@
+package evmrpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "block", "0x65")
+	traces := resObj["result"].([]interface{})
+	require.Equal(t, 1, len(traces))
+	trace := traces[0].(map[string]interface{})
+	require.Equal(t, "call", trace["type"])
+	require.Equal(t, float64(101), trace["blockNumber"])
+	require.NotNil(t, trace["transactionHash"])
+	require.Equal(t, []interface{}{}, trace["traceAddress"])
+	require.Equal(t, float64(0), trace["subtraces"])
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, "call", action["callType"])
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", action["from"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", action["to"])
+	require.Equal(t, "0x30d40", action["gas"])
+	require.Equal(t, "0x616263", action["input"])
+	require.Equal(t, "0x3e8", action["value"])
+	require.NotNil(t, trace["result"])
+}
+
+func TestTraceTransaction_Parity(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "transaction", DebugTraceHashHex)
+	traces := resObj["result"].([]interface{})
+	require.Equal(t, 1, len(traces))
+	trace := traces[0].(map[string]interface{})
+	require.Equal(t, "call", trace["type"])
+	require.Equal(t, float64(101), trace["blockNumber"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", trace["action"].(map[string]interface{})["to"])
+}
+
+func TestTraceFilter(t *testing.T) {
+	filter := map[string]interface{}{
+		"fromBlock":   "0x65",
+		"toBlock":     "0x65",
+		"fromAddress": []common.Address{common.HexToAddress("0x5b4eba929f3811980f5ae0c5d04fa200f837df4e")},
+	}
+	resObj := sendRequestGoodWithNamespace(t, "trace", "filter", filter)
+	require.Equal(t, 1, len(resObj["result"].([]interface{})))
+
+	filter["toAddress"] = []common.Address{common.HexToAddress("0x1")}
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", filter)
+	require.Equal(t, 0, len(resObj["result"].([]interface{})))
+
+	// range wider than the maximum number of blocks, which is much lower than the one of logs
+	filter = map[string]interface{}{
+		"fromBlock": "0x1",
+		"toBlock":   fmt.Sprintf("%#x", evmrpc.DefaultConfig.MaxTraceFilterBlocks+1),
+	}
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", filter)
+	errMap := resObj["error"].(map[string]interface{})
+	require.Equal(t, "a maximum of 100 blocks worth of traces may be requested at a time", errMap["message"])
+	require.Less(t, evmrpc.DefaultConfig.MaxTraceFilterBlocks, evmrpc.DefaultConfig.MaxBlocksForLog)
+}
+
+func TestTraceReplayBlockTransactions(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"trace", "stateDiff"})
+	results := resObj["result"].([]interface{})
+	require.Equal(t, 1, len(results))
+	result := results[0].(map[string]interface{})
+	require.NotNil(t, result["transactionHash"])
+	require.Equal(t, 1, len(result["trace"].([]interface{})))
+	stateDiff := result["stateDiff"].(map[string]interface{})
+	require.Greater(t, len(stateDiff), 0)
+	for _, v := range stateDiff {
+		require.Contains(t, v, "balance")
+	}
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"vmTrace"})
+	errMap := resObj["error"].(map[string]interface{})
+	require.Equal(t, "vmTrace is not supported", errMap["message"])
+}
+
+func TestTraceBlockExcludeTraceFail(t *testing.T) {
+	blockNumber := fmt.Sprintf("%#x", MockHeight103)
+	seiResObj := sendRequestGoodWithNamespace(t, "sei", "traceBlockExcludeTraceFail", blockNumber)
+	// the panic tx is excluded
+	require.Equal(t, 1, len(seiResObj["result"].([]interface{})))
+	resObj := sendRequestGoodWithNamespace(t, "trace", "block", blockNumber)
+	// the panic tx is reported as a failed top-level trace
+	traces := resObj["result"].([]interface{})
+	require.Equal(t, 2, len(traces))
+	failed := 0
+	for _, trace := range traces {
+		if _, ok := trace.(map[string]interface{})["error"]; ok {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+}
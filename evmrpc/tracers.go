@@ -23,14 +23,20 @@ const (
 	IsPanicCacheTTL  = 1 * time.Minute
 )
 
+func init() {
+	tracers.DefaultDirectory.Register(SeiCallTracerName, newSeiCallTracer, false)
+}
+
 type DebugAPI struct {
 	tracersAPI     *tracers.API
+	backend        *Backend
 	tmClient       rpcclient.Client
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
 	txDecoder      sdk.TxDecoder
 	connectionType ConnectionType
 	isPanicCache   *expirable.LRU[common.Hash, bool] // hash to isPanic
+	cache          *ResponseCache
 }
 
 type SeiDebugAPI struct {
@@ -38,19 +44,21 @@ type SeiDebugAPI struct {
 }
 
 func NewDebugAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, app *baseapp.BaseApp,
-	antehandler sdk.AnteHandler, connectionType ConnectionType) *DebugAPI {
+	antehandler sdk.AnteHandler, connectionType ConnectionType, cache *ResponseCache) *DebugAPI {
 	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config, app, antehandler)
 	tracersAPI := tracers.NewAPI(backend)
 	evictCallback := func(key common.Hash, value bool) {}
 	isPanicCache := expirable.NewLRU[common.Hash, bool](IsPanicCacheSize, evictCallback, IsPanicCacheTTL)
 	return &DebugAPI{
 		tracersAPI:     tracersAPI,
+		backend:        backend,
 		tmClient:       tmClient,
 		keeper:         k,
 		ctxProvider:    ctxProvider,
 		txDecoder:      txDecoder,
 		connectionType: connectionType,
 		isPanicCache:   isPanicCache,
+		cache:          cache,
 	}
 }
 
@@ -63,25 +71,40 @@ func NewSeiDebugAPI(
 	app *baseapp.BaseApp,
 	antehandler sdk.AnteHandler,
 	connectionType ConnectionType,
+	cache *ResponseCache,
 ) *SeiDebugAPI {
 	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config, app, antehandler)
 	tracersAPI := tracers.NewAPI(backend)
 	return &SeiDebugAPI{
-		DebugAPI: &DebugAPI{tracersAPI: tracersAPI, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, connectionType: connectionType},
+		DebugAPI: &DebugAPI{tracersAPI: tracersAPI, backend: backend, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, connectionType: connectionType, cache: cache},
 	}
 }
 
 func (api *DebugAPI) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceTransaction", api.connectionType, startTime, returnErr == nil)
-	result, returnErr = api.tracersAPI.TraceTransaction(ctx, hash, config)
-	return
+	return api.traceTransaction(ctx, hash, config)
+}
+
+func (api *DebugAPI) traceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (interface{}, error) {
+	return cached(api.cache, "debug_traceTransaction", func() (interface{}, int64, error) {
+		res, err := api.tracersAPI.TraceTransaction(ctx, hash, config)
+		if err != nil {
+			return nil, 0, err
+		}
+		receipt, err := api.keeper.GetReceipt(api.ctxProvider(LatestCtxHeight), hash)
+		if err != nil {
+			// the result is still valid but is not cached without a known height
+			return res, 0, nil
+		}
+		return res, int64(receipt.BlockNumber), nil
+	}, hash, config)
 }
 
 func (api *SeiDebugAPI) TraceBlockByNumberExcludeTraceFail(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("sei_traceBlockByNumberExcludeTraceFail", api.connectionType, startTime, returnErr == nil)
-	result, returnErr = api.tracersAPI.TraceBlockByNumber(ctx, number, config)
+	result, returnErr = api.traceBlockByNumber(ctx, number, config)
 	traces, ok := result.([]*tracers.TxTraceResult)
 	if !ok {
 		return nil, fmt.Errorf("unexpected type: %T", result)
@@ -99,7 +122,7 @@ func (api *SeiDebugAPI) TraceBlockByNumberExcludeTraceFail(ctx context.Context,
 func (api *SeiDebugAPI) TraceBlockByHashExcludeTraceFail(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("sei_traceBlockByHashExcludeTraceFail", api.connectionType, startTime, returnErr == nil)
-	result, returnErr = api.tracersAPI.TraceBlockByHash(ctx, hash, config)
+	result, returnErr = api.traceBlockByHash(ctx, hash, config)
 	traces, ok := result.([]*tracers.TxTraceResult)
 	if !ok {
 		return nil, fmt.Errorf("unexpected type: %T", result)
@@ -161,17 +184,42 @@ func (api *DebugAPI) isPanicOrSyntheticTx(ctx context.Context, hash common.Hash)
 func (api *DebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByNumber", api.connectionType, startTime, returnErr == nil)
-	result, returnErr = api.tracersAPI.TraceBlockByNumber(ctx, number, config)
+	result, returnErr = api.traceBlockByNumber(ctx, number, config)
 	return
 }
 
+func (api *DebugAPI) traceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) ([]*tracers.TxTraceResult, error) {
+	if number < 0 {
+		// block tags resolve to a different block as the chain advances
+		return api.tracersAPI.TraceBlockByNumber(ctx, number, config)
+	}
+	return cached(api.cache, "debug_traceBlockByNumber", func() ([]*tracers.TxTraceResult, int64, error) {
+		res, err := api.tracersAPI.TraceBlockByNumber(ctx, number, config)
+		return res, number.Int64(), err
+	}, number, config)
+}
+
 func (api *DebugAPI) TraceBlockByHash(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByHash", api.connectionType, startTime, returnErr == nil)
-	result, returnErr = api.tracersAPI.TraceBlockByHash(ctx, hash, config)
+	result, returnErr = api.traceBlockByHash(ctx, hash, config)
 	return
 }
 
+func (api *DebugAPI) traceBlockByHash(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) ([]*tracers.TxTraceResult, error) {
+	return cached(api.cache, "debug_traceBlockByHash", func() ([]*tracers.TxTraceResult, int64, error) {
+		res, err := api.tracersAPI.TraceBlockByHash(ctx, hash, config)
+		if err != nil {
+			return nil, 0, err
+		}
+		block, err := blockByHash(ctx, api.tmClient, hash[:])
+		if err != nil {
+			return res, 0, nil
+		}
+		return res, block.Block.Height, nil
+	}, hash, config)
+}
+
 func (api *DebugAPI) TraceCall(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *tracers.TraceCallConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceCall", api.connectionType, startTime, returnErr == nil)
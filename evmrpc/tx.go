@@ -11,7 +11,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -35,8 +34,9 @@ type TransactionAPI struct {
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
 	txConfig       client.TxConfig
-	homeDir        string
+	signer         Signer
 	connectionType ConnectionType
+	cache          *ResponseCache
 }
 
 type SeiTransactionAPI struct {
@@ -44,19 +44,20 @@ type SeiTransactionAPI struct {
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error)
 }
 
-func NewTransactionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, homeDir string, connectionType ConnectionType) *TransactionAPI {
-	return &TransactionAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, homeDir: homeDir, connectionType: connectionType}
+func NewTransactionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, signer Signer, connectionType ConnectionType, cache *ResponseCache) *TransactionAPI {
+	return &TransactionAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, signer: signer, connectionType: connectionType, cache: cache}
 }
 
 func NewSeiTransactionAPI(
 	tmClient rpcclient.Client,
 	k *keeper.Keeper,
 	ctxProvider func(int64) sdk.Context, txConfig client.TxConfig,
-	homeDir string,
+	signer Signer,
 	connectionType ConnectionType,
 	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
+	cache *ResponseCache,
 ) *SeiTransactionAPI {
-	return &SeiTransactionAPI{TransactionAPI: NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, connectionType), isPanicTx: isPanicTx}
+	return &SeiTransactionAPI{TransactionAPI: NewTransactionAPI(tmClient, k, ctxProvider, txConfig, signer, connectionType, cache), isPanicTx: isPanicTx}
 }
 
 func (t *SeiTransactionAPI) GetTransactionReceiptExcludeTraceFail(ctx context.Context, hash common.Hash) (result map[string]interface{}, returnErr error) {
@@ -90,15 +91,29 @@ func getTransactionReceipt(
 ) (result map[string]interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionReceipt", t.connectionType, startTime, returnErr == nil)
+	return cached(t.cache, "eth_getTransactionReceipt", func() (map[string]interface{}, int64, error) {
+		return fetchTransactionReceipt(ctx, t, hash, excludePanicTxs, isPanicTx, includeSynthetic, signer)
+	}, hash, excludePanicTxs, includeSynthetic)
+}
+
+func fetchTransactionReceipt(
+	ctx context.Context,
+	t *TransactionAPI,
+	hash common.Hash,
+	excludePanicTxs bool,
+	isPanicTx func(ctx context.Context, hash common.Hash) (bool, error),
+	includeSynthetic bool,
+	signer ethtypes.Signer,
+) (map[string]interface{}, int64, error) {
 	sdkctx := t.ctxProvider(LatestCtxHeight)
 
 	if excludePanicTxs {
 		isPanicTx, err := isPanicTx(ctx, hash)
 		if isPanicTx {
-			return nil, ErrPanicTx
+			return nil, 0, ErrPanicTx
 		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to check if tx is panic tx: %w", err)
+			return nil, 0, fmt.Errorf("failed to check if tx is panic tx: %w", err)
 		}
 	}
 
@@ -107,9 +122,9 @@ func getTransactionReceipt(
 		if strings.Contains(err.Error(), "not found") {
 			// When the transaction doesn't exist, the RPC method should return JSON null
 			// as per specification.
-			return nil, nil
+			return nil, 0, nil
 		}
-		return nil, err
+		return nil, 0, err
 	}
 	// Fill in the receipt if the transaction has failed and used 0 gas
 	// This case is for when a tx fails before it makes it to the VM
@@ -118,7 +133,7 @@ func getTransactionReceipt(
 		height := int64(receipt.BlockNumber)
 		block, err := blockByNumberWithRetry(ctx, t.tmClient, &height, 1)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		// Find the transaction in the block
@@ -153,12 +168,13 @@ func getTransactionReceipt(
 	height := int64(receipt.BlockNumber)
 	block, err := blockByNumberWithRetry(ctx, t.tmClient, &height, 1)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return encodeReceipt(receipt, t.txConfig.TxDecoder(), block, func(h common.Hash) bool {
+	res, err := encodeReceipt(receipt, t.txConfig.TxDecoder(), block, func(h common.Hash) bool {
 		_, err := t.keeper.GetReceipt(sdkctx, h)
 		return err == nil
 	}, includeSynthetic, signer)
+	return res, height, err
 }
 
 func (t *TransactionAPI) GetVMError(hash common.Hash) (result string, returnErr error) {
@@ -178,21 +194,27 @@ func (t *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	block, err := blockByNumberWithRetry(ctx, t.tmClient, blockNumber, 1)
-	if err != nil {
-		return nil, err
-	}
-	return t.getTransactionWithBlock(block, index)
+	return cached(t.cache.forHeight(blockNumber), "eth_getTransactionByBlockNumberAndIndex", func() (*ethapi.RPCTransaction, int64, error) {
+		block, err := blockByNumberWithRetry(ctx, t.tmClient, blockNumber, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		res, err := t.getTransactionWithBlock(block, index)
+		return res, block.Block.Height, err
+	}, blockNumber, index)
 }
 
 func (t *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context, blockHash common.Hash, index hexutil.Uint) (result *ethapi.RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionByBlockHashAndIndex", t.connectionType, startTime, returnErr == nil)
-	block, err := blockByHash(ctx, t.tmClient, blockHash[:])
-	if err != nil {
-		return nil, err
-	}
-	return t.getTransactionWithBlock(block, index)
+	return cached(t.cache, "eth_getTransactionByBlockHashAndIndex", func() (*ethapi.RPCTransaction, int64, error) {
+		block, err := blockByHash(ctx, t.tmClient, blockHash[:])
+		if err != nil {
+			return nil, 0, err
+		}
+		res, err := t.getTransactionWithBlock(block, index)
+		return res, block.Block.Height, err
+	}, blockHash, index)
 }
 
 func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (result *ethapi.RPCTransaction, returnErr error) {
@@ -309,18 +331,7 @@ func (t *TransactionAPI) getTransactionWithBlock(block *coretypes.ResultBlock, i
 func (t *TransactionAPI) Sign(addr common.Address, data hexutil.Bytes) (result hexutil.Bytes, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_sign", t.connectionType, startTime, returnErr == nil)
-	kb, err := getTestKeyring(t.homeDir)
-	if err != nil {
-		return nil, err
-	}
-	for taddr, privKey := range getAddressPrivKeyMap(kb) {
-		if taddr != addr.Hex() {
-			continue
-		}
-		dataHash := accounts.TextHash(data)
-		return crypto.Sign(dataHash, privKey)
-	}
-	return nil, errors.New("address does not have hosted key")
+	return t.signer.SignText(addr, data)
 }
 
 func getEthTxForTxBz(tx tmtypes.Tx, decoder sdk.TxDecoder) *ethtypes.Transaction {
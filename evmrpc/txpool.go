@@ -2,11 +2,14 @@ package evmrpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
 	"github.com/sei-protocol/sei-chain/x/evm/keeper"
@@ -31,15 +34,98 @@ func NewTxPoolAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(
 	return &TxPoolAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, txPoolConfig: txPoolConfig, connectionType: connectionType}
 }
 
-// For now, we put all unconfirmed txs in pending and none in queued
+// Content returns the unconfirmed EVM txs in the mempool, grouped by sender and nonce
 func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("sei_content", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]*ethapi.RPCTransaction{
 		"pending": make(map[string]map[string]*ethapi.RPCTransaction),
 		"queued":  make(map[string]map[string]*ethapi.RPCTransaction),
 	}
+	for addr, txs := range pool.pending {
+		content["pending"][addr.String()] = t.rpcTransactions(txs)
+	}
+	for addr, txs := range pool.queued {
+		content["queued"][addr.String()] = t.rpcTransactions(txs)
+	}
+	return content, nil
+}
 
+// ContentFrom returns the unconfirmed EVM txs in the mempool sent by addr, keyed by nonce
+func (t *TxPoolAPI) ContentFrom(ctx context.Context, addr common.Address) (result map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_contentFrom", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]map[string]*ethapi.RPCTransaction{
+		"pending": t.rpcTransactions(pool.pending[addr]),
+		"queued":  t.rpcTransactions(pool.queued[addr]),
+	}, nil
+}
+
+// Status returns the number of pending and queued EVM txs in the mempool
+func (t *TxPoolAPI) Status(ctx context.Context) (result map[string]hexutil.Uint, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_status", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := func(txsByAddr map[common.Address]map[uint64]*ethtypes.Transaction) (n hexutil.Uint) {
+		for _, txs := range txsByAddr {
+			n += hexutil.Uint(len(txs))
+		}
+		return n
+	}
+	return map[string]hexutil.Uint{
+		"pending": count(pool.pending),
+		"queued":  count(pool.queued),
+	}, nil
+}
+
+// Inspect returns a textual summary of every unconfirmed EVM tx in the mempool, grouped by
+// sender and nonce
+func (t *TxPoolAPI) Inspect(ctx context.Context) (result map[string]map[string]map[string]string, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_inspect", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inspect := func(txsByAddr map[common.Address]map[uint64]*ethtypes.Transaction) map[string]map[string]string {
+		res := make(map[string]map[string]string, len(txsByAddr))
+		for addr, txs := range txsByAddr {
+			summaries := make(map[string]string, len(txs))
+			for nonce, tx := range txs {
+				summaries[strconv.FormatUint(nonce, 10)] = summarizeTx(tx)
+			}
+			res[addr.String()] = summaries
+		}
+		return res
+	}
+	return map[string]map[string]map[string]string{
+		"pending": inspect(pool.pending),
+		"queued":  inspect(pool.queued),
+	}, nil
+}
+
+type txPool struct {
+	pending map[common.Address]map[uint64]*ethtypes.Transaction
+	queued  map[common.Address]map[uint64]*ethtypes.Transaction
+}
+
+// pool splits the unconfirmed EVM txs into pending txs, which continue the sender's nonce
+// sequence without a gap and can be included in the next block, and queued txs, which
+// wait for a missing nonce. The sequence starts at the sender's committed nonce and
+// continues through the nonces the keeper tracks as pending as well as the nonces of the
+// sender's other unconfirmed txs. Txs whose nonce has already been used are left out.
+func (t *TxPoolAPI) pool(ctx context.Context) (*txPool, error) {
 	total := t.txPoolConfig.maxNumTxs
 	resUnconfirmedTxs, err := t.tmClient.UnconfirmedTxs(ctx, nil, &total)
 	if err != nil {
@@ -53,6 +139,7 @@ func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]m
 		uint64(sdkCtx.BlockTime().Unix()),
 	)
 
+	txsByAddr := map[common.Address]map[uint64]*ethtypes.Transaction{}
 	for _, tx := range resUnconfirmedTxs.Txs {
 		ethTx := getEthTxForTxBz(tx, t.txDecoder)
 		if ethTx == nil { // not an evm tx
@@ -62,18 +149,57 @@ func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]m
 		if err != nil {
 			return nil, err
 		}
+		if txsByAddr[fromAddr] == nil {
+			txsByAddr[fromAddr] = map[uint64]*ethtypes.Transaction{}
+		}
+		txsByAddr[fromAddr][ethTx.Nonce()] = ethTx
+	}
 
-		nonce := ethTx.Nonce()
-		chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
-		res := ethapi.NewRPCPendingTransaction(ethTx, nil, chainConfig)
-		nonceStr := strconv.FormatUint(nonce, 10)
-		if content["pending"][fromAddr.String()] == nil {
-			content["pending"][fromAddr.String()] = map[string]*ethapi.RPCTransaction{
-				nonceStr: res,
+	pool := &txPool{
+		pending: map[common.Address]map[uint64]*ethtypes.Transaction{},
+		queued:  map[common.Address]map[uint64]*ethtypes.Transaction{},
+	}
+	for addr, txs := range txsByAddr {
+		committedNonce := t.keeper.CalculateNextNonce(sdkCtx, addr, false)
+		nextNonce := t.keeper.CalculateNextNonce(sdkCtx, addr, true)
+		for {
+			if _, ok := txs[nextNonce]; !ok {
+				break
+			}
+			nextNonce++
+		}
+		for nonce, tx := range txs {
+			var group map[common.Address]map[uint64]*ethtypes.Transaction
+			switch {
+			case nonce < committedNonce:
+				continue
+			case nonce < nextNonce:
+				group = pool.pending
+			default:
+				group = pool.queued
 			}
-		} else {
-			content["pending"][fromAddr.String()][nonceStr] = res
+			if group[addr] == nil {
+				group[addr] = map[uint64]*ethtypes.Transaction{}
+			}
+			group[addr][nonce] = tx
 		}
 	}
-	return content, nil
+	return pool, nil
+}
+
+func (t *TxPoolAPI) rpcTransactions(txs map[uint64]*ethtypes.Transaction) map[string]*ethapi.RPCTransaction {
+	chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(t.ctxProvider(LatestCtxHeight)))
+	res := make(map[string]*ethapi.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		res[strconv.FormatUint(nonce, 10)] = ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+	}
+	return res
+}
+
+// summarizeTx formats a tx the same way as go-ethereum's txpool_inspect
+func summarizeTx(tx *ethtypes.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
 }
@@ -4,14 +4,33 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// setUnconfirmedTxSenderNonce sets the committed nonce of the sender of the mock mempool
+// tx, whose nonce is 2, and returns the sender and a function restoring the old nonce.
+func setUnconfirmedTxSenderNonce(t *testing.T, nonce uint64) (common.Address, func()) {
+	etx, _ := UnconfirmedTx.GetMsgs()[0].(*types.MsgEVMTransaction).AsTransaction()
+	signer := ethtypes.MakeSigner(types.DefaultChainConfig().EthereumConfig(EVMKeeper.ChainID(Ctx)), big.NewInt(Ctx.BlockHeight()), uint64(Ctx.BlockTime().Unix()))
+	from, err := ethtypes.Sender(signer, etx)
+	require.Nil(t, err)
+	oldNonce := EVMKeeper.GetNonce(Ctx, from)
+	EVMKeeper.SetNonce(Ctx, from, nonce)
+	return from, func() { EVMKeeper.SetNonce(Ctx, from, oldNonce) }
+}
+
 func TestTxPoolContent(t *testing.T) {
+	_, restore := setUnconfirmedTxSenderNonce(t, 2)
+	defer restore()
 	body := "{\"jsonrpc\": \"2.0\",\"method\": \"txpool_content\",\"params\":[],\"id\":\"test\"}"
 	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%d", TestAddr, TestPort), strings.NewReader(body))
 	require.Nil(t, err)
@@ -65,6 +84,65 @@ func TestTxPoolContent(t *testing.T) {
 	require.Equal(t, 0, len(queuedMap))
 }
 
+func TestTxPoolQueued(t *testing.T) {
+	from, restore := setUnconfirmedTxSenderNonce(t, 0)
+	defer restore()
+
+	// the tx waits for nonces 0 and 1
+	content := sendRequestGoodWithNamespace(t, "txpool", "content")["result"].(map[string]interface{})
+	require.Empty(t, content["pending"])
+	queued := content["queued"].(map[string]interface{})
+	require.Len(t, queued, 1)
+	require.Contains(t, queued[from.Hex()], "2")
+
+	status := sendRequestGoodWithNamespace(t, "txpool", "status")["result"].(map[string]interface{})
+	require.Equal(t, "0x0", status["pending"])
+	require.Equal(t, "0x1", status["queued"])
+
+	contentFrom := sendRequestGoodWithNamespace(t, "txpool", "contentFrom", from.Hex())["result"].(map[string]interface{})
+	require.Empty(t, contentFrom["pending"])
+	require.Contains(t, contentFrom["queued"], "2")
+	contentFrom = sendRequestGoodWithNamespace(t, "txpool", "contentFrom", "0x1234567890123456789012345678901234567890")["result"].(map[string]interface{})
+	require.Empty(t, contentFrom["pending"])
+	require.Empty(t, contentFrom["queued"])
+
+	inspect := sendRequestGoodWithNamespace(t, "txpool", "inspect")["result"].(map[string]interface{})
+	summary := inspect["queued"].(map[string]interface{})[from.Hex()].(map[string]interface{})["2"].(string)
+	require.Contains(t, summary, "2000 wei + 1000 gas × 10 wei")
+
+	// the gap is filled by txs the keeper tracks as pending
+	EVMKeeper.AddPendingNonce(tmtypes.TxKey{0}, from, 0, 1)
+	EVMKeeper.AddPendingNonce(tmtypes.TxKey{1}, from, 1, 1)
+	defer EVMKeeper.RemovePendingNonce(tmtypes.TxKey{0})
+	defer EVMKeeper.RemovePendingNonce(tmtypes.TxKey{1})
+	status = sendRequestGoodWithNamespace(t, "txpool", "status")["result"].(map[string]interface{})
+	require.Equal(t, "0x1", status["pending"])
+	require.Equal(t, "0x0", status["queued"])
+}
+
+func TestTxPoolPending(t *testing.T) {
+	from, restore := setUnconfirmedTxSenderNonce(t, 2)
+	defer restore()
+
+	status := sendRequestGoodWithNamespace(t, "txpool", "status")["result"].(map[string]interface{})
+	require.Equal(t, "0x1", status["pending"])
+	require.Equal(t, "0x0", status["queued"])
+
+	inspect := sendRequestGoodWithNamespace(t, "txpool", "inspect")["result"].(map[string]interface{})
+	require.Contains(t, inspect["pending"], from.Hex())
+	require.Empty(t, inspect["queued"])
+}
+
+func TestTxPoolStale(t *testing.T) {
+	// the tx's nonce has already been used
+	_, restore := setUnconfirmedTxSenderNonce(t, 3)
+	defer restore()
+
+	status := sendRequestGoodWithNamespace(t, "txpool", "status")["result"].(map[string]interface{})
+	require.Equal(t, "0x0", status["pending"])
+	require.Equal(t, "0x0", status["queued"])
+}
+
 func requireNotZeroHex(t *testing.T, hexStr string) {
 	if strings.HasPrefix(hexStr, "0x") {
 		hexStr = hexStr[2:]
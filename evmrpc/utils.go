@@ -13,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/config"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -73,15 +72,6 @@ func getHeightFromBigIntBlockNumber(latest int64, blockNumber *big.Int) int64 {
 	}
 }
 
-func getTestKeyring(homeDir string) (keyring.Keyring, error) {
-	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
-	clientCtx, err := config.ReadFromClientConfig(clientCtx)
-	if err != nil {
-		return nil, err
-	}
-	return client.NewKeyringFromBackend(clientCtx, keyring.BackendTest)
-}
-
 func getAddressPrivKeyMap(kb keyring.Keyring) map[string]*ecdsa.PrivateKey {
 	res := map[string]*ecdsa.PrivateKey{}
 	keys, err := kb.List()
@@ -183,6 +173,10 @@ func recordMetricsWithError(apiMethod string, connectionType ConnectionType, sta
 	recordMetrics(apiMethod, connectionType, startTime, err == nil)
 }
 
+func recordRateLimitMetrics(apiMethod string, connectionType ConnectionType, reason string) {
+	metrics.IncrementRpcRateLimitedCounter(apiMethod, string(connectionType), reason)
+}
+
 func CheckVersion(ctx sdk.Context, k *keeper.Keeper) error {
 	if !evmExists(ctx, k) {
 		return fmt.Errorf("evm module does not exist on height %d", ctx.BlockHeight())
@@ -86,6 +86,7 @@ func (p Precompile) Run(evm *vm.EVM, caller common.Address, callingContract comm
 	if len(events) > 0 {
 		em.EmitEvents(ctx.EventManager().Events())
 	}
+	types.TraceCosmosEvents(ctx, events)
 	return bz, err
 }
 
@@ -178,6 +179,7 @@ func (d DynamicGasPrecompile) RunAndCalculateGas(evm *vm.EVM, caller common.Addr
 	if len(events) > 0 {
 		em.EmitEvents(ctx.EventManager().Events())
 	}
+	types.TraceCosmosEvents(ctx, events)
 	return ret, remainingGas, err
 }
 
@@ -188,7 +188,11 @@ func (p PrecompileExecutor) instantiate(ctx sdk.Context, method *abi.Method, cal
 		}
 	}
 
-	addr, data, err := p.wasmdKeeper.Instantiate(ctx, codeID, creatorAddr, adminAddr, msg, label, coins)
+	var addr sdk.AccAddress
+	data, err := types.TraceCrossVMCall(ctx, wasmFrame(types.CrossVMWasmInstantiate, creatorAddr, nil, msg, coins), func() (data []byte, err error) {
+		addr, data, err = p.wasmdKeeper.Instantiate(ctx, codeID, creatorAddr, adminAddr, msg, label, coins)
+		return data, err
+	})
 	if err != nil {
 		rerr = err
 		return
@@ -313,7 +317,9 @@ func (p PrecompileExecutor) executeBatch(ctx sdk.Context, method *abi.Method, ca
 			return
 		}
 
-		res, err := p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, coins)
+		res, err := types.TraceCrossVMCall(ctx, wasmFrame(types.CrossVMWasmExecute, senderAddr, contractAddr, msg, coins), func() ([]byte, error) {
+			return p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, coins)
+		})
 		if err != nil {
 			rerr = err
 			return
@@ -408,7 +414,9 @@ func (p PrecompileExecutor) execute(ctx sdk.Context, method *abi.Method, caller
 			return
 		}
 	}
-	res, err := p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, coins)
+	res, err := types.TraceCrossVMCall(ctx, wasmFrame(types.CrossVMWasmExecute, senderAddr, contractAddr, msg, coins), func() ([]byte, error) {
+		return p.wasmdKeeper.Execute(ctx, contractAddr, senderAddr, msg, coins)
+	})
 	if err != nil {
 		rerr = err
 		return
@@ -451,7 +459,11 @@ func (p PrecompileExecutor) query(ctx sdk.Context, method *abi.Method, args []in
 		rerr = err
 		return
 	}
-	res, err := p.wasmdViewKeeper.QuerySmartSafe(ctx, contractAddr, req)
+	res, err := types.TraceCrossVMCall(ctx, func() types.CrossVMFrame {
+		return types.CrossVMFrame{Type: types.CrossVMWasmQuery, To: contractAddr.String(), Input: req}
+	}, func() ([]byte, error) {
+		return p.wasmdViewKeeper.QuerySmartSafe(ctx, contractAddr, req)
+	})
 	if err != nil {
 		rerr = err
 		return
@@ -464,3 +476,20 @@ func (p PrecompileExecutor) query(ctx sdk.Context, method *abi.Method, args []in
 func IsWasmdCall(to *common.Address) bool {
 	return to != nil && (to.Cmp(Address) == 0)
 }
+
+// wasmFrame returns a builder of the frame reported to cross-VM tracers for a message sent
+// to a CosmWasm contract, or to a code for instantiations.
+func wasmFrame(typ string, sender sdk.AccAddress, contract sdk.AccAddress, msg []byte, coins sdk.Coins) func() types.CrossVMFrame {
+	return func() types.CrossVMFrame {
+		frame := types.CrossVMFrame{
+			Type:  typ,
+			From:  sender.String(),
+			Input: msg,
+			Value: coins.AmountOf(sdk.MustGetBaseDenom()).Mul(state.SdkUseiToSweiMultiplier).BigInt(),
+		}
+		if contract != nil {
+			frame.To = contract.String()
+		}
+		return frame
+	}
+}
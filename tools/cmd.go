@@ -5,6 +5,7 @@ import (
 
 	hasher "github.com/sei-protocol/sei-chain/tools/hash_verification/cmd"
 	migration "github.com/sei-protocol/sei-chain/tools/migration/cmd"
+	receipts "github.com/sei-protocol/sei-chain/tools/receipts/cmd"
 	scanner "github.com/sei-protocol/sei-chain/tools/tx-scanner/cmd"
 )
 
@@ -19,5 +20,6 @@ func ToolCmd() *cobra.Command {
 	toolsCmd.AddCommand(migration.GenerateStats())
 	toolsCmd.AddCommand(hasher.GenerateIavlHashCmd())
 	toolsCmd.AddCommand(hasher.GeneratePebbleHashCmd())
+	toolsCmd.AddCommand(receipts.ReceiptsCmd())
 	return toolsCmd
 }
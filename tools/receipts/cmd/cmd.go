@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/tools/receipts"
+	"github.com/sei-protocol/sei-db/common/logger"
+	"github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/ss"
+	"github.com/sei-protocol/sei-db/ss/types"
+	"github.com/spf13/cobra"
+)
+
+func ReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts",
+		Short: "Export and import the EVM receipts of the receipt store",
+	}
+	cmd.AddCommand(ExportCmd())
+	cmd.AddCommand(ImportCmd())
+	return cmd
+}
+
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Export the receipts of a height range to a file",
+		Long: `Export the receipts of a height range from the receipt store to a file that can be
+imported into the receipt store of another node. The node must be stopped while the command
+runs. Receipts that still live in the legacy application store are not exported.`,
+		Args: cobra.ExactArgs(1),
+		RunE: exportReceipts,
+	}
+	cmd.PersistentFlags().String("home-dir", "/root/.sei", "Sei home directory")
+	cmd.PersistentFlags().Uint64("start-height", 0, "lowest block height whose receipts should be exported")
+	cmd.PersistentFlags().Uint64("end-height", 0, "highest block height whose receipts should be exported (0 means no upper bound)")
+	return cmd
+}
+
+func exportReceipts(cmd *cobra.Command, args []string) error {
+	homeDir, _ := cmd.Flags().GetString("home-dir")
+	startHeight, _ := cmd.Flags().GetUint64("start-height")
+	endHeight, _ := cmd.Flags().GetUint64("end-height")
+	receiptStore, err := openReceiptStore(homeDir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = receiptStore.Close() }()
+	f, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	start := time.Now()
+	exported, err := receipts.Export(receiptStore, f, startHeight, endHeight)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("exported %d receipts in %f seconds\n", exported, time.Since(start).Seconds())
+	return f.Sync()
+}
+
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import the receipts of an export into the receipt store",
+		Long: `Import the receipts of a file written by the export command into the receipt store.
+The node must be stopped while the command runs. Imported receipts older than the node's
+receipt retention are pruned again once the node starts.`,
+		Args: cobra.ExactArgs(1),
+		RunE: importReceipts,
+	}
+	cmd.PersistentFlags().String("home-dir", "/root/.sei", "Sei home directory")
+	cmd.PersistentFlags().Int("batch-size", 10000, "number of entries to write per batch")
+	cmd.PersistentFlags().Bool("log-index", false, "also build the log index of the imported receipts")
+	cmd.PersistentFlags().Bool("tx-address-index", false, "also build the transaction address index of the imported receipts")
+	return cmd
+}
+
+func importReceipts(cmd *cobra.Command, args []string) error {
+	homeDir, _ := cmd.Flags().GetString("home-dir")
+	batchSize, _ := cmd.Flags().GetInt("batch-size")
+	logIndex, _ := cmd.Flags().GetBool("log-index")
+	txAddressIndex, _ := cmd.Flags().GetBool("tx-address-index")
+	receiptStore, err := openReceiptStore(homeDir)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = receiptStore.Close() }()
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	start := time.Now()
+	imported, err := receipts.Import(receiptStore, f, receipts.ImportOptions{
+		BatchSize:      batchSize,
+		LogIndex:       logIndex,
+		TxAddressIndex: txAddressIndex,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("imported %d receipts in %f seconds\n", imported, time.Since(start).Seconds())
+	return nil
+}
+
+func openReceiptStore(homeDir string) (types.StateStore, error) {
+	receiptStorePath := filepath.Join(homeDir, "data", "receipt.db")
+	ssConfig := config.DefaultStateStoreConfig()
+	ssConfig.DBDirectory = receiptStorePath
+	ssConfig.KeepLastVersion = false
+	// the tools must not prune the store while they run
+	ssConfig.KeepRecent = 0
+	return ss.NewStateStore(logger.NewNopLogger(), receiptStorePath, ssConfig)
+}
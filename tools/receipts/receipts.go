@@ -0,0 +1,261 @@
+package receipts
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/sei-protocol/sei-db/ss/pebbledb"
+	"github.com/sei-protocol/sei-db/ss/types"
+)
+
+// fileHeader starts every receipt export. It is followed by the receipts, each prefixed
+// with the uvarint length of its protobuf encoding. The whole file is gzip compressed.
+const fileHeader = "sei-receipts/v1\n"
+
+// maxReceiptSize bounds the size of a single receipt read from an export.
+const maxReceiptSize = 64 << 20
+
+// Export writes the receipts of the receipt store whose block number is within
+// [startHeight, endHeight] to w, endHeight 0 meaning no upper bound. Receipts that still
+// live in the legacy application store are not exported.
+func Export(store types.StateStore, w io.Writer, startHeight uint64, endHeight uint64) (int, error) {
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+	if _, err := bw.WriteString(fileHeader); err != nil {
+		return 0, err
+	}
+	exported := 0
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	if err := ForEachReceipt(store, startHeight, endHeight, func(_ *evmtypes.Receipt, bz []byte) error {
+		n := binary.PutUvarint(lenBuf, uint64(len(bz)))
+		if _, err := bw.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := bw.Write(bz); err != nil {
+			return err
+		}
+		exported++
+		return nil
+	}); err != nil {
+		return exported, err
+	}
+	if err := bw.Flush(); err != nil {
+		return exported, err
+	}
+	return exported, zw.Close()
+}
+
+// ForEachReceipt calls fn with the receipts of the receipt store whose block number is
+// within [startHeight, endHeight] and their encoding, endHeight 0 meaning no upper bound,
+// stopping at the first error.
+func ForEachReceipt(store types.StateStore, startHeight uint64, endHeight uint64, fn func(receipt *evmtypes.Receipt, bz []byte) error) error {
+	// raw keys are prefixed with the store key, and returning true stops the iteration
+	storePrefix := []byte(fmt.Sprintf(pebbledb.StorePrefixTpl, evmtypes.ReceiptStoreKey))
+	var iterErr error
+	if _, err := store.RawIterate(evmtypes.ReceiptStoreKey, func(key []byte, value []byte, _ int64) bool {
+		key = bytes.TrimPrefix(key, storePrefix)
+		if !bytes.HasPrefix(key, evmtypes.ReceiptKeyPrefix) || len(value) == 0 {
+			return false
+		}
+		receipt := &evmtypes.Receipt{}
+		if err := receipt.Unmarshal(value); err != nil {
+			iterErr = fmt.Errorf("failed to unmarshal receipt with key %X: %w", key, err)
+			return true
+		}
+		if receipt.BlockNumber < startHeight || (endHeight > 0 && receipt.BlockNumber > endHeight) {
+			return false
+		}
+		if err := fn(receipt, value); err != nil {
+			iterErr = err
+			return true
+		}
+		return false
+	}); err != nil {
+		return err
+	}
+	return iterErr
+}
+
+// BackfillOptions configures Backfill.
+type BackfillOptions struct {
+	// BatchSize is the number of index entries written per batch.
+	BatchSize int
+	// TxAddressIndex also builds the transaction address index.
+	TxAddressIndex bool
+}
+
+// Backfill builds the log index of the receipts of the receipt store whose block number is
+// within [startHeight, endHeight], endHeight 0 meaning no upper bound. Entries are written
+// at the latest version so that they are visible to queries without moving the store's
+// latest version. If the range reaches the lowest indexed height, that height is lowered to
+// startHeight so that queries use the index for the backfilled blocks. It returns the number
+// of receipts indexed and of index entries written.
+func Backfill(store types.StateStore, startHeight uint64, endHeight uint64, opts BackfillOptions) (int, int, error) {
+	version, err := store.GetLatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	var pairs []*iavl.KVPair
+	numReceipts, numEntries := 0, 0
+	flush := func() error {
+		if len(pairs) == 0 {
+			return nil
+		}
+		if err := store.ApplyChangeset(version, &proto.NamedChangeSet{
+			Name:      evmtypes.ReceiptStoreKey,
+			Changeset: iavl.ChangeSet{Pairs: pairs},
+		}); err != nil {
+			return err
+		}
+		numEntries += len(pairs)
+		pairs = nil
+		return nil
+	}
+	if err := ForEachReceipt(store, startHeight, endHeight, func(receipt *evmtypes.Receipt, _ []byte) error {
+		numReceipts++
+		pairs = append(pairs, evmkeeper.LogIndexPairs(receipt)...)
+		if opts.TxAddressIndex {
+			pairs = append(pairs, evmkeeper.TxAddressIndexPairs(receipt)...)
+		}
+		if len(pairs) >= opts.BatchSize {
+			return flush()
+		}
+		return nil
+	}); err != nil {
+		return numReceipts, numEntries, err
+	}
+	bz, err := store.Get(evmtypes.ReceiptStoreKey, version, evmtypes.LogIndexStartHeightKey)
+	if err != nil {
+		return numReceipts, numEntries, err
+	}
+	indexedFrom, recorded := uint64(0), len(bz) > 0
+	if recorded {
+		indexedFrom = binary.BigEndian.Uint64(bz)
+	}
+	if endHeight == 0 || (recorded && endHeight+1 >= indexedFrom) {
+		if !recorded || startHeight < indexedFrom {
+			pairs = append(pairs, evmkeeper.LogIndexStartHeightPair(startHeight))
+		}
+	}
+	return numReceipts, numEntries, flush()
+}
+
+// ImportOptions configures Import.
+type ImportOptions struct {
+	// BatchSize is the number of entries written per batch.
+	BatchSize int
+	// LogIndex also writes the log index entries of the imported receipts.
+	LogIndex bool
+	// TxAddressIndex also writes the transaction address index entries of the imported receipts.
+	TxAddressIndex bool
+}
+
+// Import writes the receipts of an export read from r to the receipt store, each at the
+// height of its block. Writing a height moves the store's latest version, so the latest
+// version is restored to the highest of its previous value and the highest imported height
+// at the end, which keeps the receipts visible to queries without regressing the store.
+func Import(store types.StateStore, r io.Reader, opts ImportOptions) (int, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open receipt export: %w", err)
+	}
+	defer func() { _ = zr.Close() }()
+	br := bufio.NewReader(zr)
+	header := make([]byte, len(fileHeader))
+	if _, err := io.ReadFull(br, header); err != nil || string(header) != fileHeader {
+		return 0, errors.New("not a receipt export")
+	}
+
+	prevLatest, err := store.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	pairsByHeight := map[uint64][]*iavl.KVPair{}
+	numPairs, imported := 0, 0
+	var maxHeight uint64
+	flush := func() error {
+		heights := make([]uint64, 0, len(pairsByHeight))
+		for height := range pairsByHeight {
+			heights = append(heights, height)
+		}
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+		for _, height := range heights {
+			if err := store.ApplyChangeset(int64(height), &proto.NamedChangeSet{ //nolint:gosec
+				Name:      evmtypes.ReceiptStoreKey,
+				Changeset: iavl.ChangeSet{Pairs: pairsByHeight[height]},
+			}); err != nil {
+				return err
+			}
+		}
+		pairsByHeight = map[uint64][]*iavl.KVPair{}
+		numPairs = 0
+		return nil
+	}
+	for {
+		size, err := binary.ReadUvarint(br)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return imported, fmt.Errorf("failed to read receipt %d: %w", imported, err)
+		}
+		if size > maxReceiptSize {
+			return imported, fmt.Errorf("receipt %d has size %d above the maximum of %d", imported, size, maxReceiptSize)
+		}
+		bz := make([]byte, size)
+		if _, err := io.ReadFull(br, bz); err != nil {
+			return imported, fmt.Errorf("failed to read receipt %d: %w", imported, err)
+		}
+		receipt := &evmtypes.Receipt{}
+		if err := receipt.Unmarshal(bz); err != nil {
+			return imported, fmt.Errorf("failed to unmarshal receipt %d: %w", imported, err)
+		}
+		txHash, err := receiptTxHash(receipt)
+		if err != nil {
+			return imported, err
+		}
+		pairs := []*iavl.KVPair{{Key: evmtypes.ReceiptKey(txHash), Value: bz}}
+		if opts.LogIndex {
+			pairs = append(pairs, evmkeeper.LogIndexPairs(receipt)...)
+		}
+		if opts.TxAddressIndex {
+			pairs = append(pairs, evmkeeper.TxAddressIndexPairs(receipt)...)
+		}
+		pairsByHeight[receipt.BlockNumber] = append(pairsByHeight[receipt.BlockNumber], pairs...)
+		numPairs += len(pairs)
+		imported++
+		if receipt.BlockNumber > maxHeight {
+			maxHeight = receipt.BlockNumber
+		}
+		if numPairs >= opts.BatchSize {
+			if err := flush(); err != nil {
+				return imported, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return imported, err
+	}
+	if latest := int64(maxHeight); latest > prevLatest { //nolint:gosec
+		return imported, store.SetLatestVersion(latest)
+	}
+	return imported, store.SetLatestVersion(prevLatest)
+}
+
+func receiptTxHash(receipt *evmtypes.Receipt) (common.Hash, error) {
+	if receipt.TxHashHex == "" {
+		return common.Hash{}, fmt.Errorf("receipt at height %d has no transaction hash", receipt.BlockNumber)
+	}
+	return common.HexToHash(receipt.TxHashHex), nil
+}
@@ -0,0 +1,184 @@
+package receipts_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/tools/receipts"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/common/logger"
+	"github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/sei-protocol/sei-db/ss"
+	"github.com/sei-protocol/sei-db/ss/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newReceiptStore(t *testing.T) types.StateStore {
+	dir := filepath.Join(t.TempDir(), "receipt.db")
+	ssConfig := config.DefaultStateStoreConfig()
+	ssConfig.DBDirectory = dir
+	ssConfig.KeepLastVersion = false
+	ssConfig.KeepRecent = 0
+	store, err := ss.NewStateStore(logger.NewNopLogger(), dir, ssConfig)
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestExportImport(t *testing.T) {
+	source := newReceiptStore(t)
+	contract := common.HexToAddress("0x1")
+	for height := uint64(1); height <= 4; height++ {
+		txHash := common.BytesToHash([]byte{byte(height)})
+		receipt := &evmtypes.Receipt{
+			TxHashHex:   txHash.Hex(),
+			BlockNumber: height,
+			Logs:        []*evmtypes.Log{{Address: contract.Hex(), Topics: []string{common.HexToHash("0x2").Hex()}}},
+		}
+		bz, err := receipt.Marshal()
+		require.Nil(t, err)
+		require.Nil(t, source.ApplyChangeset(int64(height), &proto.NamedChangeSet{ //nolint:gosec
+			Name:      evmtypes.ReceiptStoreKey,
+			Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: evmtypes.ReceiptKey(txHash), Value: bz}}},
+		}))
+	}
+	require.Nil(t, source.SetLatestVersion(4))
+
+	buf := &bytes.Buffer{}
+	exported, err := receipts.Export(source, buf, 2, 3)
+	require.Nil(t, err)
+	require.Equal(t, 2, exported)
+
+	target := newReceiptStore(t)
+	imported, err := receipts.Import(target, bytes.NewReader(buf.Bytes()), receipts.ImportOptions{BatchSize: 1, LogIndex: true})
+	require.Nil(t, err)
+	require.Equal(t, 2, imported)
+	latest, err := target.GetLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, int64(3), latest)
+	for height := uint64(1); height <= 4; height++ {
+		txHash := common.BytesToHash([]byte{byte(height)})
+		bz, err := target.Get(evmtypes.ReceiptStoreKey, latest, evmtypes.ReceiptKey(txHash))
+		require.Nil(t, err)
+		if height < 2 || height > 3 {
+			require.Nil(t, bz)
+			continue
+		}
+		receipt := &evmtypes.Receipt{}
+		require.Nil(t, receipt.Unmarshal(bz))
+		require.Equal(t, height, receipt.BlockNumber)
+	}
+	// the log index of the imported receipts is built
+	indexed := 0
+	iter, err := target.Iterator(evmtypes.ReceiptStoreKey, latest, evmtypes.LogIndexValuePrefix(evmtypes.LogIndexAddressKind, contract[:]), nil)
+	require.Nil(t, err)
+	for ; iter.Valid() && bytes.HasPrefix(iter.Key(), evmtypes.LogIndexValuePrefix(evmtypes.LogIndexAddressKind, contract[:])); iter.Next() {
+		indexed++
+	}
+	require.Nil(t, iter.Close())
+	require.Equal(t, 2, indexed)
+
+	_, err = receipts.Import(target, bytes.NewReader([]byte("not an export")), receipts.ImportOptions{BatchSize: 1})
+	require.NotNil(t, err)
+}
+
+func TestImportIntoNonEmptyStore(t *testing.T) {
+	source := newReceiptStore(t)
+	for height := uint64(1); height <= 3; height++ {
+		txHash := common.BytesToHash([]byte{byte(height)})
+		bz, err := (&evmtypes.Receipt{TxHashHex: txHash.Hex(), BlockNumber: height}).Marshal()
+		require.Nil(t, err)
+		require.Nil(t, source.ApplyChangeset(int64(height), &proto.NamedChangeSet{ //nolint:gosec
+			Name:      evmtypes.ReceiptStoreKey,
+			Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: evmtypes.ReceiptKey(txHash), Value: bz}}},
+		}))
+	}
+	buf := &bytes.Buffer{}
+	_, err := receipts.Export(source, buf, 1, 3)
+	require.Nil(t, err)
+
+	// the target already serves receipts up to height 10
+	target := newReceiptStore(t)
+	recent := common.BytesToHash([]byte{10})
+	bz, err := (&evmtypes.Receipt{TxHashHex: recent.Hex(), BlockNumber: 10}).Marshal()
+	require.Nil(t, err)
+	require.Nil(t, target.ApplyChangeset(10, &proto.NamedChangeSet{
+		Name:      evmtypes.ReceiptStoreKey,
+		Changeset: iavl.ChangeSet{Pairs: []*iavl.KVPair{{Key: evmtypes.ReceiptKey(recent), Value: bz}}},
+	}))
+
+	imported, err := receipts.Import(target, bytes.NewReader(buf.Bytes()), receipts.ImportOptions{BatchSize: 100})
+	require.Nil(t, err)
+	require.Equal(t, 3, imported)
+	latest, err := target.GetLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, int64(10), latest)
+	for _, height := range []uint64{1, 2, 3, 10} {
+		bz, err := target.Get(evmtypes.ReceiptStoreKey, latest, evmtypes.ReceiptKey(common.BytesToHash([]byte{byte(height)})))
+		require.Nil(t, err)
+		require.NotNil(t, bz)
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	store := newReceiptStore(t)
+	contract, topic := common.HexToAddress("0x1"), common.HexToHash("0x2")
+	for height := uint64(1); height <= 4; height++ {
+		txHash := common.BytesToHash([]byte{byte(height)})
+		receipt := &evmtypes.Receipt{
+			TxHashHex:   txHash.Hex(),
+			BlockNumber: height,
+			Logs:        []*evmtypes.Log{{Address: contract.Hex(), Topics: []string{topic.Hex()}}},
+		}
+		bz, err := receipt.Marshal()
+		require.Nil(t, err)
+		pairs := []*iavl.KVPair{{Key: evmtypes.ReceiptKey(txHash), Value: bz}}
+		if height == 4 {
+			// the node indexed logs since height 4
+			pairs = append(pairs, evmkeeper.LogIndexPairs(receipt)...)
+			pairs = append(pairs, evmkeeper.LogIndexStartHeightPair(4))
+		}
+		require.Nil(t, store.ApplyChangeset(int64(height), &proto.NamedChangeSet{ //nolint:gosec
+			Name:      evmtypes.ReceiptStoreKey,
+			Changeset: iavl.ChangeSet{Pairs: pairs},
+		}))
+	}
+	indexedHeights := func() []uint64 {
+		heights := []uint64{}
+		iter, err := store.Iterator(evmtypes.ReceiptStoreKey, 4, evmtypes.LogIndexValuePrefix(evmtypes.LogIndexAddressKind, contract[:]), nil)
+		require.Nil(t, err)
+		defer func() { _ = iter.Close() }()
+		for ; iter.Valid() && bytes.HasPrefix(iter.Key(), evmtypes.LogIndexValuePrefix(evmtypes.LogIndexAddressKind, contract[:])); iter.Next() {
+			heights = append(heights, evmtypes.LogIndexKeyHeight(iter.Key()))
+		}
+		return heights
+	}
+	indexedFrom := func() uint64 {
+		bz, err := store.Get(evmtypes.ReceiptStoreKey, 4, evmtypes.LogIndexStartHeightKey)
+		require.Nil(t, err)
+		return binary.BigEndian.Uint64(bz)
+	}
+
+	// a range that does not reach the indexed heights leaves a gap, so the start stays
+	numReceipts, numEntries, err := receipts.Backfill(store, 1, 1, receipts.BackfillOptions{BatchSize: 1})
+	require.Nil(t, err)
+	require.Equal(t, 1, numReceipts)
+	require.Equal(t, 2, numEntries)
+	require.Equal(t, []uint64{1, 4}, indexedHeights())
+	require.Equal(t, uint64(4), indexedFrom())
+
+	numReceipts, _, err = receipts.Backfill(store, 2, 0, receipts.BackfillOptions{BatchSize: 1})
+	require.Nil(t, err)
+	require.Equal(t, 3, numReceipts)
+	require.Equal(t, []uint64{1, 2, 3, 4}, indexedHeights())
+	require.Equal(t, uint64(2), indexedFrom())
+	latest, err := store.GetLatestVersion()
+	require.Nil(t, err)
+	require.Equal(t, int64(4), latest)
+}
@@ -1,7 +1,30 @@
 package utils
 
+import (
+	"math"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 type ERCMetadata struct {
 	Name     string
 	Symbol   string
 	Decimals uint8
 }
+
+// ERCMetadataFromDenomMetadata derives the metadata of a native pointer from the bank metadata
+// of its denom, using the denom unit with the largest exponent as the display unit.
+func ERCMetadataFromDenomMetadata(metadata banktypes.Metadata) ERCMetadata {
+	res := ERCMetadata{Name: metadata.Name, Symbol: metadata.Symbol}
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Exponent > uint32(res.Decimals) && denomUnit.Exponent <= math.MaxUint8 {
+			res.Decimals = uint8(denomUnit.Exponent)
+			res.Name = denomUnit.Denom
+			res.Symbol = denomUnit.Denom
+			if len(denomUnit.Aliases) > 0 {
+				res.Name = denomUnit.Aliases[0]
+			}
+		}
+	}
+	return res
+}
@@ -279,6 +279,37 @@ func IncrementRpcRequestCounter(endpoint string, connectionType string, success
 	)
 }
 
+// Measures RPC requests rejected by the rate limiter
+// Metric Name:
+//
+//	sei_rpc_request_rate_limited
+func IncrementRpcRateLimitedCounter(endpoint string, connectionType string, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "rpc", "request", "rate_limited"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("reason", reason),
+		},
+	)
+}
+
+// Measures lookups in the EVM RPC response cache
+// Metric Name:
+//
+//	sei_rpc_response_cache
+func IncrementRpcResponseCacheCounter(endpoint string, hit bool) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"sei", "rpc", "response", "cache"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("hit", strconv.FormatBool(hit)),
+		},
+	)
+}
+
 func IncrementErrorMetrics(scenario string, err error) {
 	if err == nil {
 		return
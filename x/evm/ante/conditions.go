@@ -0,0 +1,31 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// ConditionsDecorator rejects conditional transactions whose conditions do not hold. It
+// runs at CheckTx and again at delivery, so that a transaction whose conditions became
+// stale while it was in the mempool fails instead of being executed.
+type ConditionsDecorator struct {
+	k *keeper.Keeper
+}
+
+func NewConditionsDecorator(k *keeper.Keeper) *ConditionsDecorator {
+	return &ConditionsDecorator{k}
+}
+
+func (cd ConditionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	conditions, err := evmtypes.GetTxConditions(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if conditions != nil {
+		if err := conditions.Check(ctx, cd.k.GetState, cd.k.GetNonce); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
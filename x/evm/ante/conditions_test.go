@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/ante"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMemoTx struct {
+	mockTx
+	memo string
+}
+
+func (tx mockMemoTx) GetMemo() string { return tx.memo }
+
+func TestConditionsDecorator(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	k.SetState(ctx, addr, common.HexToHash("0x1"), common.HexToHash("0x2"))
+	k.SetNonce(ctx, addr, 5)
+	a := ante.NewConditionsDecorator(k)
+	msg, _ := types.NewMsgEVMTransaction(&ethtx.LegacyTx{})
+	checkCtx := ctx.WithIsCheckTx(true)
+	handle := func(ctx sdk.Context, conditions *types.TxConditions) error {
+		memo := ""
+		if conditions != nil {
+			var err error
+			memo, err = conditions.ToMemo()
+			require.Nil(t, err)
+		}
+		_, err := a.AnteHandle(ctx, mockMemoTx{mockTx: mockTx{msgs: []sdk.Msg{msg}}, memo: memo}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		return err
+	}
+	u64 := func(v uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&v) }
+
+	require.Nil(t, handle(ctx, nil))
+	require.Nil(t, handle(ctx, &types.TxConditions{
+		KnownAccounts:  map[common.Address]types.KnownAccount{addr: {StorageSlots: map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x2")}}},
+		KnownNonces:    map[common.Address]hexutil.Uint64{addr: 5},
+		BlockNumberMin: u64(10),
+		BlockNumberMax: u64(10),
+		TimestampMin:   u64(1000),
+		TimestampMax:   u64(1000),
+	}))
+	for _, conditions := range []*types.TxConditions{
+		{KnownAccounts: map[common.Address]types.KnownAccount{addr: {StorageSlots: map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x3")}}}},
+		{KnownNonces: map[common.Address]hexutil.Uint64{addr: 4}},
+		{BlockNumberMin: u64(11)},
+		{BlockNumberMax: u64(9)},
+		{TimestampMin: u64(1001)},
+		{TimestampMax: u64(999)},
+	} {
+		require.ErrorIs(t, handle(checkCtx, conditions), types.ErrTxConditionsNotMet)
+		require.ErrorIs(t, handle(ctx, conditions), types.ErrTxConditionsNotMet)
+	}
+
+	// conditions that held at CheckTx but went stale before delivery fail at delivery
+	stale := &types.TxConditions{KnownNonces: map[common.Address]hexutil.Uint64{addr: 5}}
+	require.Nil(t, handle(checkCtx, stale))
+	k.SetNonce(ctx, addr, 6)
+	require.ErrorIs(t, handle(ctx, stale), types.ErrTxConditionsNotMet)
+
+	// the first failing condition in address and slot order is reported
+	slots := map[common.Hash]common.Hash{}
+	for i := int64(1); i <= 20; i++ {
+		slots[common.BigToHash(big.NewInt(i))] = common.HexToHash("0xff")
+	}
+	for i := 0; i < 10; i++ {
+		err := handle(ctx, &types.TxConditions{KnownAccounts: map[common.Address]types.KnownAccount{addr: {StorageSlots: slots}}})
+		require.ErrorContains(t, err, "storage slot "+common.BigToHash(big.NewInt(1)).Hex())
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -64,3 +65,117 @@ func NewAddERCNativePointerProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+const FlagForce = "force"
+
+func NewRemovePointerProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-pointer title description type pointee deposit [--force]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a remove pointer proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to retire the pointer of the specified type (one of [NATIVE, CW20,
+			CW721, CW1155, ERC20, ERC721, ERC1155]) and pointee. The proposal fails while the
+			pointee still has balances unless --force is set.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pointerType, err := parsePointerType(args[2])
+			if err != nil {
+				return err
+			}
+			force, err := cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			content := types.RemovePointerProposal{
+				Title:       args[0],
+				Description: args[1],
+				PointerType: pointerType,
+				Pointee:     args[3],
+				Force:       force,
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagForce, false, "remove the pointer even if the pointee still has balances")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewMigratePointerProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-pointer title description type pointee new-pointer version deposit",
+		Args:  cobra.ExactArgs(7),
+		Short: "Submit a migrate pointer proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to repoint the pointee of the specified type (one of [NATIVE, CW20,
+			CW721, CW1155, ERC20, ERC721, ERC1155]) to an already deployed pointer contract,
+			registered under a version higher than the current one.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pointerType, err := parsePointerType(args[2])
+			if err != nil {
+				return err
+			}
+			version, err := strconv.ParseUint(args[5], 10, 16)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(args[6])
+			if err != nil {
+				return err
+			}
+
+			content := types.MigratePointerProposal{
+				Title:       args[0],
+				Description: args[1],
+				PointerType: pointerType,
+				Pointee:     args[3],
+				NewPointer:  args[4],
+				Version:     uint32(version),
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parsePointerType(s string) (types.PointerType, error) {
+	v, ok := types.PointerType_value[s]
+	if !ok {
+		return 0, fmt.Errorf("unknown pointer type %s", s)
+	}
+	return types.PointerType(v), nil
+}
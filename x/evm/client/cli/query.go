@@ -10,6 +10,7 @@ import (
 
 	"math/big"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/sei-protocol/sei-chain/precompiles/confidentialtransfers"
@@ -62,6 +63,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryPointer())
 	cmd.AddCommand(CmdQueryPointerVersion())
 	cmd.AddCommand(CmdQueryPointee())
+	cmd.AddCommand(CmdQueryBaseFeeHistory())
+	cmd.AddCommand(CmdQueryPointerHistory())
 	cmd.AddCommand(GetCmdQueryCtTransferPayload())
 	cmd.AddCommand(GetCmdQueryCtInitAccountPayload())
 	cmd.AddCommand(GetCmdQueryCtApplyPendingBalancePayload())
@@ -482,6 +485,70 @@ func CmdQueryPointee() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryBaseFeeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history [count]",
+		Short: "Get the base fee and gas used of the most recent blocks, or of all blocks kept in the history if count is omitted",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			req := types.QueryBaseFeeHistoryRequest{}
+			if len(args) > 0 {
+				count, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.Count = count
+			}
+			res, err := queryClient.BaseFeeHistory(ctx, &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPointerHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pointer-history [type] [pointee]",
+		Short: "get all registered versions of the pointer of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, ERC1155]) and pointee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			ctx := cmd.Context()
+
+			res, err := queryClient.PointerHistory(ctx, &types.QueryPointerHistoryRequest{
+				PointerType: types.PointerType(types.PointerType_value[args[0]]), Pointee: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdQueryCtTransferPayload() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ct-transfer-payload [abi-filepath] [from_address] [to_address] [amount] [flags]",
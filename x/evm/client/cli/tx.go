@@ -66,6 +66,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(RegisterCwPointerCmd())
 	cmd.AddCommand(RegisterEvmPointerCmd())
 	cmd.AddCommand(NewAddERCNativePointerProposalTxCmd())
+	cmd.AddCommand(NewRemovePointerProposalTxCmd())
+	cmd.AddCommand(NewMigratePointerProposalTxCmd())
 	cmd.AddCommand(AssociateContractAddressCmd())
 	cmd.AddCommand(NativeAssociateCmd())
 
@@ -28,6 +28,18 @@ func HandleAddERCNativePointerProposalV2(ctx sdk.Context, k *keeper.Keeper, p *t
 	)
 }
 
+func HandleRemovePointerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RemovePointerProposal) error {
+	return k.RemovePointer(ctx, p.PointerType, p.Pointee, p.Force)
+}
+
+func HandleMigratePointerProposal(ctx sdk.Context, k *keeper.Keeper, p *types.MigratePointerProposal) error {
+	if p.Version > math.MaxUint16 {
+		// should never happen given validation
+		return fmt.Errorf("pointer version %d must be <= %d", p.Version, math.MaxUint16)
+	}
+	return k.MigratePointer(ctx, p.PointerType, p.Pointee, p.NewPointer, uint16(p.Version))
+}
+
 func logNativeV2Error(ctx sdk.Context, p *types.AddERCNativePointerProposalV2, step string, err string) {
 	id := fmt.Sprintf("Title: %s, Description: %s, Token: %s", p.Title, p.Description, p.Token)
 	ctx.Logger().Error(fmt.Sprintf("proposal (%s) encountered error during (%s) due to (%s)", id, step, err))
@@ -3,9 +3,14 @@ package evm_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/x/evm"
 	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +53,31 @@ func TestAddERCNativePointerProposalsV2(t *testing.T) {
 	require.True(t, exists2)
 	require.NotEqual(t, pointer, pointer2)
 }
+
+func TestRemoveAndMigratePointerProposals(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	handler := evm.NewProposalHandler(*k)
+	require.Nil(t, handler(ctx, &types.AddERCNativePointerProposalV2{Token: "ugovremove", Name: "NAME", Symbol: "SYMBOL", Decimals: 6}))
+	var newPointer common.Address
+	args, err := native.GetParsedABI().Pack("", "ugovremove", "NAME", "SYMBOL", uint8(6))
+	require.Nil(t, err)
+	require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		moduleAddr := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+		_, newPointer, _, err = e.Create(vm.AccountRef(moduleAddr), append(native.GetBin(), args...), 10000000, utils.Big0)
+		return err
+	}, func(string, string) {}))
+	require.Nil(t, handler(ctx, &types.MigratePointerProposal{
+		PointerType: types.PointerType_NATIVE, Pointee: "ugovremove", NewPointer: newPointer.Hex(), Version: uint32(native.CurrentVersion + 1),
+	}))
+	res, err := keeper.NewQuerier(k).PointerHistory(sdk.WrapSDKContext(ctx), &types.QueryPointerHistoryRequest{
+		PointerType: types.PointerType_NATIVE, Pointee: "ugovremove",
+	})
+	require.Nil(t, err)
+	require.Len(t, res.Versions, 2)
+	require.Equal(t, newPointer.Hex(), res.Versions[1].Pointer)
+
+	require.Nil(t, handler(ctx, &types.RemovePointerProposal{PointerType: types.PointerType_NATIVE, Pointee: "ugovremove"}))
+	_, _, exists := k.GetERC20NativePointer(ctx, "ugovremove")
+	require.False(t, exists)
+}
@@ -56,6 +56,10 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return HandleAddCWERC1155PointerProposal(ctx, &k, c)
 		case *types.AddERCNativePointerProposalV2:
 			return HandleAddERCNativePointerProposalV2(ctx, &k, c)
+		case *types.RemovePointerProposal:
+			return HandleRemovePointerProposal(ctx, &k, c)
+		case *types.MigratePointerProposal:
+			return HandleMigratePointerProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized evm proposal content type: %T", c)
 		}
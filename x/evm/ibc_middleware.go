@@ -0,0 +1,41 @@
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+)
+
+// NativePointerIBCMiddleware wraps the transfer IBC module so that IBC denoms get a native
+// pointer when they are first received, if the params allow it.
+type NativePointerIBCMiddleware struct {
+	porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+var _ porttypes.IBCModule = NativePointerIBCMiddleware{}
+
+func NewNativePointerIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) NativePointerIBCMiddleware {
+	return NativePointerIBCMiddleware{IBCModule: app, keeper: k}
+}
+
+func (im NativePointerIBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return ack
+	}
+	// tokens returning to their source chain are unwrapped rather than minted as IBC denoms
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return ack
+	}
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	im.keeper.AutoRegisterNativePointer(ctx, transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom())
+	return ack
+}
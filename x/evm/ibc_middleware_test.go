@@ -0,0 +1,54 @@
+package evm_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTransferModule struct {
+	porttypes.IBCModule
+	ack exported.Acknowledgement
+}
+
+func (m mockTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	return m.ack
+}
+
+func TestNativePointerIBCMiddleware(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx, _ := testkeeper.EVMTestApp.GetContextForDeliverTx(nil).CacheContext()
+	params := k.GetParams(ctx)
+	params.AutoRegisterNativePointers = true
+	k.SetParams(ctx, params)
+
+	packet := func(denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "100", "sender", "receiver")
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-1", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+	}
+	received := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	// failed transfers do not register pointers
+	failed := evm.NewNativePointerIBCMiddleware(mockTransferModule{ack: channeltypes.NewErrorAcknowledgement("failed")}, k)
+	failed.OnRecvPacket(ctx, packet("uatom"), nil)
+	_, _, exists := k.GetERC20NativePointer(ctx, received)
+	require.False(t, exists)
+
+	im := evm.NewNativePointerIBCMiddleware(mockTransferModule{ack: channeltypes.NewResultAcknowledgement([]byte{1})}, k)
+	require.True(t, im.OnRecvPacket(ctx, packet("uatom"), nil).Success())
+	_, _, exists = k.GetERC20NativePointer(ctx, received)
+	require.True(t, exists)
+
+	// tokens returning to this chain are not new IBC denoms
+	im.OnRecvPacket(ctx, packet("transfer/channel-1/usei"), nil)
+	_, _, exists = k.GetERC20NativePointer(ctx, "usei")
+	require.False(t, exists)
+}
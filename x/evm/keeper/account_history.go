@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// shellEVMTxType is the type of the receipts that only carry EVM events synthesized from
+// the CosmWasm events of a Cosmos transaction.
+const shellEVMTxType = math.MaxUint32
+
+var (
+	transferTopic              = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	erc1155TransferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+	erc1155TransferBatchTopic  = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+)
+
+// AccountHistoryEntry points to a transaction that an address took part in.
+type AccountHistoryEntry struct {
+	Height  uint64
+	TxIndex uint32
+	Kind    byte
+	TxHash  common.Hash
+}
+
+// SetAccountHistoryIndexEnabled toggles whether account history entries are written when
+// receipts are flushed to the receipt store.
+func (k *Keeper) SetAccountHistoryIndexEnabled(enabled bool) {
+	k.accountHistoryIndexEnabled = enabled
+}
+
+func (k *Keeper) AccountHistoryIndexEnabled() bool {
+	return k.accountHistoryIndexEnabled
+}
+
+// AccountHistoryPairs returns the account history entries of a receipt. EVM transactions
+// are indexed by their sender, recipient and created contract. Receipts of synthetic
+// events are indexed by the signer of the Cosmos transaction and by the parties of the
+// token transfers they carry.
+func AccountHistoryPairs(receipt *types.Receipt) []*iavl.KVPair {
+	txHash := common.HexToHash(receipt.TxHashHex)
+	addrs := []common.Address{}
+	kind := types.AccountHistoryEVMKind
+	if receipt.TxType == shellEVMTxType {
+		kind = types.AccountHistorySyntheticKind
+		if receipt.From != "" {
+			addrs = append(addrs, common.HexToAddress(receipt.From))
+		}
+		for _, log := range receipt.Logs {
+			addrs = append(addrs, transferParties(log)...)
+		}
+	} else {
+		for _, hex := range []string{receipt.From, receipt.To, receipt.ContractAddress} {
+			if hex != "" {
+				addrs = append(addrs, common.HexToAddress(hex))
+			}
+		}
+	}
+	pairs := []*iavl.KVPair{}
+	seen := map[common.Address]struct{}{}
+	for _, addr := range addrs {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		pairs = append(pairs, &iavl.KVPair{
+			Key:   types.AccountHistoryKey(addr[:], receipt.BlockNumber, receipt.TransactionIndex, kind),
+			Value: txHash[:],
+		})
+	}
+	return pairs
+}
+
+// transferParties returns the sender and recipient of an ERC20, ERC721 or ERC1155
+// transfer log.
+func transferParties(log *types.Log) []common.Address {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	var topics []string
+	switch common.HexToHash(log.Topics[0]) {
+	case transferTopic:
+		topics = log.Topics[1:]
+	case erc1155TransferSingleTopic, erc1155TransferBatchTopic:
+		topics = log.Topics[2:]
+	default:
+		return nil
+	}
+	res := []common.Address{}
+	for i := 0; i < len(topics) && i < 2; i++ {
+		res = append(res, common.BytesToAddress(common.HexToHash(topics[i]).Bytes()))
+	}
+	return res
+}
+
+// SetTransientAccountHistory records that the Cosmos transaction being delivered involves
+// addrs. The entries are written to the receipt store with the receipts of the block.
+func (k *Keeper) SetTransientAccountHistory(ctx sdk.Context, addrs []sdk.AccAddress, txHash common.Hash) {
+	if !k.accountHistoryIndexEnabled {
+		return
+	}
+	// the index is node-local, so writing it must not consume the gas of the transaction
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)).TransientStore(k.transientStoreKey)
+	for _, addr := range addrs {
+		if len(addr) != common.AddressLength {
+			// module and contract accounts are not indexed
+			continue
+		}
+		store.Set(types.AccountHistoryKey(addr, uint64(ctx.BlockHeight()), uint32(ctx.TxIndex()), types.AccountHistoryCosmosKind), txHash[:])
+	}
+}
+
+// transientAccountHistoryPairs returns the account history entries recorded by
+// SetTransientAccountHistory in the current block.
+func (k *Keeper) transientAccountHistoryPairs(ctx sdk.Context) []*iavl.KVPair {
+	iter := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.AccountHistoryPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	pairs := []*iavl.KVPair{}
+	for ; iter.Valid(); iter.Next() {
+		key := append(append([]byte{}, types.AccountHistoryPrefix...), iter.Key()...)
+		pairs = append(pairs, &iavl.KVPair{Key: key, Value: iter.Value()})
+	}
+	return pairs
+}
+
+// IterateAccountHistory calls cb with the indexed transactions of the 20 byte address
+// addr in reverse chain order, starting right before the given position, or from the
+// latest transaction if before is nil. Iteration stops when cb returns false. The second
+// return value is false if the index is disabled.
+func (k *Keeper) IterateAccountHistory(_ sdk.Context, addr []byte, before *AccountHistoryEntry, cb func(AccountHistoryEntry) bool) (bool, error) {
+	if !k.accountHistoryIndexEnabled {
+		return false, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	start := types.AccountHistoryAddressPrefix(addr)
+	end := sdk.PrefixEndBytes(start)
+	if before != nil {
+		end = types.AccountHistoryKey(addr, before.Height, before.TxIndex, 0)
+	}
+	iter, err := k.receiptStore.ReverseIterator(types.ReceiptStoreKey, lv, start, end)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		height, txIndex, kind := types.AccountHistoryKeyPosition(iter.Key())
+		if !cb(AccountHistoryEntry{Height: height, TxIndex: txIndex, Kind: kind, TxHash: common.BytesToHash(iter.Value())}) {
+			break
+		}
+	}
+	return true, nil
+}
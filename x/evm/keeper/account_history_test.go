@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountHistoryPairs(t *testing.T) {
+	txHash := common.HexToHash("0xabc")
+	from, to := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	receipt := &types.Receipt{
+		TxHashHex:        txHash.Hex(),
+		BlockNumber:      5,
+		TransactionIndex: 2,
+		From:             from.Hex(),
+		To:               to.Hex(),
+	}
+	pairs := keeper.AccountHistoryPairs(receipt)
+	require.Len(t, pairs, 2)
+	require.Equal(t, types.AccountHistoryKey(from[:], 5, 2, types.AccountHistoryEVMKind), pairs[0].Key)
+	require.Equal(t, types.AccountHistoryKey(to[:], 5, 2, types.AccountHistoryEVMKind), pairs[1].Key)
+	height, txIndex, kind := types.AccountHistoryKeyPosition(pairs[1].Key)
+	require.Equal(t, uint64(5), height)
+	require.Equal(t, uint32(2), txIndex)
+	require.Equal(t, types.AccountHistoryEVMKind, kind)
+	require.Equal(t, txHash[:], pairs[0].Value)
+
+	// synthetic receipts are indexed by their signer and the parties of token transfers
+	recipient := common.HexToAddress("0x3")
+	receipt.TxType = math.MaxUint32
+	receipt.To = ""
+	receipt.Logs = []*types.Log{{
+		Topics: []string{
+			"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			common.BytesToHash(from[:]).Hex(),
+			common.BytesToHash(recipient[:]).Hex(),
+		},
+	}}
+	pairs = keeper.AccountHistoryPairs(receipt)
+	require.Len(t, pairs, 2)
+	require.Equal(t, types.AccountHistoryKey(from[:], 5, 2, types.AccountHistorySyntheticKind), pairs[0].Key)
+	require.Equal(t, types.AccountHistoryKey(recipient[:], 5, 2, types.AccountHistorySyntheticKind), pairs[1].Key)
+}
+
+func TestIterateAccountHistory(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(30)
+	addr := common.HexToAddress("0xacc7")
+	collect := func(before *keeper.AccountHistoryEntry) []keeper.AccountHistoryEntry {
+		entries := []keeper.AccountHistoryEntry{}
+		ok, err := k.IterateAccountHistory(ctx, addr[:], before, func(e keeper.AccountHistoryEntry) bool {
+			entries = append(entries, e)
+			return true
+		})
+		require.Nil(t, err)
+		require.True(t, ok)
+		return entries
+	}
+
+	ok, err := k.IterateAccountHistory(ctx, addr[:], nil, func(keeper.AccountHistoryEntry) bool { return true })
+	require.Nil(t, err)
+	require.False(t, ok) // index disabled
+
+	k.SetAccountHistoryIndexEnabled(true)
+	defer k.SetAccountHistoryIndexEnabled(false)
+	evmHash := common.HexToHash("0xacc10")
+	require.Nil(t, k.SetTransientReceipt(ctx, evmHash, &types.Receipt{
+		TxHashHex:   evmHash.Hex(),
+		BlockNumber: 10,
+		From:        addr.Hex(),
+		To:          common.HexToAddress("0xacc8").Hex(),
+	}))
+	cosmosHash := common.HexToHash("0xacc30")
+	k.SetTransientAccountHistory(ctx.WithTxIndex(1), []sdk.AccAddress{addr[:], sdk.AccAddress("module")}, cosmosHash)
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+
+	entries := collect(nil)
+	require.Equal(t, []keeper.AccountHistoryEntry{
+		{Height: 30, TxIndex: 1, Kind: types.AccountHistoryCosmosKind, TxHash: cosmosHash},
+		{Height: 10, TxIndex: 0, Kind: types.AccountHistoryEVMKind, TxHash: evmHash},
+	}, entries)
+	require.Equal(t, entries[1:], collect(&entries[0]))
+	require.Empty(t, collect(&entries[1]))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SetBaseFeeRecord adds record to the base fee history and drops the records that fall
+// out of the last historySize heights, so that the history acts as a ring buffer that
+// can be resized by governance. A historySize of 0 disables the history.
+func (k *Keeper) SetBaseFeeRecord(ctx sdk.Context, historySize uint64, record types.BaseFeeRecord) {
+	if record.Height < 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	oldest := uint64(record.Height) + 1
+	if historySize <= oldest {
+		oldest -= historySize
+	} else {
+		oldest = 0
+	}
+	iter := store.Iterator(types.BaseFeeHistoryPrefix, types.BaseFeeHistoryKey(oldest))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	if historySize == 0 {
+		return
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BaseFeeHistoryKey(uint64(record.Height)), bz)
+}
+
+// GetBaseFeeRecord returns the record of height if it is still in the base fee history.
+func (k *Keeper) GetBaseFeeRecord(ctx sdk.Context, height int64) (types.BaseFeeRecord, bool) {
+	if height < 0 {
+		return types.BaseFeeRecord{}, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeHistoryKey(uint64(height)))
+	if bz == nil {
+		return types.BaseFeeRecord{}, false
+	}
+	record := types.BaseFeeRecord{}
+	if err := record.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return record, true
+}
+
+// GetBaseFeeHistory returns up to count records of the base fee history, most recent
+// first, or all of them if count is 0.
+func (k *Keeper) GetBaseFeeHistory(ctx sdk.Context, count uint64) []types.BaseFeeRecord {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.BaseFeeHistoryPrefix).ReverseIterator(nil, nil)
+	defer iter.Close()
+	records := []types.BaseFeeRecord{}
+	for ; iter.Valid() && (count == 0 || uint64(len(records)) < count); iter.Next() {
+		record := types.BaseFeeRecord{}
+		if err := record.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
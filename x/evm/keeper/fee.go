@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
-// modified eip-1559 adjustment using target gas used
+// modified eip-1559 adjustment using target gas used, along the curve selected by params
 func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64) *sdk.Dec {
 	if ctx.ConsensusParams() == nil || ctx.ConsensusParams().Block == nil {
 		return nil
@@ -13,12 +16,19 @@ func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64
 	prevBaseFee := k.GetNextBaseFeePerGas(ctx)
 	// set the resulting base fee for block n-1 on block n
 	k.SetCurrBaseFeePerGas(ctx, prevBaseFee)
-	targetGasUsed := sdk.NewDec(int64(k.GetTargetGasUsedPerBlock(ctx)))
+	params := k.GetParams(ctx)
+	k.SetBaseFeeRecord(ctx, params.BaseFeeHistorySize, types.BaseFeeRecord{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   prevBaseFee,
+		GasUsed:   blockGasUsed,
+		GasTarget: params.TargetGasUsedPerBlock,
+	})
+	targetGasUsed := sdk.NewDec(int64(params.TargetGasUsedPerBlock))
 	if targetGasUsed.IsZero() { // avoid division by zero
 		return &prevBaseFee // return the previous base fee as is
 	}
-	minimumFeePerGas := k.GetParams(ctx).MinimumFeePerGas
-	maximumFeePerGas := k.GetParams(ctx).MaximumFeePerGas
+	minimumFeePerGas := params.MinimumFeePerGas
+	maximumFeePerGas := params.MaximumFeePerGas
 	blockGasLimit := sdk.NewDec(ctx.ConsensusParams().Block.MaxGas)
 	blockGasUsedDec := sdk.NewDec(int64(blockGasUsed))
 
@@ -27,21 +37,15 @@ func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64
 		blockGasUsedDec = blockGasLimit
 	}
 
+	k.resetBaseFeeCurveState(ctx, params.BaseFeeAdjustmentCurve)
 	var newBaseFee sdk.Dec
-	if blockGasUsedDec.GT(targetGasUsed) {
-		// upward adjustment
-		numerator := blockGasUsedDec.Sub(targetGasUsed)
-		denominator := blockGasLimit.Sub(targetGasUsed)
-		percentageFull := numerator.Quo(denominator)
-		adjustmentFactor := k.GetMaxDynamicBaseFeeUpwardAdjustment(ctx).Mul(percentageFull)
-		newBaseFee = prevBaseFee.Mul(sdk.NewDec(1).Add(adjustmentFactor))
-	} else {
-		// downward adjustment
-		numerator := targetGasUsed.Sub(blockGasUsedDec)
-		denominator := targetGasUsed
-		percentageEmpty := numerator.Quo(denominator)
-		adjustmentFactor := k.GetMaxDynamicBaseFeeDownwardAdjustment(ctx).Mul(percentageEmpty)
-		newBaseFee = prevBaseFee.Mul(sdk.NewDec(1).Sub(adjustmentFactor))
+	switch params.BaseFeeAdjustmentCurve {
+	case types.BaseFeeAdjustmentCurve_EXPONENTIAL:
+		newBaseFee = k.exponentialBaseFee(ctx, params, blockGasUsedDec.TruncateInt().Uint64())
+	case types.BaseFeeAdjustmentCurve_PID:
+		newBaseFee = k.pidBaseFee(ctx, params, prevBaseFee, blockGasUsedDec, targetGasUsed)
+	default:
+		newBaseFee = linearBaseFee(params, prevBaseFee, blockGasUsedDec, targetGasUsed, blockGasLimit)
 	}
 
 	// Ensure the new base fee is not lower than the minimum fee
@@ -60,6 +64,109 @@ func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64
 	return &newBaseFee
 }
 
+// linearBaseFee moves the base fee by up to the max upward (downward) adjustment,
+// proportionally to how full (empty) the block is relative to the target.
+func linearBaseFee(params types.Params, prevBaseFee sdk.Dec, blockGasUsed sdk.Dec, targetGasUsed sdk.Dec, blockGasLimit sdk.Dec) sdk.Dec {
+	if blockGasUsed.GT(targetGasUsed) {
+		// upward adjustment
+		numerator := blockGasUsed.Sub(targetGasUsed)
+		denominator := blockGasLimit.Sub(targetGasUsed)
+		percentageFull := numerator.Quo(denominator)
+		adjustmentFactor := params.MaxDynamicBaseFeeUpwardAdjustment.Mul(percentageFull)
+		return prevBaseFee.Mul(sdk.NewDec(1).Add(adjustmentFactor))
+	}
+	// downward adjustment
+	numerator := targetGasUsed.Sub(blockGasUsed)
+	denominator := targetGasUsed
+	percentageEmpty := numerator.Quo(denominator)
+	adjustmentFactor := params.MaxDynamicBaseFeeDownwardAdjustment.Mul(percentageEmpty)
+	return prevBaseFee.Mul(sdk.NewDec(1).Sub(adjustmentFactor))
+}
+
+// resetBaseFeeCurveState clears the state kept by the exponential and PID curves when the
+// curve changed since the last adjustment, so that switching back to a curve does not
+// resume from stale state. Curve changes are detected here rather than when params are
+// set, since governance param changes do not go through SetParams.
+func (k *Keeper) resetBaseFeeCurveState(ctx sdk.Context, curve types.BaseFeeAdjustmentCurve) {
+	store := ctx.KVStore(k.storeKey)
+	prev := types.BaseFeeAdjustmentCurve_LINEAR
+	if bz := store.Get(types.BaseFeeCurveKey); bz != nil {
+		prev = types.BaseFeeAdjustmentCurve(binary.BigEndian.Uint32(bz))
+	}
+	if prev == curve {
+		return
+	}
+	store.Delete(types.BaseFeeExcessGasKey)
+	store.Delete(types.BaseFeePidIntegralKey)
+	store.Delete(types.BaseFeePidLastErrorKey)
+	store.Set(types.BaseFeeCurveKey, binary.BigEndian.AppendUint32(nil, uint32(curve)))
+}
+
+// exponentialBaseFee prices gas like EIP-4844 prices blobs: the base fee is the minimum
+// fee times e^(excess / update fraction), where the excess is the gas used above the
+// target accumulated over blocks.
+func (k *Keeper) exponentialBaseFee(ctx sdk.Context, params types.Params, blockGasUsed uint64) sdk.Dec {
+	prevExcess := k.GetBaseFeeExcessGas(ctx)
+	excess := uint64(0)
+	if prevExcess+blockGasUsed > params.TargetGasUsedPerBlock {
+		excess = prevExcess + blockGasUsed - params.TargetGasUsedPerBlock
+	}
+	newBaseFee := fakeExponential(params.MinimumFeePerGas, excess, params.ExponentialBaseFeeUpdateFraction)
+	if newBaseFee.GT(params.MaximumFeePerGas) && excess > prevExcess {
+		// stop accumulating excess once the maximum fee is reached, so that the fee
+		// comes down as soon as usage drops below the target
+		excess = prevExcess
+	}
+	k.SetBaseFeeExcessGas(ctx, excess)
+	return newBaseFee
+}
+
+// fakeExponential approximates factor * e^(numerator / denominator) with the Taylor
+// expansion used by EIP-4844.
+func fakeExponential(factor sdk.Dec, numerator uint64, denominator uint64) sdk.Dec {
+	num := new(big.Int).SetUint64(numerator)
+	denom := new(big.Int).SetUint64(denominator)
+	output := new(big.Int)
+	accum := new(big.Int).Mul(factor.BigInt(), denom)
+	for i := int64(1); accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+		accum.Mul(accum, num)
+		accum.Quo(accum, new(big.Int).Mul(denom, big.NewInt(i)))
+	}
+	return sdk.NewDecFromBigIntWithPrec(output.Quo(output, denom), sdk.Precision)
+}
+
+// pidBaseFee adjusts the base fee by a PID controller on the relative distance of the
+// block gas used to the target. The adjustment is bounded by the max upward and
+// downward adjustments, and the integral stops accumulating while the adjustment or
+// the base fee is saturated so that it does not wind up.
+func (k *Keeper) pidBaseFee(ctx sdk.Context, params types.Params, prevBaseFee sdk.Dec, blockGasUsed sdk.Dec, targetGasUsed sdk.Dec) sdk.Dec {
+	e := blockGasUsed.Sub(targetGasUsed).Quo(targetGasUsed)
+	prevIntegral := k.GetBaseFeePidIntegral(ctx)
+	integral := prevIntegral.Add(e)
+	derivative := e.Sub(k.GetBaseFeePidLastError(ctx))
+	adjustment := params.PidProportionalGain.Mul(e).
+		Add(params.PidIntegralGain.Mul(integral)).
+		Add(params.PidDerivativeGain.Mul(derivative))
+	saturated := false
+	if maxUp := params.MaxDynamicBaseFeeUpwardAdjustment; adjustment.GT(maxUp) {
+		adjustment, saturated = maxUp, true
+	}
+	if maxDown := params.MaxDynamicBaseFeeDownwardAdjustment.Neg(); adjustment.LT(maxDown) {
+		adjustment, saturated = maxDown, true
+	}
+	newBaseFee := prevBaseFee.Mul(sdk.OneDec().Add(adjustment))
+	if (e.IsPositive() && newBaseFee.GTE(params.MaximumFeePerGas)) || (e.IsNegative() && newBaseFee.LTE(params.MinimumFeePerGas)) {
+		saturated = true
+	}
+	if saturated {
+		integral = prevIntegral
+	}
+	k.SetBaseFeePidIntegral(ctx, integral)
+	k.SetBaseFeePidLastError(ctx, e)
+	return newBaseFee
+}
+
 // dont have height be a prefix, just store the current base fee directly
 func (k *Keeper) GetCurrBaseFeePerGas(ctx sdk.Context) sdk.Dec {
 	store := ctx.KVStore(k.storeKey)
@@ -114,3 +221,51 @@ func (k *Keeper) GetNextBaseFeePerGas(ctx sdk.Context) sdk.Dec {
 	}
 	return d
 }
+
+func (k *Keeper) GetBaseFeeExcessGas(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeExcessGasKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k *Keeper) SetBaseFeeExcessGas(ctx sdk.Context, excess uint64) {
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeExcessGasKey, binary.BigEndian.AppendUint64(nil, excess))
+}
+
+func (k *Keeper) GetBaseFeePidIntegral(ctx sdk.Context) sdk.Dec {
+	return k.getDecOrZero(ctx, types.BaseFeePidIntegralKey)
+}
+
+func (k *Keeper) SetBaseFeePidIntegral(ctx sdk.Context, integral sdk.Dec) {
+	k.setDec(ctx, types.BaseFeePidIntegralKey, integral)
+}
+
+func (k *Keeper) GetBaseFeePidLastError(ctx sdk.Context) sdk.Dec {
+	return k.getDecOrZero(ctx, types.BaseFeePidLastErrorKey)
+}
+
+func (k *Keeper) SetBaseFeePidLastError(ctx sdk.Context, e sdk.Dec) {
+	k.setDec(ctx, types.BaseFeePidLastErrorKey, e)
+}
+
+func (k *Keeper) getDecOrZero(ctx sdk.Context, key []byte) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	d := sdk.Dec{}
+	if err := d.UnmarshalJSON(bz); err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (k *Keeper) setDec(ctx sdk.Context, key []byte, d sdk.Dec) {
+	bz, err := d.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
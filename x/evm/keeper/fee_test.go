@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -215,3 +216,177 @@ func TestGetPrevBlockBaseFeePerGasWithNilMinFee(t *testing.T) {
 	require.Equal(t, types.DefaultParams().MinimumFeePerGas, fee)
 	require.False(t, fee.IsNil())
 }
+
+func TestAdjustBaseFeePerGasExponential(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 1000000},
+	})
+	p := k.GetParams(ctx)
+	p.BaseFeeAdjustmentCurve = types.BaseFeeAdjustmentCurve_EXPONENTIAL
+	p.MinimumFeePerGas = sdk.NewDec(100)
+	p.MaximumFeePerGas = sdk.NewDec(1000)
+	p.TargetGasUsedPerBlock = 500000
+	p.ExponentialBaseFeeUpdateFraction = 1000000
+	k.SetParams(ctx, p)
+
+	for _, tc := range []struct {
+		blockGasUsed    uint64
+		expectedExcess  uint64
+		expectedBaseFee float64
+	}{
+		{blockGasUsed: 1000000, expectedExcess: 500000, expectedBaseFee: 164.872},  // 100 * e^0.5
+		{blockGasUsed: 2000000, expectedExcess: 1000000, expectedBaseFee: 271.828}, // capped to the block gas limit
+		{blockGasUsed: 500000, expectedExcess: 1000000, expectedBaseFee: 271.828},
+		{blockGasUsed: 0, expectedExcess: 500000, expectedBaseFee: 164.872},
+		{blockGasUsed: 0, expectedExcess: 0, expectedBaseFee: 100},
+		{blockGasUsed: 0, expectedExcess: 0, expectedBaseFee: 100},
+	} {
+		newBaseFee := k.AdjustDynamicBaseFeePerGas(ctx, tc.blockGasUsed)
+		require.Equal(t, tc.expectedExcess, k.GetBaseFeeExcessGas(ctx))
+		require.InDelta(t, tc.expectedBaseFee, newBaseFee.MustFloat64(), 0.001)
+	}
+
+	// excess stops accumulating once the maximum fee is reached
+	for i := 0; i < 10; i++ {
+		k.AdjustDynamicBaseFeePerGas(ctx, 1000000)
+	}
+	require.Equal(t, sdk.NewDec(1000), k.GetNextBaseFeePerGas(ctx))
+	excess := k.GetBaseFeeExcessGas(ctx)
+	require.Less(t, excess, uint64(3000000))
+	k.AdjustDynamicBaseFeePerGas(ctx, 0)
+	require.True(t, k.GetNextBaseFeePerGas(ctx).LT(sdk.NewDec(1000)))
+}
+
+func TestAdjustBaseFeePerGasPID(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 1000000},
+	})
+	p := k.GetParams(ctx)
+	p.BaseFeeAdjustmentCurve = types.BaseFeeAdjustmentCurve_PID
+	p.MinimumFeePerGas = sdk.NewDec(100)
+	p.MaximumFeePerGas = sdk.NewDec(1000)
+	p.TargetGasUsedPerBlock = 500000
+	p.MaxDynamicBaseFeeUpwardAdjustment = sdk.NewDecWithPrec(5, 1)
+	p.MaxDynamicBaseFeeDownwardAdjustment = sdk.NewDecWithPrec(5, 1)
+	p.PidProportionalGain = sdk.NewDecWithPrec(1, 1)
+	p.PidIntegralGain = sdk.NewDecWithPrec(1, 2)
+	p.PidDerivativeGain = sdk.NewDecWithPrec(5, 2)
+	k.SetParams(ctx, p)
+	k.SetNextBaseFeePerGas(ctx, sdk.NewDec(100))
+
+	for _, tc := range []struct {
+		blockGasUsed     uint64
+		expectedIntegral sdk.Dec
+		expectedBaseFee  sdk.Dec
+	}{
+		// e = 1, adjustment = .1 + .01 + .05
+		{blockGasUsed: 1000000, expectedIntegral: sdk.NewDec(1), expectedBaseFee: sdk.NewDec(116)},
+		// e = 1, adjustment = .1 + .02
+		{blockGasUsed: 1000000, expectedIntegral: sdk.NewDec(2), expectedBaseFee: sdk.MustNewDecFromStr("129.92")},
+		// e = 0, adjustment = .02 - .05
+		{blockGasUsed: 500000, expectedIntegral: sdk.NewDec(2), expectedBaseFee: sdk.MustNewDecFromStr("126.0224")},
+	} {
+		newBaseFee := k.AdjustDynamicBaseFeePerGas(ctx, tc.blockGasUsed)
+		require.Equal(t, tc.expectedIntegral, k.GetBaseFeePidIntegral(ctx))
+		require.Equal(t, tc.expectedBaseFee, *newBaseFee)
+	}
+
+	// the integral does not wind up while the base fee is held at the minimum
+	k.SetNextBaseFeePerGas(ctx, sdk.NewDec(100))
+	k.SetBaseFeePidIntegral(ctx, sdk.ZeroDec())
+	for i := 0; i < 5; i++ {
+		require.Equal(t, sdk.NewDec(100), *k.AdjustDynamicBaseFeePerGas(ctx, 0))
+	}
+	require.Equal(t, sdk.ZeroDec(), k.GetBaseFeePidIntegral(ctx))
+	require.Equal(t, sdk.NewDec(-1), k.GetBaseFeePidLastError(ctx))
+}
+
+func TestAdjustBaseFeePerGasCurveChange(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 1000000},
+	})
+	p := k.GetParams(ctx)
+	p.MinimumFeePerGas = sdk.NewDec(100)
+	p.MaximumFeePerGas = sdk.NewDec(1000)
+	p.TargetGasUsedPerBlock = 500000
+	p.ExponentialBaseFeeUpdateFraction = 1000000
+	setCurve := func(curve types.BaseFeeAdjustmentCurve) {
+		p.BaseFeeAdjustmentCurve = curve
+		k.SetParams(ctx, p)
+	}
+
+	setCurve(types.BaseFeeAdjustmentCurve_EXPONENTIAL)
+	k.AdjustDynamicBaseFeePerGas(ctx, 1000000)
+	require.Equal(t, uint64(500000), k.GetBaseFeeExcessGas(ctx))
+	setCurve(types.BaseFeeAdjustmentCurve_PID)
+	k.AdjustDynamicBaseFeePerGas(ctx, 1000000)
+	require.Equal(t, uint64(0), k.GetBaseFeeExcessGas(ctx))
+	require.Equal(t, sdk.NewDec(1), k.GetBaseFeePidIntegral(ctx))
+
+	// switching back starts from a clean state rather than from the excess left behind
+	setCurve(types.BaseFeeAdjustmentCurve_EXPONENTIAL)
+	require.InDelta(t, 100, k.AdjustDynamicBaseFeePerGas(ctx, 500000).MustFloat64(), 0.001)
+	require.Equal(t, uint64(0), k.GetBaseFeeExcessGas(ctx))
+	require.Equal(t, sdk.ZeroDec(), k.GetBaseFeePidIntegral(ctx))
+	require.Equal(t, sdk.ZeroDec(), k.GetBaseFeePidLastError(ctx))
+	setCurve(types.BaseFeeAdjustmentCurve_PID)
+	k.AdjustDynamicBaseFeePerGas(ctx, 500000)
+	require.Equal(t, sdk.ZeroDec(), k.GetBaseFeePidIntegral(ctx))
+}
+
+func TestBaseFeeHistory(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: 1000000},
+	})
+	p := k.GetParams(ctx)
+	p.BaseFeeHistorySize = 3
+	k.SetParams(ctx, p)
+
+	for height := int64(1); height <= 5; height++ {
+		k.SetNextBaseFeePerGas(ctx, sdk.NewDec(height*100))
+		k.AdjustDynamicBaseFeePerGas(ctx.WithBlockHeight(height), uint64(height*1000))
+	}
+	history := k.GetBaseFeeHistory(ctx, 0)
+	require.Len(t, history, 3)
+	for i, record := range history {
+		height := int64(5 - i)
+		require.Equal(t, types.BaseFeeRecord{
+			Height:    height,
+			BaseFee:   sdk.NewDec(height * 100),
+			GasUsed:   uint64(height * 1000),
+			GasTarget: p.TargetGasUsedPerBlock,
+		}, record)
+	}
+	require.Equal(t, history[:2], k.GetBaseFeeHistory(ctx, 2))
+	record, ok := k.GetBaseFeeRecord(ctx, 4)
+	require.True(t, ok)
+	require.Equal(t, history[1], record)
+	_, ok = k.GetBaseFeeRecord(ctx, 2)
+	require.False(t, ok)
+
+	querier := keeper.NewQuerier(k)
+	res, err := querier.BaseFeeHistory(sdk.WrapSDKContext(ctx), &types.QueryBaseFeeHistoryRequest{Count: 1})
+	require.Nil(t, err)
+	require.Equal(t, history[:1], res.Records)
+
+	// shrinking the history drops the slots beyond the new size
+	p.BaseFeeHistorySize = 2
+	k.SetParams(ctx, p)
+	k.AdjustDynamicBaseFeePerGas(ctx.WithBlockHeight(6), 6000)
+	history = k.GetBaseFeeHistory(ctx, 0)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(6), history[0].Height)
+	require.Equal(t, int64(5), history[1].Height)
+
+	// a history size of 0 disables the history
+	p.BaseFeeHistorySize = 0
+	k.SetParams(ctx, p)
+	k.AdjustDynamicBaseFeePerGas(ctx.WithBlockHeight(7), 7000)
+	require.Empty(t, k.GetBaseFeeHistory(ctx, 0))
+	_, ok = k.GetBaseFeeRecord(ctx, 7)
+	require.False(t, ok)
+}
@@ -0,0 +1,415 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/proto"
+	seidbtypes "github.com/sei-protocol/sei-db/ss/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// The flat snapshot keeps the history of the keys EVM state is read from (storage, nonces,
+// code, address associations and balances in the base denom) in the receipt store, so that
+// they can be read at past heights with a single seek instead of going through the
+// versioned state store. Each block writes the keys it changed at its height, and base
+// layers periodically write every key at a height so that the snapshot remains complete
+// once older heights are pruned from the receipt store.
+
+var flatSnapshotEVMPrefixes = [][]byte{
+	types.EVMAddressToSeiAddressKeyPrefix,
+	types.SeiAddressToEVMAddressKeyPrefix,
+	types.StateKeyPrefix,
+	types.CodeKeyPrefix,
+	types.CodeHashKeyPrefix,
+	types.CodeSizeKeyPrefix,
+	types.NonceKeyPrefix,
+}
+
+var flatSnapshotBankPrefixes = [][]byte{
+	banktypes.BalancesPrefix,
+	banktypes.WeiBalancesPrefix,
+}
+
+// FlatSnapshotPrefixes returns the prefixes of the keys of the given module store whose
+// history the flat snapshot keeps.
+func FlatSnapshotPrefixes(store byte) [][]byte {
+	if store == types.FlatSnapshotBankStore {
+		return flatSnapshotBankPrefixes
+	}
+	return flatSnapshotEVMPrefixes
+}
+
+// IsFlatSnapshotKey returns whether the flat snapshot keeps the history of key in the given
+// module store. Only balances in the base denom are kept.
+func IsFlatSnapshotKey(store byte, key []byte) bool {
+	for _, prefix := range FlatSnapshotPrefixes(store) {
+		if !bytes.HasPrefix(key, prefix) {
+			continue
+		}
+		if store == types.FlatSnapshotBankStore && bytes.Equal(prefix, banktypes.BalancesPrefix) {
+			// balances are keyed by prefix | address length | address | denom
+			if len(key) < 2 || len(key) < int(key[1])+2 {
+				return false
+			}
+			return string(key[int(key[1])+2:]) == sdk.MustGetBaseDenom()
+		}
+		return true
+	}
+	return false
+}
+
+// flatSnapshotStatus caches the unbroken run of blocks whose changes were written to the
+// flat snapshot, since writes to the receipt store are asynchronous.
+type flatSnapshotStatus struct {
+	mu              sync.Mutex
+	loaded          bool
+	continuousSince uint64
+	latest          uint64
+}
+
+func (k *Keeper) SetFlatSnapshotEnabled(enabled bool) {
+	k.flatSnapshotEnabled = enabled
+}
+
+func (k *Keeper) FlatSnapshotEnabled() bool {
+	return k.flatSnapshotEnabled
+}
+
+// FlatSnapshotDiffPairs returns the flat snapshot entries recording the changes a block
+// made to the keys of the given module store. Changes to keys the snapshot does not keep
+// are skipped.
+func FlatSnapshotDiffPairs(store byte, height uint64, changes []*iavl.KVPair) []*iavl.KVPair {
+	pairs := make([]*iavl.KVPair, 0, len(changes))
+	for _, change := range changes {
+		if !IsFlatSnapshotKey(store, change.Key) {
+			continue
+		}
+		pairs = append(pairs, &iavl.KVPair{
+			Key:   types.FlatSnapshotEntryKey(store, change.Key, height),
+			Value: flatSnapshotEntryValue(change.Value, change.Delete),
+		})
+	}
+	return pairs
+}
+
+// SetFlatSnapshotDiff writes the changes the block at height made to the EVM and bank
+// module stores to the flat snapshot. It is a no-op if the flat snapshot is disabled. A
+// block that does not directly follow the last block written starts a new run, which the
+// snapshot can only serve once a base layer has been written within it.
+func (k *Keeper) SetFlatSnapshotDiff(height int64, evmChanges []*iavl.KVPair, bankChanges []*iavl.KVPair) error {
+	if !k.flatSnapshotEnabled {
+		return nil
+	}
+	status := k.flatSnapshotStatus
+	status.mu.Lock()
+	defer status.mu.Unlock()
+	if !status.loaded {
+		since, latest, err := GetFlatSnapshotStatus(k.receiptStore)
+		if err != nil {
+			return err
+		}
+		status.continuousSince, status.latest, status.loaded = since, latest, true
+	}
+	h := uint64(height) //nolint:gosec
+	if status.latest == 0 || status.latest+1 != h {
+		status.continuousSince = h
+	}
+	status.latest = h
+	pairs := FlatSnapshotDiffPairs(types.FlatSnapshotEVMStore, h, evmChanges)
+	pairs = append(pairs, FlatSnapshotDiffPairs(types.FlatSnapshotBankStore, h, bankChanges)...)
+	pairs = append(pairs, &iavl.KVPair{Key: types.FlatSnapshotStatusKey, Value: encodeFlatSnapshotStatus(status.continuousSince, status.latest)})
+	sortPairs(pairs)
+	return k.applyReceiptChangesetsAsync(height, []*proto.NamedChangeSet{{
+		Name:      types.ReceiptStoreKey,
+		Changeset: iavl.ChangeSet{Pairs: pairs},
+	}})
+}
+
+// WriteFlatSnapshotBaseLayer writes every key the flat snapshot keeps as of the height of
+// ctx, batchSize entries at a time, followed by the marker of the base layer. It is meant
+// to run in the background against a query context, and returns the number of entries
+// written. Entries carry their height in their key, so they are queued at the receipt
+// store's latest version alongside block writes rather than written at the height of ctx,
+// which would move the store's latest version back.
+func (k *Keeper) WriteFlatSnapshotBaseLayer(ctx sdk.Context, batchSize int) (int, error) {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	written := 0
+	var pairs []*iavl.KVPair
+	flush := func() error {
+		if len(pairs) == 0 {
+			return nil
+		}
+		sortPairs(pairs)
+		if err := k.applyReceiptChangesetsAtLatestAsync([]*proto.NamedChangeSet{{
+			Name:      types.ReceiptStoreKey,
+			Changeset: iavl.ChangeSet{Pairs: pairs},
+		}}); err != nil {
+			return err
+		}
+		written += len(pairs)
+		pairs = nil
+		return nil
+	}
+	for _, s := range []struct {
+		store    byte
+		storeKey sdk.StoreKey
+	}{
+		{types.FlatSnapshotEVMStore, k.storeKey},
+		{types.FlatSnapshotBankStore, k.BankKeeper().GetStoreKey()},
+	} {
+		kvStore := ctx.MultiStore().GetKVStore(s.storeKey)
+		for _, prefix := range FlatSnapshotPrefixes(s.store) {
+			iter := kvStore.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+			for ; iter.Valid(); iter.Next() {
+				if !IsFlatSnapshotKey(s.store, iter.Key()) {
+					continue
+				}
+				pairs = append(pairs, &iavl.KVPair{
+					Key:   types.FlatSnapshotEntryKey(s.store, iter.Key(), height),
+					Value: flatSnapshotEntryValue(iter.Value(), false),
+				})
+				if len(pairs) >= batchSize {
+					if err := flush(); err != nil {
+						iter.Close()
+						return written, err
+					}
+				}
+			}
+			iter.Close()
+		}
+	}
+	pairs = append(pairs, &iavl.KVPair{Key: types.FlatSnapshotBaseLayerKey(height), Value: []byte{}})
+	return written, flush()
+}
+
+// GetFlatSnapshotStatus returns the first and last heights of the latest unbroken run of
+// blocks whose changes were written to the flat snapshot of receiptStore, or zeros if none
+// was.
+func GetFlatSnapshotStatus(receiptStore seidbtypes.StateStore) (uint64, uint64, error) {
+	lv, err := receiptStore.GetLatestVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	bz, err := receiptStore.Get(types.ReceiptStoreKey, lv, types.FlatSnapshotStatusKey)
+	if err != nil || len(bz) != 16 {
+		return 0, 0, err
+	}
+	return binary.BigEndian.Uint64(bz[:8]), binary.BigEndian.Uint64(bz[8:]), nil
+}
+
+// FlatSnapshotBaseLayer returns the height of the latest base layer at or below height, and
+// whether the flat snapshot of receiptStore can serve height from it: every block since
+// the base layer and up to height must have been written.
+func FlatSnapshotBaseLayer(receiptStore seidbtypes.StateStore, height uint64) (uint64, bool, error) {
+	since, latest, err := GetFlatSnapshotStatus(receiptStore)
+	if err != nil || latest == 0 || height > latest {
+		return 0, false, err
+	}
+	lv, err := receiptStore.GetLatestVersion()
+	if err != nil {
+		return 0, false, err
+	}
+	iter, err := receiptStore.ReverseIterator(types.ReceiptStoreKey, lv, types.FlatSnapshotBaseLayerKey(0), types.FlatSnapshotBaseLayerKey(height+1))
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() { _ = iter.Close() }()
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	base := binary.BigEndian.Uint64(iter.Key()[len(types.FlatSnapshotBaseLayerPrefix):])
+	return base, base+1 >= since, nil
+}
+
+// GetFlatSnapshotValue returns the value of key in the given module store at height
+// according to the flat snapshot of receiptStore, nil if the key did not exist. The
+// snapshot must be able to serve height.
+func GetFlatSnapshotValue(receiptStore seidbtypes.StateStore, store byte, key []byte, height uint64) ([]byte, error) {
+	lv, err := receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	iter, err := receiptStore.ReverseIterator(types.ReceiptStoreKey, lv, types.FlatSnapshotEntryKey(store, key, 0), types.FlatSnapshotEntryKey(store, key, height+1))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+	if !iter.Valid() {
+		return nil, nil
+	}
+	return decodeFlatSnapshotEntryValue(iter.Value()), nil
+}
+
+// FlatSnapshotContext returns ctx with the keys the flat snapshot keeps read from the
+// snapshot rather than from the versioned state store, if the snapshot is enabled and
+// can serve the height of ctx. ctx must be a query context at a past height; writes made
+// to it are cached on top of the snapshot as usual.
+func (k *Keeper) FlatSnapshotContext(ctx sdk.Context) sdk.Context {
+	if !k.flatSnapshotEnabled || ctx.BlockHeight() <= 0 {
+		return ctx
+	}
+	height := uint64(ctx.BlockHeight())
+	if _, ok, err := FlatSnapshotBaseLayer(k.receiptStore, height); err != nil || !ok {
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to check flat snapshot at height %d, reading the state store: %s", height, err))
+		}
+		return ctx
+	}
+	bankStoreKey := k.BankKeeper().GetStoreKey()
+	ms := ctx.MultiStore().SetKVStores(func(key storetypes.StoreKey, s sdk.KVStore) storetypes.CacheWrap {
+		var store byte
+		switch key {
+		case k.storeKey:
+			store = types.FlatSnapshotEVMStore
+		case bankStoreKey:
+			store = types.FlatSnapshotBankStore
+		default:
+			return s.(storetypes.CacheWrap)
+		}
+		return cachekv.NewStore(&flatSnapshotKVStore{KVStore: s, receiptStore: k.receiptStore, store: store, height: height, logger: ctx.Logger()}, key, storetypes.DefaultCacheSizeLimit)
+	})
+	return ctx.WithMultiStore(ms)
+}
+
+// flatSnapshotKVStore reads the keys the flat snapshot keeps from the snapshot and every
+// other key from the underlying store. Snapshot entries are grouped by key length, so
+// they cannot be iterated in key order; iterators read the underlying store, which holds
+// the same values at the height.
+type flatSnapshotKVStore struct {
+	sdk.KVStore
+	receiptStore seidbtypes.StateStore
+	store        byte
+	height       uint64
+	logger       log.Logger
+}
+
+func (s *flatSnapshotKVStore) Get(key []byte) []byte {
+	if !IsFlatSnapshotKey(s.store, key) {
+		return s.KVStore.Get(key)
+	}
+	value, err := GetFlatSnapshotValue(s.receiptStore, s.store, key, s.height)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("failed to read %X from flat snapshot at height %d, reading the state store: %s", key, s.height, err))
+		return s.KVStore.Get(key)
+	}
+	return value
+}
+
+func (s *flatSnapshotKVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *flatSnapshotKVStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *flatSnapshotKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// VerifyFlatSnapshot compares the value of every key the flat snapshot of receiptStore
+// keeps at height with its value in stateStore, the versioned state store of the chain,
+// calling onMismatch for every key whose values differ. It returns the number of keys
+// checked.
+func VerifyFlatSnapshot(receiptStore seidbtypes.StateStore, stateStore seidbtypes.StateStore, height uint64, onMismatch func(store byte, key []byte, expected []byte, actual []byte)) (int, error) {
+	checked := 0
+	storeKeys := map[byte]string{types.FlatSnapshotEVMStore: types.StoreKey, types.FlatSnapshotBankStore: banktypes.StoreKey}
+	// every key of the state store must be in the snapshot
+	for _, store := range []byte{types.FlatSnapshotEVMStore, types.FlatSnapshotBankStore} {
+		for _, prefix := range FlatSnapshotPrefixes(store) {
+			iter, err := stateStore.Iterator(storeKeys[store], int64(height), prefix, storetypes.PrefixEndBytes(prefix)) //nolint:gosec
+			if err != nil {
+				return checked, err
+			}
+			for ; iter.Valid(); iter.Next() {
+				if !IsFlatSnapshotKey(store, iter.Key()) {
+					continue
+				}
+				actual, err := GetFlatSnapshotValue(receiptStore, store, iter.Key(), height)
+				if err != nil {
+					_ = iter.Close()
+					return checked, err
+				}
+				checked++
+				if !bytes.Equal(iter.Value(), actual) {
+					onMismatch(store, common.CopyBytes(iter.Key()), common.CopyBytes(iter.Value()), actual)
+				}
+			}
+			_ = iter.Close()
+		}
+	}
+	// and every key the snapshot has must be in the state store
+	lv, err := receiptStore.GetLatestVersion()
+	if err != nil {
+		return checked, err
+	}
+	iter, err := receiptStore.Iterator(types.ReceiptStoreKey, lv, types.FlatSnapshotEntryPrefix, storetypes.PrefixEndBytes(types.FlatSnapshotEntryPrefix))
+	if err != nil {
+		return checked, err
+	}
+	defer func() { _ = iter.Close() }()
+	var lastStore byte
+	var lastKey, lastValue []byte
+	check := func() error {
+		if lastValue == nil {
+			return nil
+		}
+		expected, err := stateStore.Get(storeKeys[lastStore], int64(height), lastKey) //nolint:gosec
+		if err != nil {
+			return err
+		}
+		if expected == nil {
+			onMismatch(lastStore, lastKey, nil, lastValue)
+		}
+		return nil
+	}
+	for ; iter.Valid(); iter.Next() {
+		store, key, entryHeight := types.FlatSnapshotEntryKeyPosition(iter.Key())
+		if store != lastStore || !bytes.Equal(key, lastKey) {
+			if err := check(); err != nil {
+				return checked, err
+			}
+			lastStore, lastKey, lastValue = store, common.CopyBytes(key), nil
+		}
+		if entryHeight <= height {
+			lastValue = decodeFlatSnapshotEntryValue(iter.Value())
+		}
+	}
+	return checked, check()
+}
+
+// flatSnapshotEntryValue marks whether the key was deleted, since deleting the entry would
+// expose an older value.
+func flatSnapshotEntryValue(value []byte, deleted bool) []byte {
+	if deleted {
+		return []byte{0}
+	}
+	return append([]byte{1}, value...)
+}
+
+func decodeFlatSnapshotEntryValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == 0 {
+		return nil
+	}
+	return bz[1:]
+}
+
+func encodeFlatSnapshotStatus(continuousSince uint64, latest uint64) []byte {
+	bz := binary.BigEndian.AppendUint64(make([]byte, 0, 16), continuousSince)
+	return binary.BigEndian.AppendUint64(bz, latest)
+}
+
+func sortPairs(pairs []*iavl.KVPair) {
+	sort.Slice(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0 })
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/common/logger"
+	"github.com/sei-protocol/sei-db/config"
+	"github.com/sei-protocol/sei-db/proto"
+	"github.com/sei-protocol/sei-db/ss"
+	seidbtypes "github.com/sei-protocol/sei-db/ss/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newPebbleStore(t *testing.T) seidbtypes.StateStore {
+	dir := filepath.Join(t.TempDir(), "store.db")
+	ssConfig := config.DefaultStateStoreConfig()
+	ssConfig.DBDirectory = dir
+	ssConfig.KeepLastVersion = false
+	ssConfig.KeepRecent = 0
+	store, err := ss.NewStateStore(logger.NewNopLogger(), dir, ssConfig)
+	require.Nil(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func applyPairs(t *testing.T, store seidbtypes.StateStore, name string, height int64, pairs []*iavl.KVPair) {
+	require.Nil(t, store.ApplyChangeset(height, &proto.NamedChangeSet{Name: name, Changeset: iavl.ChangeSet{Pairs: pairs}}))
+	latest, err := store.GetLatestVersion()
+	require.Nil(t, err)
+	if height > latest {
+		require.Nil(t, store.SetLatestVersion(height))
+	}
+}
+
+func flatSnapshotStatusPair(since uint64, latest uint64) *iavl.KVPair {
+	value := binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, since), latest)
+	return &iavl.KVPair{Key: types.FlatSnapshotStatusKey, Value: value}
+}
+
+func TestIsFlatSnapshotKey(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	seiAddr := sdk.AccAddress(addr[:])
+	require.True(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotEVMStore, types.StateKey(addr)))
+	require.True(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotEVMStore, append(types.NonceKeyPrefix, addr[:]...)))
+	require.False(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotEVMStore, types.ReceiptKey(common.Hash{})))
+	require.True(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotBankStore, banktypes.CreatePrefixedAccountStoreKey(seiAddr, []byte(sdk.MustGetBaseDenom()))))
+	require.False(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotBankStore, banktypes.CreatePrefixedAccountStoreKey(seiAddr, []byte("other"))))
+	require.True(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotBankStore, append(banktypes.WeiBalancesPrefix, seiAddr...)))
+	require.False(t, keeper.IsFlatSnapshotKey(types.FlatSnapshotBankStore, types.StateKey(addr)))
+}
+
+func TestFlatSnapshot(t *testing.T) {
+	receiptStore := newPebbleStore(t)
+	slot := append(types.StateKey(common.HexToAddress("0x1")), common.HexToHash("0x1").Bytes()...)
+	nonce := append(types.NonceKeyPrefix, common.HexToAddress("0x1").Bytes()...)
+	valueAt := func(key []byte, height uint64) []byte {
+		value, err := keeper.GetFlatSnapshotValue(receiptStore, types.FlatSnapshotEVMStore, key, height)
+		require.Nil(t, err)
+		return value
+	}
+	serves := func(height uint64) bool {
+		_, ok, err := keeper.FlatSnapshotBaseLayer(receiptStore, height)
+		require.Nil(t, err)
+		return ok
+	}
+
+	// nothing written yet
+	require.False(t, serves(10))
+
+	// base layer at 10, diffs at 11 to 13
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 10, []*iavl.KVPair{
+		{Key: types.FlatSnapshotEntryKey(types.FlatSnapshotEVMStore, slot, 10), Value: append([]byte{1}, 0xa)},
+		{Key: types.FlatSnapshotBaseLayerKey(10), Value: []byte{}},
+	})
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 11, append(keeper.FlatSnapshotDiffPairs(types.FlatSnapshotEVMStore, 11, []*iavl.KVPair{
+		{Key: slot, Value: []byte{0xb}},
+		{Key: nonce, Value: []byte{1}},
+		{Key: types.ReceiptKey(common.Hash{}), Value: []byte{1}}, // not kept
+	}), flatSnapshotStatusPair(11, 11)))
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 13, append(keeper.FlatSnapshotDiffPairs(types.FlatSnapshotEVMStore, 13, []*iavl.KVPair{
+		{Key: slot, Delete: true},
+	}), flatSnapshotStatusPair(11, 13)))
+
+	require.False(t, serves(9))
+	require.True(t, serves(10))
+	require.True(t, serves(13))
+	require.False(t, serves(14))
+	require.Equal(t, []byte{0xa}, valueAt(slot, 10))
+	require.Equal(t, []byte{0xb}, valueAt(slot, 11))
+	require.Equal(t, []byte{0xb}, valueAt(slot, 12))
+	require.Nil(t, valueAt(slot, 13))
+	require.Nil(t, valueAt(nonce, 10))
+	require.Equal(t, []byte{1}, valueAt(nonce, 13))
+	require.Nil(t, valueAt(types.ReceiptKey(common.Hash{}), 13))
+
+	// a gap in the diffs invalidates the base layer until a new one is written
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 20, []*iavl.KVPair{flatSnapshotStatusPair(20, 20)})
+	require.False(t, serves(20))
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 20, []*iavl.KVPair{{Key: types.FlatSnapshotBaseLayerKey(20), Value: []byte{}}})
+	require.True(t, serves(20))
+	require.False(t, serves(13))
+}
+
+func TestVerifyFlatSnapshot(t *testing.T) {
+	receiptStore, stateStore := newPebbleStore(t), newPebbleStore(t)
+	addr := common.HexToAddress("0x1")
+	slot1 := append(types.StateKey(addr), common.HexToHash("0x1").Bytes()...)
+	slot2 := append(types.StateKey(addr), common.HexToHash("0x2").Bytes()...)
+	slot3 := append(types.StateKey(addr), common.HexToHash("0x3").Bytes()...)
+	balance := banktypes.CreatePrefixedAccountStoreKey(addr[:], []byte(sdk.MustGetBaseDenom()))
+	applyPairs(t, stateStore, types.StoreKey, 5, []*iavl.KVPair{{Key: slot1, Value: []byte{1}}, {Key: slot2, Value: []byte{2}}})
+	applyPairs(t, stateStore, banktypes.StoreKey, 5, []*iavl.KVPair{{Key: balance, Value: []byte{3}}})
+	applyPairs(t, receiptStore, types.ReceiptStoreKey, 5, append(
+		keeper.FlatSnapshotDiffPairs(types.FlatSnapshotEVMStore, 5, []*iavl.KVPair{
+			{Key: slot1, Value: []byte{1}},
+			{Key: slot2, Value: []byte{9}}, // wrong value
+			{Key: slot3, Value: []byte{3}}, // not in the state store
+		}),
+		keeper.FlatSnapshotDiffPairs(types.FlatSnapshotBankStore, 5, []*iavl.KVPair{{Key: balance, Value: []byte{3}}})...,
+	))
+
+	type mismatch struct {
+		store            byte
+		key              []byte
+		expected, actual []byte
+	}
+	mismatches := []mismatch{}
+	checked, err := keeper.VerifyFlatSnapshot(receiptStore, stateStore, 5, func(store byte, key []byte, expected []byte, actual []byte) {
+		mismatches = append(mismatches, mismatch{store, key, expected, actual})
+	})
+	require.Nil(t, err)
+	require.Equal(t, 3, checked)
+	require.Equal(t, []mismatch{
+		{types.FlatSnapshotEVMStore, slot2, []byte{2}, []byte{9}},
+		{types.FlatSnapshotEVMStore, slot3, nil, []byte{3}},
+	}, mismatches)
+}
@@ -279,3 +279,20 @@ func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*ty
 		return nil, errors.ErrUnsupported
 	}
 }
+
+func (q Querier) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBaseFeeHistoryResponse{Records: q.Keeper.GetBaseFeeHistory(ctx, req.Count)}, nil
+}
+
+func (q Querier) PointerHistory(c context.Context, req *types.QueryPointerHistoryRequest) (*types.QueryPointerHistoryResponse, error) {
+	if req.Pointee == "" {
+		return nil, ErrMustSpecifyPointee
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	versions, err := q.Keeper.GetPointerHistory(ctx, req.PointerType, req.Pointee)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPointerHistoryResponse{Versions: versions}, nil
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+)
+
+type TokenFactoryHooks struct {
+	k *Keeper
+}
+
+var _ tokenfactorytypes.TokenFactoryHooks = TokenFactoryHooks{}
+
+func (k *Keeper) TokenFactoryHooks() TokenFactoryHooks {
+	return TokenFactoryHooks{k}
+}
+
+func (h TokenFactoryHooks) AfterDenomCreated(ctx sdk.Context, denom string) {
+	h.k.AutoRegisterNativePointer(ctx, denom)
+}
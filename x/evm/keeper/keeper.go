@@ -84,7 +84,15 @@ type Keeper struct {
 	Root        common.Hash
 	ReplayBlock *ethtypes.Block
 
-	receiptStore seidbtypes.StateStore
+	receiptStore               seidbtypes.StateStore
+	receiptWrites              *receiptWrites
+	logIndexEnabled            bool
+	logIndexStartRecorded      bool
+	txAddressIndexEnabled      bool
+	accountHistoryIndexEnabled bool
+	stateDiffIndexEnabled      bool
+	flatSnapshotEnabled        bool
+	flatSnapshotStatus         *flatSnapshotStatus
 
 	customPrecompiles       map[common.Address]precompiles.VersionedPrecompiles
 	latestCustomPrecompiles map[common.Address]vm.PrecompiledContract
@@ -143,6 +151,8 @@ func NewKeeper(
 		cachedFeeCollectorAddressMtx: &sync.RWMutex{},
 		keyToNonce:                   make(map[tmtypes.TxKey]*AddressNoncePair),
 		receiptStore:                 receiptStateStore,
+		flatSnapshotStatus:           &flatSnapshotStatus{},
+		receiptWrites:                &receiptWrites{},
 	}
 	return k
 }
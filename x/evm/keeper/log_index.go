@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// SetLogIndexEnabled toggles whether log index entries are written alongside receipts
+// when they are flushed to the receipt store.
+func (k *Keeper) SetLogIndexEnabled(enabled bool) {
+	k.logIndexEnabled = enabled
+}
+
+func (k *Keeper) LogIndexEnabled() bool {
+	return k.logIndexEnabled
+}
+
+// LogIndexPairs returns the log index entries for all logs of a receipt. Each log is
+// indexed by its address and by each of its topics, and points to the hash of the
+// transaction that emitted it.
+func LogIndexPairs(receipt *types.Receipt) []*iavl.KVPair {
+	txHash := common.HexToHash(receipt.TxHashHex)
+	pairs := []*iavl.KVPair{}
+	for _, log := range receipt.Logs {
+		addr := common.HexToAddress(log.Address)
+		pairs = append(pairs, &iavl.KVPair{
+			Key:   types.LogIndexKey(types.LogIndexAddressKind, addr[:], receipt.BlockNumber, receipt.TransactionIndex, log.Index),
+			Value: txHash[:],
+		})
+		for i, topic := range log.Topics {
+			if i >= types.LogIndexMaxTopics {
+				break
+			}
+			t := common.HexToHash(topic)
+			pairs = append(pairs, &iavl.KVPair{
+				Key:   types.LogIndexKey(byte(i+1), t[:], receipt.BlockNumber, receipt.TransactionIndex, log.Index),
+				Value: txHash[:],
+			})
+		}
+	}
+	return pairs
+}
+
+// LogIndexStartHeightPair returns the receipt store entry recording height as the lowest
+// height from which on the log index holds the logs of every block.
+func LogIndexStartHeightPair(height uint64) *iavl.KVPair {
+	return &iavl.KVPair{Key: types.LogIndexStartHeightKey, Value: sdk.Uint64ToBigEndian(height)}
+}
+
+// shouldRecordLogIndexStart reports whether no lowest indexed height has been recorded
+// yet, in which case the index starts at the block being flushed.
+func (k *Keeper) shouldRecordLogIndexStart() (bool, error) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.LogIndexStartHeightKey)
+	if err != nil {
+		return false, err
+	}
+	return len(bz) == 0, nil
+}
+
+// GetLogIndexStartHeight returns the lowest height from which on the log index can answer
+// the criteria. The second return value is false if the index cannot answer them at all,
+// either because it is disabled, because nothing has been indexed yet or because the
+// criteria have no address or topic to look up.
+func (k *Keeper) GetLogIndexStartHeight(addresses []common.Address, topics [][]common.Hash) (uint64, bool, error) {
+	if !k.logIndexEnabled {
+		return 0, false, nil
+	}
+	if _, values := logIndexValues(addresses, topics); len(values) == 0 {
+		return 0, false, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return 0, false, err
+	}
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.LogIndexStartHeightKey)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(bz) == 0 {
+		return 0, false, nil
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
+// GetLogIndexHeights returns the sorted heights within [fromHeight, toHeight] that contain
+// at least one log emitted by one of the addresses, or, if no address is given, one log
+// matching the first non-empty topic position. The heights are a superset of the heights
+// that fully match the criteria, so callers still need to check each log. The second
+// return value is false if the criteria cannot be answered by the index, including when
+// fromHeight is below the height returned by GetLogIndexStartHeight.
+func (k *Keeper) GetLogIndexHeights(ctx sdk.Context, fromHeight, toHeight uint64, addresses []common.Address, topics [][]common.Hash) ([]uint64, bool, error) {
+	startHeight, ok, err := k.GetLogIndexStartHeight(addresses, topics)
+	if err != nil || !ok || fromHeight < startHeight {
+		return nil, false, err
+	}
+	kind, values := logIndexValues(addresses, topics)
+
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, false, err
+	}
+	heights := map[uint64]struct{}{}
+	for _, value := range values {
+		start := types.LogIndexHeightPrefix(kind, value, fromHeight)
+		end := types.LogIndexHeightPrefix(kind, value, toHeight+1)
+		if toHeight == ^uint64(0) {
+			end = sdk.PrefixEndBytes(types.LogIndexValuePrefix(kind, value))
+		}
+		iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, lv, start, end)
+		if err != nil {
+			return nil, false, err
+		}
+		for ; iter.Valid(); iter.Next() {
+			heights[types.LogIndexKeyHeight(iter.Key())] = struct{}{}
+		}
+		if err := iter.Close(); err != nil {
+			return nil, false, err
+		}
+	}
+	res := make([]uint64, 0, len(heights))
+	for h := range heights {
+		res = append(res, h)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, true, nil
+}
+
+// logIndexValues returns the kind and values of the log index entries to look up for the
+// criteria, or no values if the criteria cannot be answered by the index.
+func logIndexValues(addresses []common.Address, topics [][]common.Hash) (byte, [][]byte) {
+	kind, values := types.LogIndexAddressKind, [][]byte{}
+	for _, addr := range addresses {
+		a := addr
+		values = append(values, a[:])
+	}
+	if len(values) == 0 {
+		for i, topicList := range topics {
+			if len(topicList) == 0 || i >= types.LogIndexMaxTopics {
+				continue
+			}
+			kind = byte(i + 1)
+			for _, topic := range topicList {
+				t := topic
+				values = append(values, t[:])
+			}
+			break
+		}
+	}
+	return kind, values
+}
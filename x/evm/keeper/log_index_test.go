@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogIndexPairs(t *testing.T) {
+	txHash := common.HexToHash("0xabc")
+	receipt := &types.Receipt{
+		TxHashHex:        txHash.Hex(),
+		BlockNumber:      5,
+		TransactionIndex: 2,
+		Logs: []*types.Log{
+			{Address: common.HexToAddress("0x1").Hex(), Topics: []string{common.HexToHash("0x2").Hex(), common.HexToHash("0x3").Hex()}, Index: 0},
+			{Address: common.HexToAddress("0x4").Hex(), Index: 1},
+		},
+	}
+	pairs := keeper.LogIndexPairs(receipt)
+	// address + 2 topics for the first log, address only for the second
+	require.Len(t, pairs, 4)
+	addr := common.HexToAddress("0x1")
+	require.Equal(t, types.LogIndexKey(types.LogIndexAddressKind, addr[:], 5, 2, 0), pairs[0].Key)
+	topic := common.HexToHash("0x3")
+	require.Equal(t, types.LogIndexKey(2, topic[:], 5, 2, 0), pairs[2].Key)
+	require.Equal(t, uint64(5), types.LogIndexKeyHeight(pairs[3].Key))
+	for _, pair := range pairs {
+		require.Equal(t, txHash[:], pair.Value)
+	}
+}
+
+func TestGetLogIndexHeights(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(10)
+	addr1, addr2 := common.HexToAddress("0x1234"), common.HexToAddress("0x5678")
+	topic := common.HexToHash("0x9abc")
+
+	_, ok, err := k.GetLogIndexHeights(ctx, 0, 100, []common.Address{addr1}, nil)
+	require.Nil(t, err)
+	require.False(t, ok) // index disabled
+
+	k.SetLogIndexEnabled(true)
+	defer k.SetLogIndexEnabled(false)
+	for i, height := range []uint64{10, 20, 30} {
+		addr := addr1
+		if i == 1 {
+			addr = addr2
+		}
+		txHash := common.BytesToHash([]byte{byte(height)})
+		require.Nil(t, k.SetTransientReceipt(ctx, txHash, &types.Receipt{
+			TxHashHex:   txHash.Hex(),
+			BlockNumber: height,
+			Logs:        []*types.Log{{Address: addr.Hex(), Topics: []string{topic.Hex()}}},
+		}))
+	}
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+
+	// the index only holds the blocks flushed since it was enabled
+	start, ok, err := k.GetLogIndexStartHeight([]common.Address{addr1}, nil)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(10), start)
+	_, ok, err = k.GetLogIndexHeights(ctx, 0, 100, []common.Address{addr1}, nil)
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	heights, ok, err := k.GetLogIndexHeights(ctx, 10, 100, []common.Address{addr1}, nil)
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, []uint64{10, 30}, heights)
+
+	heights, _, err = k.GetLogIndexHeights(ctx, 15, 30, []common.Address{addr1, addr2}, nil)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{20, 30}, heights)
+
+	heights, ok, err = k.GetLogIndexHeights(ctx, 10, 25, nil, [][]common.Hash{{topic}})
+	require.Nil(t, err)
+	require.True(t, ok)
+	require.Equal(t, []uint64{10, 20}, heights)
+
+	// criteria without addresses or topics cannot be served by the index
+	_, ok, err = k.GetLogIndexHeights(ctx, 10, 100, nil, [][]common.Hash{{}})
+	require.Nil(t, err)
+	require.False(t, ok)
+}
@@ -241,7 +241,9 @@ func (k Keeper) applyEVMMessage(ctx sdk.Context, msg *core.Message, stateDB *sta
 	}
 	cfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
 	txCtx := core.NewEVMTxContext(msg)
-	evmInstance := vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{}, k.CustomPrecompiles(ctx))
+	// calls made back into the EVM from another VM are reported to the tracer of the
+	// outer EVM transaction, if it is traced
+	evmInstance := vm.NewEVM(*blockCtx, txCtx, stateDB, cfg, vm.Config{Tracer: types.GetNestedTracingHooks(ctx)}, k.CustomPrecompiles(ctx))
 	st := core.NewStateTransition(evmInstance, msg, &gp, true) // fee already charged in ante handler
 	return st.TransitionDb()
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/sei-protocol/sei-chain/utils"
+)
+
+// AutoRegisterNativePointer deploys a native pointer for denom if it does not have one yet and
+// the params allow automatic registration for it. The deployment is charged to the gas meter
+// of the operation that introduced the denom, but a failed deployment does not fail that
+// operation; failures are only logged.
+func (k *Keeper) AutoRegisterNativePointer(ctx sdk.Context, denom string) {
+	if !k.GetParams(ctx).NativePointerAutoRegistrationAllowed(denom) {
+		return
+	}
+	if _, _, exists := k.GetERC20NativePointer(ctx, denom); exists {
+		return
+	}
+	metadata := k.nativePointerMetadata(ctx, denom)
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.RunWithOneOffEVMInstance(
+		cacheCtx, func(e *vm.EVM) error {
+			_, err := k.UpsertERCNativePointer(cacheCtx, e, denom, metadata)
+			return err
+		}, func(step string, err string) {
+			ctx.Logger().Error(fmt.Sprintf("automatic native pointer registration for %s encountered error during (%s) due to (%s)", denom, step, err))
+		},
+	); err != nil {
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+func (k *Keeper) nativePointerMetadata(ctx sdk.Context, denom string) utils.ERCMetadata {
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return utils.ERCMetadataFromDenomMetadata(metadata)
+	}
+	// IBC denoms have no bank metadata, so their pointers are named after the base denom
+	name := denom
+	if strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		if path, err := k.transferKeeper.DenomPathFromHash(ctx, denom); err == nil {
+			name = ibctransfertypes.ParseDenomTrace(path).BaseDenom
+		}
+	}
+	return utils.ERCMetadata{Name: name, Symbol: name}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	tokenfactorytypes "github.com/sei-protocol/sei-chain/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoRegisterNativePointer(t *testing.T) {
+	a := testkeeper.EVMTestApp
+	k := &a.EvmKeeper
+	ctx, _ := a.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).CacheContext()
+	creator, _ := testkeeper.MockAddressPair()
+
+	// disabled by default
+	denom, err := a.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "disabled")
+	require.Nil(t, err)
+	_, _, exists := k.GetERC20NativePointer(ctx, denom)
+	require.False(t, exists)
+
+	params := k.GetParams(ctx)
+	params.AutoRegisterNativePointers = true
+	params.NativePointerDenylist = []string{"factory/" + creator.String() + "/denied"}
+	k.SetParams(ctx, params)
+
+	denom, err = a.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "auto")
+	require.Nil(t, err)
+	addr, _, exists := k.GetERC20NativePointer(ctx, denom)
+	require.True(t, exists)
+	res, err := k.QueryERCSingleOutput(ctx, "native", addr, "name")
+	require.Nil(t, err)
+	require.Equal(t, denom, res.(string))
+
+	denom, err = a.TokenFactoryKeeper.CreateDenom(ctx, creator.String(), "denied")
+	require.Nil(t, err)
+	_, _, exists = k.GetERC20NativePointer(ctx, denom)
+	require.False(t, exists)
+
+	// metadata set after the fact is picked up, and existing pointers are left alone
+	a.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base: "ucustom", Name: "custom", Symbol: "CUSTOM",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ucustom"}, {Denom: "custom", Exponent: 6, Aliases: []string{"Custom Token"}}},
+	})
+	k.AutoRegisterNativePointer(ctx, "ucustom")
+	addr, _, exists = k.GetERC20NativePointer(ctx, "ucustom")
+	require.True(t, exists)
+	res, err = k.QueryERCSingleOutput(ctx, "native", addr, "name")
+	require.Nil(t, err)
+	require.Equal(t, "Custom Token", res.(string))
+	res, err = k.QueryERCSingleOutput(ctx, "native", addr, "decimals")
+	require.Nil(t, err)
+	require.Equal(t, uint8(6), res.(uint8))
+	k.AutoRegisterNativePointer(ctx, "ucustom")
+	newAddr, _, _ := k.GetERC20NativePointer(ctx, "ucustom")
+	require.Equal(t, addr, newAddr)
+
+	params.NativePointerAllowlist = []string{"ibc/"}
+	k.SetParams(ctx, params)
+	k.AutoRegisterNativePointer(ctx, "uother")
+	_, _, exists = k.GetERC20NativePointer(ctx, "uother")
+	require.False(t, exists)
+}
+
+func TestAutoRegisterNativePointerGas(t *testing.T) {
+	a := testkeeper.EVMTestApp
+	k := &a.EvmKeeper
+	ctx, _ := a.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).CacheContext()
+	creator, _ := testkeeper.MockAddressPair()
+	createDenom := func(subdenom string) uint64 {
+		gasCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10_000_000))
+		_, err := a.TokenFactoryKeeper.CreateDenom(gasCtx, creator.String(), subdenom)
+		require.Nil(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+	gasWithout := createDenom("without")
+
+	params := k.GetParams(ctx)
+	params.AutoRegisterNativePointers = true
+	k.SetParams(ctx, params)
+
+	// the deployment is paid for by the denom creator
+	require.Greater(t, createDenom("with"), gasWithout)
+	_, _, exists := k.GetERC20NativePointer(ctx, "factory/"+creator.String()+"/with")
+	require.True(t, exists)
+
+	// a creator that cannot pay for the deployment cannot create the denom
+	lowGasCtx := ctx.WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, gasWithout+1000))
+	require.Panics(t, func() {
+		_, _ = a.TokenFactoryKeeper.CreateDenom(lowGasCtx, creator.String(), "lowgas")
+	})
+}
+
+func TestAutoRegisterNativePointerSkippedAtGenesis(t *testing.T) {
+	a := testkeeper.EVMTestApp
+	k := &a.EvmKeeper
+	ctx, _ := a.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now()).CacheContext()
+	creator, _ := testkeeper.MockAddressPair()
+	params := k.GetParams(ctx)
+	params.AutoRegisterNativePointers = true
+	k.SetParams(ctx, params)
+
+	denom := "factory/" + creator.String() + "/genesis"
+	genState := a.TokenFactoryKeeper.ExportGenesis(ctx)
+	genState.FactoryDenoms = append(genState.FactoryDenoms, tokenfactorytypes.GenesisDenom{
+		Denom:             denom,
+		AuthorityMetadata: tokenfactorytypes.DenomAuthorityMetadata{Admin: creator.String()},
+	})
+	a.TokenFactoryKeeper.InitGenesis(ctx, *genState)
+	_, found := a.BankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(t, found)
+	_, _, exists := k.GetERC20NativePointer(ctx, denom)
+	require.False(t, exists)
+}
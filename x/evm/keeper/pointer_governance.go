@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+var ErrPointerNotFound = sdkerrors.Wrap(sdkerrors.ErrNotFound, "pointer not found")
+
+// GetPointerHistory returns every registered version of the pointer of pointee, oldest first.
+// As in the pointer queries, pointerType is the type of the pointee.
+func (k *Keeper) GetPointerHistory(ctx sdk.Context, pointerType types.PointerType, pointee string) ([]types.PointerHistoryEntry, error) {
+	key, err := pointerRegistryKey(pointerType, pointee)
+	if err != nil {
+		return nil, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), key)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	res := []types.PointerHistoryEntry{}
+	for ; iter.Valid(); iter.Next() {
+		// the registry prefixes of different pointees can nest (e.g. denoms `a` and `ab`), so
+		// only keys that are exactly a version belong to pointee
+		if len(iter.Key()) != 2 {
+			continue
+		}
+		res = append(res, types.PointerHistoryEntry{
+			Pointer: formatPointer(pointerType, iter.Value()),
+			Version: uint32(binary.BigEndian.Uint16(iter.Key())),
+		})
+	}
+	return res, nil
+}
+
+// RemovePointer deletes every version of the pointer of pointee. Unless force is set, removal
+// is refused while the pointee may still have outstanding balances.
+func (k *Keeper) RemovePointer(ctx sdk.Context, pointerType types.PointerType, pointee string, force bool) error {
+	history, err := k.GetPointerHistory(ctx, pointerType, pointee)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return ErrPointerNotFound
+	}
+	if !force {
+		hasBalances, err := k.PointeeHasBalances(ctx, pointerType, pointee)
+		if err != nil {
+			return err
+		}
+		if hasBalances {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s pointee %s still has balances", pointerType, pointee)
+		}
+	}
+	key, _ := pointerRegistryKey(pointerType, pointee)
+	for _, entry := range history {
+		version := uint16(entry.Version)
+		k.deletePointerInfo(ctx, key, version)
+		// pointers retired by a migration may since have been registered for another pointee
+		reverseKey := types.PointerReverseRegistryKey(reverseRegistryAddress(pointerType, entry.Pointer))
+		if bytes.Equal(k.getPointerInfoAtVersion(ctx, reverseKey, version), pointeeBytes(pointerType, pointee)) {
+			k.deletePointerInfo(ctx, reverseKey, version)
+		}
+	}
+	latest := history[len(history)-1]
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerRemoved, sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, latest.Pointer), sdk.NewAttribute(types.AttributeKeyPointee, pointee),
+		sdk.NewAttribute(types.AttributeKeyPointerVersion, strconv.FormatUint(uint64(latest.Version), 10)),
+		sdk.NewAttribute(types.AttributeKeyForce, strconv.FormatBool(force))))
+	return nil
+}
+
+// MigratePointer repoints pointee to newPointer, an already deployed contract that is not a
+// pointer yet, under a version higher than the current one. newPointer must run the current
+// pointer artifact of pointerType and point to pointee. Older versions stay in the pointer
+// history but no longer resolve back to pointee. Holders need no migration of their own since
+// balances are always held by the pointee side.
+func (k *Keeper) MigratePointer(ctx sdk.Context, pointerType types.PointerType, pointee string, newPointer string, version uint16) error {
+	history, err := k.GetPointerHistory(ctx, pointerType, pointee)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		return ErrPointerNotFound
+	}
+	oldVersion := uint16(history[len(history)-1].Version)
+	oldPointer := history[len(history)-1].Pointer
+	if version <= oldVersion {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "new version %d must be higher than current version %d", version, oldVersion)
+	}
+	var newPointerBz []byte
+	switch pointerType {
+	case types.PointerType_ERC20, types.PointerType_ERC721, types.PointerType_ERC1155:
+		addr, err := sdk.AccAddressFromBech32(newPointer)
+		if err != nil {
+			return err
+		}
+		if k.wasmViewKeeper.GetContractInfo(ctx, addr) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s is not a contract", newPointer)
+		}
+		if k.cwAddressIsPointer(ctx, newPointer) {
+			return ErrorPointerToPointerNotAllowed
+		}
+		if err := k.verifyCWPointer(ctx, pointerType, pointee, addr); err != nil {
+			return err
+		}
+		newPointerBz = []byte(newPointer)
+	default:
+		addr := common.HexToAddress(newPointer)
+		if len(k.GetCode(ctx, addr)) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s is not a contract", newPointer)
+		}
+		if k.evmAddressIsPointer(ctx, addr) {
+			return ErrorPointerToPointerNotAllowed
+		}
+		if err := k.verifyEVMPointer(ctx, pointerType, pointee, addr); err != nil {
+			return err
+		}
+		newPointerBz = addr[:]
+	}
+	k.deletePointerInfo(ctx, types.PointerReverseRegistryKey(reverseRegistryAddress(pointerType, oldPointer)), oldVersion)
+	key, _ := pointerRegistryKey(pointerType, pointee)
+	if err := k.setPointerInfo(ctx, key, newPointerBz, version); err != nil {
+		return err
+	}
+	if err := k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress(newPointerBz)), pointeeBytes(pointerType, pointee), version); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerMigrated, sdk.NewAttribute(types.AttributeKeyPointerType, pointerType.String()),
+		sdk.NewAttribute(types.AttributeKeyOldPointer, oldPointer),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, formatPointer(pointerType, newPointerBz)),
+		sdk.NewAttribute(types.AttributeKeyPointee, pointee),
+		sdk.NewAttribute(types.AttributeKeyPointerVersion, strconv.FormatUint(uint64(version), 10))))
+	return nil
+}
+
+// verifyCWPointer checks that the CW contract at addr was instantiated from the stored
+// pointer code of pointerType and points to pointee.
+func (k *Keeper) verifyCWPointer(ctx sdk.Context, pointerType types.PointerType, pointee string, addr sdk.AccAddress) error {
+	codeID := k.GetStoredPointerCodeID(ctx, pointerType)
+	if info := k.wasmViewKeeper.GetContractInfo(ctx, addr); codeID == 0 || info.CodeID != codeID {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not run the %s pointer code", addr, pointerType)
+	}
+	var target string
+	if err := json.Unmarshal(k.wasmViewKeeper.QueryRaw(ctx, addr, []byte(cwPointeeKeys[pointerType])), &target); err != nil || !strings.EqualFold(target, pointee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not point to %s", addr, pointee)
+	}
+	return nil
+}
+
+// verifyEVMPointer checks that the code of the EVM contract at addr is the runtime code the
+// current pointer artifact of pointerType deploys for the contract's own pointee and
+// metadata, and that the pointee is pointee.
+func (k *Keeper) verifyEVMPointer(ctx sdk.Context, pointerType types.PointerType, pointee string, addr common.Address) error {
+	typ, ok := evmPointerArtifacts[pointerType]
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %s", pointerType)
+	}
+	// the candidate is queried and the expected code deployed on a throwaway branch of the
+	// state, with calls metered by the EVM alone so a broken candidate cannot exhaust ctx
+	cacheCtx, _ := ctx.CacheContext()
+	parsedABI := artifacts.GetParsedABI(typ)
+	var expected []byte
+	if err := k.RunWithOneOffEVMInstance(cacheCtx, func(e *vm.EVM) error {
+		moduleAddr := vm.AccountRef(k.GetEVMAddressOrDefault(cacheCtx, k.AccountKeeper().GetModuleAddress(types.ModuleName)))
+		query := func(method string) interface{} {
+			input, err := parsedABI.Pack(method)
+			if err != nil {
+				return nil
+			}
+			output, _, err := e.StaticCall(moduleAddr, addr, input, pointerVerificationGasLimit)
+			if err != nil {
+				return nil
+			}
+			res, err := parsedABI.Unpack(method, output)
+			if err != nil || len(res) != 1 {
+				return nil
+			}
+			return res[0]
+		}
+		if target, ok := query(evmPointeeGetters[pointerType]).(string); !ok || target != pointee {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not point to %s", addr.Hex(), pointee)
+		}
+		args := []interface{}{pointee, query("name"), query("symbol")}
+		if pointerType == types.PointerType_NATIVE {
+			args = append(args, query("decimals"))
+		}
+		ctorArgs, err := parsedABI.Pack("", args...)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not expose %s pointer metadata: %s", addr.Hex(), pointerType, err)
+		}
+		expected, _, err = e.GetDeploymentCode(moduleAddr, append(artifacts.GetBin(typ), ctorArgs...), pointerVerificationGasLimit, utils.Big0, addr)
+		return err
+	}, func(string, string) {}); err != nil {
+		return err
+	}
+	if crypto.Keccak256Hash(expected) != k.GetCodeHash(ctx, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not run the current %s pointer artifact", addr.Hex(), pointerType)
+	}
+	return nil
+}
+
+const pointerVerificationGasLimit = 10_000_000
+
+var evmPointerArtifacts = map[types.PointerType]string{
+	types.PointerType_NATIVE: "native",
+	types.PointerType_CW20:   "cw20",
+	types.PointerType_CW721:  "cw721",
+	types.PointerType_CW1155: "cw1155",
+}
+
+var evmPointeeGetters = map[types.PointerType]string{
+	types.PointerType_NATIVE: "denom",
+	types.PointerType_CW20:   "Cw20Address",
+	types.PointerType_CW721:  "Cw721Address",
+	types.PointerType_CW1155: "Cw1155Address",
+}
+
+// cwPointeeKeys are the raw storage keys under which CW pointer contracts keep their pointee
+var cwPointeeKeys = map[types.PointerType]string{
+	types.PointerType_ERC20:   "erc20_address",
+	types.PointerType_ERC721:  "erc721_address",
+	types.PointerType_ERC1155: "erc1155_address",
+}
+
+// PointeeHasBalances reports whether pointee may still have outstanding balances. Pointees
+// whose supply cannot be queried (CW1155, ERC721 and ERC1155) are assumed to have balances.
+func (k *Keeper) PointeeHasBalances(ctx sdk.Context, pointerType types.PointerType, pointee string) (bool, error) {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		return k.bankKeeper.GetSupply(ctx, pointee).Amount.IsPositive(), nil
+	case types.PointerType_CW20:
+		res := struct {
+			TotalSupply sdk.Int `json:"total_supply"`
+		}{}
+		if err := k.queryCWPointee(ctx, pointee, `{"token_info":{}}`, &res); err != nil {
+			return false, err
+		}
+		return res.TotalSupply.IsPositive(), nil
+	case types.PointerType_CW721:
+		res := struct {
+			Count uint64 `json:"count"`
+		}{}
+		if err := k.queryCWPointee(ctx, pointee, `{"num_tokens":{}}`, &res); err != nil {
+			return false, err
+		}
+		return res.Count > 0, nil
+	case types.PointerType_ERC20:
+		supply, err := k.QueryERCSingleOutput(ctx, "cw20", common.HexToAddress(pointee), "totalSupply")
+		if err != nil {
+			return false, err
+		}
+		s, ok := supply.(*big.Int)
+		if !ok {
+			return false, fmt.Errorf("unexpected totalSupply output %v", supply)
+		}
+		return s.Sign() > 0, nil
+	default:
+		return true, nil
+	}
+}
+
+func (k *Keeper) getPointerInfoAtVersion(ctx sdk.Context, pref []byte, version uint16) []byte {
+	versionBz := make([]byte, 2)
+	binary.BigEndian.PutUint16(versionBz, version)
+	return prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pref).Get(versionBz)
+}
+
+func (k *Keeper) queryCWPointee(ctx sdk.Context, pointee string, query string, res interface{}) error {
+	addr, err := sdk.AccAddressFromBech32(pointee)
+	if err != nil {
+		return err
+	}
+	bz, err := k.wasmViewKeeper.QuerySmart(ctx, addr, []byte(query))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, res)
+}
+
+func pointerRegistryKey(pointerType types.PointerType, pointee string) ([]byte, error) {
+	switch pointerType {
+	case types.PointerType_NATIVE:
+		return types.PointerERC20NativeKey(pointee), nil
+	case types.PointerType_CW20:
+		return types.PointerERC20CW20Key(pointee), nil
+	case types.PointerType_CW721:
+		return types.PointerERC721CW721Key(pointee), nil
+	case types.PointerType_CW1155:
+		return types.PointerERC1155CW1155Key(pointee), nil
+	case types.PointerType_ERC20:
+		return types.PointerCW20ERC20Key(common.HexToAddress(pointee)), nil
+	case types.PointerType_ERC721:
+		return types.PointerCW721ERC721Key(common.HexToAddress(pointee)), nil
+	case types.PointerType_ERC1155:
+		return types.PointerCW1155ERC1155Key(common.HexToAddress(pointee)), nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown pointer type %s", pointerType)
+	}
+}
+
+// pointers of ERC pointees are CW contracts stored as bech32 strings; all others are EVM
+// contracts stored as raw addresses
+func isCWPointer(pointerType types.PointerType) bool {
+	return pointerType == types.PointerType_ERC20 || pointerType == types.PointerType_ERC721 || pointerType == types.PointerType_ERC1155
+}
+
+func formatPointer(pointerType types.PointerType, bz []byte) string {
+	if isCWPointer(pointerType) {
+		return string(bz)
+	}
+	return common.BytesToAddress(bz).Hex()
+}
+
+func reverseRegistryAddress(pointerType types.PointerType, pointer string) common.Address {
+	if isCWPointer(pointerType) {
+		return common.BytesToAddress([]byte(pointer))
+	}
+	return common.HexToAddress(pointer)
+}
+
+func pointeeBytes(pointerType types.PointerType, pointee string) []byte {
+	if isCWPointer(pointerType) {
+		return common.HexToAddress(pointee).Bytes()
+	}
+	return []byte(pointee)
+}
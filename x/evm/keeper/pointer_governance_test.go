@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts"
+	"github.com/sei-protocol/sei-chain/x/evm/artifacts/native"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+func TestMigrateAndRemovePointer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{})
+	deploy := func(denom string) {
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+			_, err := k.UpsertERCNativePointer(ctx, e, denom, utils.ERCMetadata{Name: denom, Symbol: denom})
+			return err
+		}, func(string, string) {}))
+	}
+	deploy("ugovpointera")
+	deploy("ugovpointerb")
+	oldPointer, _, _ := k.GetERC20NativePointer(ctx, "ugovpointera")
+	otherPointer, _, _ := k.GetERC20NativePointer(ctx, "ugovpointerb")
+
+	history, err := k.GetPointerHistory(ctx, types.PointerType_NATIVE, "ugovpointera")
+	require.Nil(t, err)
+	require.Equal(t, []types.PointerHistoryEntry{{Pointer: oldPointer.Hex(), Version: uint32(native.CurrentVersion)}}, history)
+
+	// migration targets are deployed from the pointer artifact without being registered
+	deployArtifact := func(denom string) (addr common.Address) {
+		bin, err := artifacts.GetParsedABI("native").Pack("", denom, denom, denom, uint8(0))
+		require.Nil(t, err)
+		require.Nil(t, k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+			moduleAddr := k.GetEVMAddressOrDefault(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName))
+			_, addr, _, err = e.Create(vm.AccountRef(moduleAddr), append(artifacts.GetBin("native"), bin...), 10000000, utils.Big0)
+			return err
+		}, func(string, string) {}))
+		return addr
+	}
+	_, missing := testkeeper.MockAddressPair()
+	require.NotNil(t, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", missing.Hex(), native.CurrentVersion+1))
+	_, arbitrary := testkeeper.MockAddressPair()
+	k.SetCode(ctx, arbitrary, []byte{1})
+	require.NotNil(t, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", arbitrary.Hex(), native.CurrentVersion+1))
+	wrongPointee := deployArtifact("ugovpointerc")
+	require.NotNil(t, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", wrongPointee.Hex(), native.CurrentVersion+1))
+	newPointer := deployArtifact("ugovpointera")
+	require.NotNil(t, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", newPointer.Hex(), native.CurrentVersion))
+	require.Equal(t, evmkeeper.ErrorPointerToPointerNotAllowed, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", otherPointer.Hex(), native.CurrentVersion+1))
+	require.Equal(t, evmkeeper.ErrPointerNotFound, k.MigratePointer(ctx, types.PointerType_NATIVE, "umissing", newPointer.Hex(), native.CurrentVersion+1))
+
+	require.Nil(t, k.MigratePointer(ctx, types.PointerType_NATIVE, "ugovpointera", newPointer.Hex(), native.CurrentVersion+1))
+	pointer, version, exists := k.GetERC20NativePointer(ctx, "ugovpointera")
+	require.True(t, exists)
+	require.Equal(t, newPointer, pointer)
+	require.Equal(t, native.CurrentVersion+1, version)
+	_, _, exists = k.GetNativePointee(ctx, oldPointer.Hex())
+	require.False(t, exists)
+	pointee, _, exists := k.GetNativePointee(ctx, newPointer.Hex())
+	require.True(t, exists)
+	require.Equal(t, "ugovpointera", pointee)
+	history, err = k.GetPointerHistory(ctx, types.PointerType_NATIVE, "ugovpointera")
+	require.Nil(t, err)
+	require.Equal(t, []types.PointerHistoryEntry{
+		{Pointer: oldPointer.Hex(), Version: uint32(native.CurrentVersion)},
+		{Pointer: newPointer.Hex(), Version: uint32(native.CurrentVersion + 1)},
+	}, history)
+
+	// removal is refused while the denom has supply unless forced
+	amt := sdk.NewCoins(sdk.NewCoin("ugovpointera", sdk.NewInt(10)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.NotNil(t, k.RemovePointer(ctx, types.PointerType_NATIVE, "ugovpointera", false))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, k.RemovePointer(ctx, types.PointerType_NATIVE, "ugovpointera", true))
+	_, _, exists = k.GetERC20NativePointer(ctx, "ugovpointera")
+	require.False(t, exists)
+	_, _, exists = k.GetNativePointee(ctx, newPointer.Hex())
+	require.False(t, exists)
+	history, err = k.GetPointerHistory(ctx, types.PointerType_NATIVE, "ugovpointera")
+	require.Nil(t, err)
+	require.Empty(t, history)
+	require.Equal(t, types.EventTypePointerRemoved, ctx.EventManager().Events()[0].Type)
+	require.Equal(t, evmkeeper.ErrPointerNotFound, k.RemovePointer(ctx, types.PointerType_NATIVE, "ugovpointera", true))
+
+	// denoms without supply can be removed without force
+	require.Nil(t, k.RemovePointer(ctx, types.PointerType_NATIVE, "ugovpointerb", false))
+	_, _, exists = k.GetERC20NativePointer(ctx, "ugovpointerb")
+	require.False(t, exists)
+}
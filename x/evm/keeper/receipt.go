@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -122,6 +123,34 @@ func (k *Keeper) FlushTransientReceipts(ctx sdk.Context) error {
 	for ; iter.Valid(); iter.Next() {
 		kvPair := &iavl.KVPair{Key: types.ReceiptKey(common.Hash(iter.Key())), Value: iter.Value()}
 		pairs = append(pairs, kvPair)
+		if k.logIndexEnabled || k.txAddressIndexEnabled || k.accountHistoryIndexEnabled {
+			receipt := &types.Receipt{}
+			if err := receipt.Unmarshal(iter.Value()); err != nil {
+				return err
+			}
+			if k.logIndexEnabled {
+				pairs = append(pairs, LogIndexPairs(receipt)...)
+			}
+			if k.txAddressIndexEnabled {
+				pairs = append(pairs, TxAddressIndexPairs(receipt)...)
+			}
+			if k.accountHistoryIndexEnabled {
+				pairs = append(pairs, AccountHistoryPairs(receipt)...)
+			}
+		}
+	}
+	if k.accountHistoryIndexEnabled {
+		pairs = append(pairs, k.transientAccountHistoryPairs(ctx)...)
+	}
+	if k.logIndexEnabled && !k.logIndexStartRecorded {
+		recordStart, err := k.shouldRecordLogIndexStart()
+		if err != nil {
+			return err
+		}
+		if recordStart {
+			pairs = append(pairs, LogIndexStartHeightPair(uint64(ctx.BlockHeight())))
+		}
+		k.logIndexStartRecorded = true
 	}
 	if len(pairs) == 0 {
 		return nil
@@ -132,7 +161,42 @@ func (k *Keeper) FlushTransientReceipts(ctx sdk.Context) error {
 	}
 	changesets = append(changesets, ncs)
 
-	return k.receiptStore.ApplyChangesetAsync(ctx.BlockHeight(), changesets)
+	return k.applyReceiptChangesetsAsync(ctx.BlockHeight(), changesets)
+}
+
+// receiptWrites tracks the highest version queued for the receipt store. Applying a queued
+// changeset sets the store's latest version, so writes that are not tied to a block must
+// be queued at this version to not move the latest version back.
+type receiptWrites struct {
+	mu      sync.Mutex
+	version int64
+}
+
+// applyReceiptChangesetsAsync queues changesets of the block at height for the receipt store.
+func (k *Keeper) applyReceiptChangesetsAsync(height int64, changesets []*proto.NamedChangeSet) error {
+	w := k.receiptWrites
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if height > w.version {
+		w.version = height
+	}
+	return k.receiptStore.ApplyChangesetAsync(height, changesets)
+}
+
+// applyReceiptChangesetsAtLatestAsync queues changesets that are not tied to a block for the
+// receipt store, at the latest version queued or written so far.
+func (k *Keeper) applyReceiptChangesetsAtLatestAsync(changesets []*proto.NamedChangeSet) error {
+	w := k.receiptWrites
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	latest, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if latest > w.version {
+		w.version = latest
+	}
+	return k.receiptStore.ApplyChangesetAsync(w.version, changesets)
 }
 
 func (k *Keeper) WriteReceipt(
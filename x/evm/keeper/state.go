@@ -42,3 +42,15 @@ func (k *Keeper) IterateState(ctx sdk.Context, cb func(addr common.Address, key
 		}
 	}
 }
+
+// IterateStorage calls cb with the storage slots of addr in key order, starting from the
+// first slot that is not less than start. Iteration stops when cb returns true.
+func (k *Keeper) IterateStorage(ctx sdk.Context, addr common.Address, start []byte, cb func(key common.Hash, val common.Hash) bool) {
+	iter := k.PrefixStore(ctx, types.StateKey(addr)).Iterator(start, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(common.BytesToHash(iter.Key()), common.BytesToHash(iter.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-db/proto"
+)
+
+// SetStateDiffIndexEnabled toggles whether the accounts modified by each block are written
+// to the receipt store when the block is committed.
+func (k *Keeper) SetStateDiffIndexEnabled(enabled bool) {
+	k.stateDiffIndexEnabled = enabled
+}
+
+func (k *Keeper) StateDiffIndexEnabled() bool {
+	return k.stateDiffIndexEnabled
+}
+
+// ModifiedAccountPairs returns the state diff index entries of a block, sorted by key.
+// Each account modified by the block maps to the storage slots the block modified.
+func ModifiedAccountPairs(height uint64, modified map[common.Address][]common.Hash) []*iavl.KVPair {
+	pairs := make([]*iavl.KVPair, 0, len(modified))
+	for addr, slots := range modified {
+		sorted := append([]common.Hash{}, slots...)
+		sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i][:], sorted[j][:]) < 0 })
+		value := make([]byte, 0, len(sorted)*common.HashLength)
+		for _, slot := range sorted {
+			value = append(value, slot[:]...)
+		}
+		pairs = append(pairs, &iavl.KVPair{Key: types.ModifiedAccountKey(height, addr), Value: value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0 })
+	return pairs
+}
+
+// SetModifiedAccounts writes the accounts and storage slots modified by the block at
+// height to the receipt store. It is a no-op if the state diff index is disabled.
+func (k *Keeper) SetModifiedAccounts(height int64, modified map[common.Address][]common.Hash) error {
+	if !k.stateDiffIndexEnabled || len(modified) == 0 {
+		return nil
+	}
+	return k.applyReceiptChangesetsAsync(height, []*proto.NamedChangeSet{{
+		Name:      types.ReceiptStoreKey,
+		Changeset: iavl.ChangeSet{Pairs: ModifiedAccountPairs(uint64(height), modified)},
+	}})
+}
+
+// IterateModifiedAccounts calls cb with the accounts modified by each block from start to
+// end, both inclusive, in chain order, along with the storage slots the block modified.
+// Iteration stops when cb returns false. The first return value is false if the index is
+// disabled.
+func (k *Keeper) IterateModifiedAccounts(start uint64, end uint64, cb func(height uint64, addr common.Address, slots []common.Hash) bool) (bool, error) {
+	if !k.stateDiffIndexEnabled {
+		return false, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	iter, err := k.receiptStore.Iterator(types.ReceiptStoreKey, lv, types.ModifiedAccountHeightPrefix(start), types.ModifiedAccountHeightPrefix(end+1))
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		height, addr := types.ModifiedAccountKeyPosition(iter.Key())
+		value := iter.Value()
+		slots := make([]common.Hash, 0, len(value)/common.HashLength)
+		for i := 0; i+common.HashLength <= len(value); i += common.HashLength {
+			slots = append(slots, common.BytesToHash(value[i:i+common.HashLength]))
+		}
+		if !cb(height, addr, slots) {
+			break
+		}
+	}
+	return true, nil
+}
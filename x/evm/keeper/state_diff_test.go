@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModifiedAccountPairs(t *testing.T) {
+	a, b := common.HexToAddress("0xa"), common.HexToAddress("0xb")
+	pairs := keeper.ModifiedAccountPairs(7, map[common.Address][]common.Hash{
+		b: {},
+		a: {common.HexToHash("0x2"), common.HexToHash("0x1")},
+	})
+	require.Len(t, pairs, 2)
+	require.Equal(t, types.ModifiedAccountKey(7, a), pairs[0].Key)
+	require.Equal(t, append(common.HexToHash("0x1").Bytes(), common.HexToHash("0x2").Bytes()...), pairs[0].Value)
+	require.Equal(t, types.ModifiedAccountKey(7, b), pairs[1].Key)
+	require.Empty(t, pairs[1].Value)
+	height, addr := types.ModifiedAccountKeyPosition(pairs[1].Key)
+	require.Equal(t, uint64(7), height)
+	require.Equal(t, b, addr)
+}
+
+func TestIterateModifiedAccounts(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	a, b := common.HexToAddress("0xd1ff1"), common.HexToAddress("0xd1ff2")
+	type entry struct {
+		height uint64
+		addr   common.Address
+		slots  []common.Hash
+	}
+	collect := func(start, end uint64) []entry {
+		entries := []entry{}
+		ok, err := k.IterateModifiedAccounts(start, end, func(height uint64, addr common.Address, slots []common.Hash) bool {
+			if addr == a || addr == b {
+				entries = append(entries, entry{height, addr, slots})
+			}
+			return true
+		})
+		require.Nil(t, err)
+		require.True(t, ok)
+		return entries
+	}
+
+	ok, err := k.IterateModifiedAccounts(0, 100, func(uint64, common.Address, []common.Hash) bool { return true })
+	require.Nil(t, err)
+	require.False(t, ok) // index disabled
+
+	k.SetStateDiffIndexEnabled(true)
+	defer k.SetStateDiffIndexEnabled(false)
+	require.Nil(t, k.SetModifiedAccounts(42, map[common.Address][]common.Hash{a: {common.HexToHash("0x1")}, b: {}}))
+
+	expected := []entry{
+		{42, a, []common.Hash{common.HexToHash("0x1")}},
+		{42, b, []common.Hash{}},
+	}
+	require.Equal(t, expected, collect(42, 42))
+	require.Equal(t, expected, collect(40, 45))
+	require.Empty(t, collect(43, 50))
+}
@@ -21,3 +21,26 @@ func TestState(t *testing.T) {
 	got := k.GetState(ctx, addr, common.HexToHash("0xabc"))
 	require.Equal(t, common.HexToHash("0xdef"), got)
 }
+
+func TestIterateStorage(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{})
+	_, addr := testkeeper.MockAddressPair()
+	_, other := testkeeper.MockAddressPair()
+	for _, slot := range []string{"0x1", "0x2", "0x3"} {
+		k.SetState(ctx, addr, common.HexToHash(slot), common.HexToHash(slot))
+	}
+	k.SetState(ctx, other, common.HexToHash("0x4"), common.HexToHash("0x4"))
+
+	collect := func(start []byte, limit int) []common.Hash {
+		keys := []common.Hash{}
+		k.IterateStorage(ctx, addr, start, func(key common.Hash, val common.Hash) bool {
+			require.Equal(t, key, val)
+			keys = append(keys, key)
+			return len(keys) == limit
+		})
+		return keys
+	}
+	require.Equal(t, []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x3")}, collect(nil, 10))
+	require.Equal(t, []common.Hash{common.HexToHash("0x2")}, collect(common.HexToHash("0x2").Bytes(), 1))
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/iavl"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// TxAddressIndexEntry points to a transaction that an address took part in.
+type TxAddressIndexEntry struct {
+	Height  uint64
+	TxIndex uint32
+	TxHash  common.Hash
+}
+
+// SetTxAddressIndexEnabled toggles whether transaction address index entries are written
+// alongside receipts when they are flushed to the receipt store.
+func (k *Keeper) SetTxAddressIndexEnabled(enabled bool) {
+	k.txAddressIndexEnabled = enabled
+}
+
+func (k *Keeper) TxAddressIndexEnabled() bool {
+	return k.txAddressIndexEnabled
+}
+
+// TxAddressIndexPairs returns the transaction address index entries of a receipt. The
+// transaction is indexed by its sender, its recipient and the contract it created, and
+// each entry points to the transaction hash. Addresses that only take part in internal
+// calls are not indexed.
+func TxAddressIndexPairs(receipt *types.Receipt) []*iavl.KVPair {
+	txHash := common.HexToHash(receipt.TxHashHex)
+	pairs := []*iavl.KVPair{}
+	seen := map[common.Address]struct{}{}
+	for _, hex := range []string{receipt.From, receipt.To, receipt.ContractAddress} {
+		if hex == "" {
+			continue
+		}
+		addr := common.HexToAddress(hex)
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+		pairs = append(pairs, &iavl.KVPair{
+			Key:   types.TxAddressIndexKey(addr, receipt.BlockNumber, receipt.TransactionIndex),
+			Value: txHash[:],
+		})
+	}
+	return pairs
+}
+
+// IterateTxAddressIndex calls cb with the indexed transactions of addr, in chain order
+// starting from fromHeight, or in reverse chain order starting from toHeight if reverse
+// is set. Only transactions within [fromHeight, toHeight] are visited, and iteration
+// stops when cb returns false. The second return value is false if the index is disabled.
+func (k *Keeper) IterateTxAddressIndex(_ sdk.Context, addr common.Address, fromHeight, toHeight uint64, reverse bool, cb func(TxAddressIndexEntry) bool) (bool, error) {
+	if !k.txAddressIndexEnabled {
+		return false, nil
+	}
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return false, err
+	}
+	start := types.TxAddressIndexKey(addr, fromHeight, 0)
+	end := sdk.PrefixEndBytes(types.TxAddressIndexAddressPrefix(addr))
+	if toHeight < ^uint64(0) {
+		end = types.TxAddressIndexKey(addr, toHeight+1, 0)
+	}
+	iterate := k.receiptStore.Iterator
+	if reverse {
+		iterate = k.receiptStore.ReverseIterator
+	}
+	iter, err := iterate(types.ReceiptStoreKey, lv, start, end)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		height, txIndex := types.TxAddressIndexKeyPosition(iter.Key())
+		if !cb(TxAddressIndexEntry{Height: height, TxIndex: txIndex, TxHash: common.BytesToHash(iter.Value())}) {
+			break
+		}
+	}
+	return true, nil
+}
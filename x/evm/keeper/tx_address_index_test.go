@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxAddressIndexPairs(t *testing.T) {
+	txHash := common.HexToHash("0xabc")
+	from, contract := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	receipt := &types.Receipt{
+		TxHashHex:        txHash.Hex(),
+		BlockNumber:      5,
+		TransactionIndex: 2,
+		From:             from.Hex(),
+		ContractAddress:  contract.Hex(),
+	}
+	pairs := keeper.TxAddressIndexPairs(receipt)
+	require.Len(t, pairs, 2)
+	require.Equal(t, types.TxAddressIndexKey(from, 5, 2), pairs[0].Key)
+	require.Equal(t, types.TxAddressIndexKey(contract, 5, 2), pairs[1].Key)
+	height, txIndex := types.TxAddressIndexKeyPosition(pairs[1].Key)
+	require.Equal(t, uint64(5), height)
+	require.Equal(t, uint32(2), txIndex)
+	for _, pair := range pairs {
+		require.Equal(t, txHash[:], pair.Value)
+	}
+
+	// self transfers are indexed once
+	receipt.To, receipt.ContractAddress = from.Hex(), ""
+	require.Len(t, keeper.TxAddressIndexPairs(receipt), 1)
+}
+
+func TestIterateTxAddressIndex(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(30)
+	addr1, addr2 := common.HexToAddress("0x1234"), common.HexToAddress("0x5678")
+	collect := func(from, to uint64, reverse bool) []uint64 {
+		heights := []uint64{}
+		ok, err := k.IterateTxAddressIndex(ctx, addr1, from, to, reverse, func(e keeper.TxAddressIndexEntry) bool {
+			require.Equal(t, common.BytesToHash([]byte{byte(e.Height)}), e.TxHash)
+			heights = append(heights, e.Height)
+			return true
+		})
+		require.Nil(t, err)
+		require.True(t, ok)
+		return heights
+	}
+
+	ok, err := k.IterateTxAddressIndex(ctx, addr1, 0, 100, false, func(keeper.TxAddressIndexEntry) bool { return true })
+	require.Nil(t, err)
+	require.False(t, ok) // index disabled
+
+	k.SetTxAddressIndexEnabled(true)
+	defer k.SetTxAddressIndexEnabled(false)
+	for i, height := range []uint64{10, 20, 30} {
+		to := addr1
+		if i == 1 {
+			to = addr2
+		}
+		txHash := common.BytesToHash([]byte{byte(height)})
+		require.Nil(t, k.SetTransientReceipt(ctx, txHash, &types.Receipt{
+			TxHashHex:   txHash.Hex(),
+			BlockNumber: height,
+			From:        common.HexToAddress("0x9999").Hex(),
+			To:          to.Hex(),
+		}))
+	}
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+
+	require.Equal(t, []uint64{10, 30}, collect(0, ^uint64(0), false))
+	require.Equal(t, []uint64{30, 10}, collect(0, ^uint64(0), true))
+	require.Equal(t, []uint64{10}, collect(0, 29, true))
+	require.Equal(t, []uint64{30}, collect(11, 30, false))
+
+	// iteration stops when the callback returns false
+	visited := 0
+	_, err = k.IterateTxAddressIndex(ctx, addr1, 0, ^uint64(0), false, func(keeper.TxAddressIndexEntry) bool {
+		visited++
+		return false
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, visited)
+}
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+func MigrateFeeMarketParams(ctx sdk.Context, k *keeper.Keeper) error {
+	keeperParams := k.GetParamsIfExists(ctx)
+	defaultParams := types.DefaultParams()
+	keeperParams.BaseFeeAdjustmentCurve = defaultParams.BaseFeeAdjustmentCurve
+	keeperParams.BaseFeeHistorySize = defaultParams.BaseFeeHistorySize
+	keeperParams.ExponentialBaseFeeUpdateFraction = defaultParams.ExponentialBaseFeeUpdateFraction
+	keeperParams.PidProportionalGain = defaultParams.PidProportionalGain
+	keeperParams.PidIntegralGain = defaultParams.PidIntegralGain
+	keeperParams.PidDerivativeGain = defaultParams.PidDerivativeGain
+	k.SetParams(ctx, keeperParams)
+	return nil
+}
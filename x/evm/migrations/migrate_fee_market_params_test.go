@@ -0,0 +1,40 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateFeeMarketParams(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+
+	keeperParams := k.GetParams(ctx)
+	keeperParams.MaximumFeePerGas = sdk.NewDec(123)
+	keeperParams.BaseFeeAdjustmentCurve = types.BaseFeeAdjustmentCurve_PID
+	keeperParams.BaseFeeHistorySize = 1
+	keeperParams.ExponentialBaseFeeUpdateFraction = 1
+	keeperParams.PidProportionalGain = sdk.OneDec()
+	k.SetParams(ctx, keeperParams)
+
+	// Perform the migration
+	err := migrations.MigrateFeeMarketParams(ctx, &k)
+	require.NoError(t, err)
+
+	// Ensure that the new fee market parameters were migrated and the old ones were not changed
+	migrated := k.GetParams(ctx)
+	require.Equal(t, sdk.NewDec(123), migrated.MaximumFeePerGas)
+	require.Equal(t, types.DefaultParams().BaseFeeAdjustmentCurve, migrated.BaseFeeAdjustmentCurve)
+	require.Equal(t, types.DefaultParams().BaseFeeHistorySize, migrated.BaseFeeHistorySize)
+	require.Equal(t, types.DefaultParams().ExponentialBaseFeeUpdateFraction, migrated.ExponentialBaseFeeUpdateFraction)
+	require.Equal(t, types.DefaultParams().PidProportionalGain, migrated.PidProportionalGain)
+	require.Equal(t, types.DefaultParams().PidIntegralGain, migrated.PidIntegralGain)
+	require.Equal(t, types.DefaultParams().PidDerivativeGain, migrated.PidDerivativeGain)
+	k.SetParams(ctx, types.DefaultParams())
+}
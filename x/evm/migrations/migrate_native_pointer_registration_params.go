@@ -0,0 +1,17 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+func MigrateNativePointerRegistrationParams(ctx sdk.Context, k *keeper.Keeper) error {
+	keeperParams := k.GetParamsIfExists(ctx)
+	defaultParams := types.DefaultParams()
+	keeperParams.AutoRegisterNativePointers = defaultParams.AutoRegisterNativePointers
+	keeperParams.NativePointerAllowlist = defaultParams.NativePointerAllowlist
+	keeperParams.NativePointerDenylist = defaultParams.NativePointerDenylist
+	k.SetParams(ctx, keeperParams)
+	return nil
+}
@@ -0,0 +1,36 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateNativePointerRegistrationParams(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+
+	keeperParams := k.GetParams(ctx)
+	keeperParams.MaximumFeePerGas = sdk.NewDec(123)
+	keeperParams.AutoRegisterNativePointers = true
+	keeperParams.NativePointerAllowlist = []string{"factory/"}
+	keeperParams.NativePointerDenylist = []string{"ibc/"}
+	k.SetParams(ctx, keeperParams)
+
+	// Perform the migration
+	err := migrations.MigrateNativePointerRegistrationParams(ctx, &k)
+	require.NoError(t, err)
+
+	// Ensure that the new registration parameters were migrated and the old ones were not changed
+	migrated := k.GetParams(ctx)
+	require.Equal(t, sdk.NewDec(123), migrated.MaximumFeePerGas)
+	require.Equal(t, types.DefaultParams().AutoRegisterNativePointers, migrated.AutoRegisterNativePointers)
+	require.Empty(t, migrated.NativePointerAllowlist)
+	require.Empty(t, migrated.NativePointerDenylist)
+	k.SetParams(ctx, types.DefaultParams())
+}
@@ -0,0 +1,74 @@
+// Package proof defines the account and storage proofs returned by eth_getProof and a
+// verifier that checks them against a block's app hash.
+//
+// Sei does not keep its EVM state in a Merkle-Patricia trie, so every proof has two layers:
+//
+//  1. Ethereum-compatible proofs. AccountProof is a Merkle-Patricia proof of the account
+//     against StateRoot and every StorageProof is a Merkle-Patricia proof of the slot against
+//     StorageHash, so both can be checked with standard Ethereum libraries. StorageHash is the
+//     root of a storage trie built from all slots of the account at the queried height, and
+//     StateRoot is the root of a trie that only holds the queried account.
+//  2. Commitment proofs. Every value the Ethereum layer is built from (the address
+//     association, nonce, code hash, balance and storage slots) comes with an ICS23 proof
+//     from the application's multistore, which commits to the app hash.
+//
+// VerifyAccountResult checks both layers and that they agree with each other.
+package proof
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+const (
+	EVMStoreName  = "evm"
+	BankStoreName = "bank"
+
+	// BaseDenom is the denom whose balance makes up the EVM balance of an account.
+	BaseDenom = "usei"
+)
+
+// AccountResult is the result of eth_getProof. The first group of fields follows the
+// go-ethereum AccountResult; the rest binds the result to the app hash.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []hexutil.Bytes `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+
+	// Height is the height of the state the proof was generated from. Because the app hash
+	// in a block header commits to the state of the previous height, the proofs verify
+	// against the app hash of block Height+1.
+	Height           hexutil.Uint64          `json:"height"`
+	StateRoot        common.Hash             `json:"stateRoot"`
+	CommitmentProofs AccountCommitmentProofs `json:"commitmentProofs"`
+}
+
+type StorageResult struct {
+	Key             common.Hash     `json:"key"`
+	Value           *hexutil.Big    `json:"value"`
+	Proof           []hexutil.Bytes `json:"proof"`
+	CommitmentProof *StoreProof     `json:"commitmentProof"`
+}
+
+// AccountCommitmentProofs holds the multistore proofs of the values an account is built
+// from. A proof with an empty value proves the absence of its key.
+type AccountCommitmentProofs struct {
+	SeiAddress *StoreProof `json:"seiAddress"`
+	Nonce      *StoreProof `json:"nonce"`
+	CodeHash   *StoreProof `json:"codeHash"`
+	Balance    *StoreProof `json:"balance"`
+	WeiBalance *StoreProof `json:"weiBalance"`
+}
+
+// StoreProof is a proof of a key in one of the application's stores.
+type StoreProof struct {
+	StoreName string           `json:"storeName"`
+	Key       hexutil.Bytes    `json:"key"`
+	Value     hexutil.Bytes    `json:"value"`
+	ProofOps  *crypto.ProofOps `json:"proofOps"`
+}
@@ -0,0 +1,82 @@
+package proof
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// NewTrie returns an empty in-memory Merkle-Patricia trie.
+func NewTrie() *trie.Trie {
+	return trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+}
+
+// StorageTrieKey returns the key of a storage slot in a storage trie.
+func StorageTrieKey(slot common.Hash) []byte {
+	return crypto.Keccak256(slot[:])
+}
+
+// StorageTrieValue returns the RLP encoded value of a storage slot as it is stored in a
+// storage trie. Zero values are not stored in the trie and encode to nil.
+func StorageTrieValue(value common.Hash) ([]byte, error) {
+	trimmed := common.TrimLeftZeroes(value[:])
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+	return rlp.EncodeToBytes(trimmed)
+}
+
+// AccountTrieKey returns the key of an account in a state trie.
+func AccountTrieKey(address common.Address) []byte {
+	return crypto.Keccak256(address[:])
+}
+
+// AccountTrieValue returns the RLP encoded account as it is stored in a state trie.
+func AccountTrieValue(nonce uint64, balance *big.Int, storageHash common.Hash, codeHash common.Hash) ([]byte, error) {
+	return rlp.EncodeToBytes(&ethtypes.StateAccount{
+		Nonce:    nonce,
+		Balance:  balance,
+		Root:     storageHash,
+		CodeHash: codeHash[:],
+	})
+}
+
+// Prove returns the nodes proving the given key (or its absence) in the trie.
+func Prove(t *trie.Trie, key []byte) ([]hexutil.Bytes, error) {
+	var list nodeList
+	if err := t.Prove(key, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// VerifyTrieProof checks a proof produced by Prove against the trie root and returns the
+// value of the key, or nil if the proof shows that the key is absent.
+func VerifyTrieProof(root common.Hash, key []byte, nodes []hexutil.Bytes) ([]byte, error) {
+	db := memorydb.New()
+	for _, node := range nodes {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	return trie.VerifyProof(root, key, db)
+}
+
+// nodeList collects the nodes written by trie.Prove in order.
+type nodeList []hexutil.Bytes
+
+func (n *nodeList) Put(_ []byte, value []byte) error {
+	*n = append(*n, common.CopyBytes(value))
+	return nil
+}
+
+func (n *nodeList) Delete([]byte) error {
+	panic("not supported")
+}
@@ -0,0 +1,45 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/x/evm/proof"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+func TestTrieProof(t *testing.T) {
+	tr := proof.NewTrie()
+	slot, value := common.HexToHash("0x1"), common.HexToHash("0x2")
+	encoded, err := proof.StorageTrieValue(value)
+	require.Nil(t, err)
+	require.Nil(t, tr.Update(proof.StorageTrieKey(slot), encoded))
+	root := tr.Hash()
+
+	nodes, err := proof.Prove(tr, proof.StorageTrieKey(slot))
+	require.Nil(t, err)
+	proven, err := proof.VerifyTrieProof(root, proof.StorageTrieKey(slot), nodes)
+	require.Nil(t, err)
+	require.Equal(t, encoded, proven)
+
+	// absent keys are proven to be absent
+	absent := proof.StorageTrieKey(common.HexToHash("0x3"))
+	nodes, err = proof.Prove(tr, absent)
+	require.Nil(t, err)
+	proven, err = proof.VerifyTrieProof(root, absent, nodes)
+	require.Nil(t, err)
+	require.Nil(t, proven)
+
+	// zero values are not stored
+	encoded, err = proof.StorageTrieValue(common.Hash{})
+	require.Nil(t, err)
+	require.Nil(t, encoded)
+}
+
+func TestVerifyStoreProofWrongKey(t *testing.T) {
+	err := proof.VerifyStoreProof([]byte("apphash"), &proof.StoreProof{StoreName: proof.EVMStoreName, Key: []byte{1}, ProofOps: &crypto.ProofOps{}}, proof.EVMStoreName, []byte{2})
+	require.Contains(t, err.Error(), "expected key 02")
+	err = proof.VerifyStoreProof([]byte("apphash"), nil, proof.EVMStoreName, []byte{2})
+	require.NotNil(t, err)
+}
@@ -0,0 +1,161 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// useiToSweiMultiplier converts a usei amount into the 18-decimal EVM balance unit.
+var useiToSweiMultiplier = big.NewInt(1_000_000_000_000)
+
+// VerifyAccountResult checks every proof in an eth_getProof result against appHash, which
+// must be the app hash of block res.Height+1, and checks that the Ethereum-compatible
+// account and storage proofs are consistent with the proven store values.
+//
+// StorageHash commits to all storage slots of the account, but only the requested slots
+// are proven against the app hash, so the verifier cannot check that StorageHash covers
+// every slot of the account.
+func VerifyAccountResult(appHash []byte, res *AccountResult) error {
+	if res == nil {
+		return errors.New("empty result")
+	}
+	addr := res.Address
+	proofs := res.CommitmentProofs
+
+	// the bank balances are keyed by the associated Sei address, which defaults to the
+	// EVM address bytes if no association exists
+	if err := VerifyStoreProof(appHash, proofs.SeiAddress, EVMStoreName, types.EVMAddressToSeiAddressKey(addr)); err != nil {
+		return fmt.Errorf("invalid sei address proof: %w", err)
+	}
+	seiAddr := sdk.AccAddress(addr[:])
+	if len(proofs.SeiAddress.Value) > 0 {
+		seiAddr = sdk.AccAddress(proofs.SeiAddress.Value)
+	}
+
+	if err := VerifyStoreProof(appHash, proofs.Nonce, EVMStoreName, append(types.NonceKeyPrefix, addr[:]...)); err != nil {
+		return fmt.Errorf("invalid nonce proof: %w", err)
+	}
+	nonce := uint64(0)
+	if len(proofs.Nonce.Value) > 0 {
+		if len(proofs.Nonce.Value) != 8 {
+			return errors.New("invalid nonce value")
+		}
+		nonce = binary.BigEndian.Uint64(proofs.Nonce.Value)
+	}
+	if nonce != uint64(res.Nonce) {
+		return fmt.Errorf("nonce %d does not match proven nonce %d", res.Nonce, nonce)
+	}
+
+	if err := VerifyStoreProof(appHash, proofs.Balance, BankStoreName, banktypes.CreatePrefixedAccountStoreKey(seiAddr, []byte(BaseDenom))); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+	if err := VerifyStoreProof(appHash, proofs.WeiBalance, BankStoreName, append(banktypes.WeiBalancesPrefix, seiAddr...)); err != nil {
+		return fmt.Errorf("invalid wei balance proof: %w", err)
+	}
+	balance, err := provenBalance(proofs.Balance.Value, proofs.WeiBalance.Value)
+	if err != nil {
+		return err
+	}
+	if res.Balance == nil || res.Balance.ToInt().Cmp(balance) != 0 {
+		return fmt.Errorf("balance %s does not match proven balance %s", res.Balance, balance)
+	}
+
+	if err := VerifyStoreProof(appHash, proofs.CodeHash, EVMStoreName, append(types.CodeHashKeyPrefix, addr[:]...)); err != nil {
+		return fmt.Errorf("invalid code hash proof: %w", err)
+	}
+	codeHash := ethtypes.EmptyCodeHash
+	if len(proofs.CodeHash.Value) > 0 {
+		codeHash = common.BytesToHash(proofs.CodeHash.Value)
+	}
+	if codeHash != res.CodeHash {
+		return fmt.Errorf("code hash %s does not match proven code hash %s", res.CodeHash.Hex(), codeHash.Hex())
+	}
+
+	account, err := AccountTrieValue(nonce, balance, res.StorageHash, codeHash)
+	if err != nil {
+		return err
+	}
+	provenAccount, err := VerifyTrieProof(res.StateRoot, AccountTrieKey(addr), res.AccountProof)
+	if err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+	if !bytes.Equal(account, provenAccount) {
+		return errors.New("account proof does not match the proven account fields")
+	}
+
+	for _, storage := range res.StorageProof {
+		if err := verifyStorageResult(appHash, addr, res.StorageHash, storage); err != nil {
+			return fmt.Errorf("invalid storage proof for key %s: %w", storage.Key.Hex(), err)
+		}
+	}
+	return nil
+}
+
+func verifyStorageResult(appHash []byte, addr common.Address, storageHash common.Hash, storage StorageResult) error {
+	if err := VerifyStoreProof(appHash, storage.CommitmentProof, EVMStoreName, append(types.StateKey(addr), storage.Key[:]...)); err != nil {
+		return err
+	}
+	value := common.BytesToHash(storage.CommitmentProof.Value)
+	if storage.Value == nil || common.BigToHash(storage.Value.ToInt()) != value {
+		return fmt.Errorf("value %s does not match proven value %s", storage.Value, value.Hex())
+	}
+	expected, err := StorageTrieValue(value)
+	if err != nil {
+		return err
+	}
+	proven, err := VerifyTrieProof(storageHash, StorageTrieKey(storage.Key), storage.Proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, proven) {
+		return errors.New("storage proof does not match the proven value")
+	}
+	return nil
+}
+
+// VerifyStoreProof checks that proof proves the value (or absence, if the value is empty)
+// of key in the given store against appHash.
+func VerifyStoreProof(appHash []byte, proof *StoreProof, storeName string, key []byte) error {
+	if proof == nil || proof.ProofOps == nil {
+		return errors.New("missing proof")
+	}
+	if proof.StoreName != storeName || !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("proof is for key %X in store %s, expected key %X in store %s", []byte(proof.Key), proof.StoreName, key, storeName)
+	}
+	keyPath := merkle.KeyPath{}.AppendKey([]byte(storeName), merkle.KeyEncodingURL).AppendKey(key, merkle.KeyEncodingHex)
+	prt := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		return prt.VerifyAbsence(proof.ProofOps, appHash, keyPath.String())
+	}
+	return prt.VerifyValue(proof.ProofOps, appHash, keyPath.String(), proof.Value)
+}
+
+func provenBalance(coinBz []byte, weiBz []byte) (*big.Int, error) {
+	usei := sdk.ZeroInt()
+	if len(coinBz) > 0 {
+		var coin sdk.Coin
+		if err := coin.Unmarshal(coinBz); err != nil {
+			return nil, fmt.Errorf("invalid balance value: %w", err)
+		}
+		usei = coin.Amount
+	}
+	wei := sdk.ZeroInt()
+	if len(weiBz) > 0 {
+		if err := wei.Unmarshal(weiBz); err != nil {
+			return nil, fmt.Errorf("invalid wei balance value: %w", err)
+		}
+	}
+	balance := new(big.Int).Mul(usei.BigInt(), useiToSweiMultiplier)
+	return balance.Add(balance, wei.BigInt()), nil
+}
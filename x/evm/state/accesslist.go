@@ -9,7 +9,7 @@ import (
 // CustomPrecompileStartingAddr all custom precompiles have an address greater than or equal to this address
 var CustomPrecompileStartingAddr = common.HexToAddress("0x0000000000000000000000000000000000001001")
 
-// Forked from go-ethereum, except journaling logic which is unnecessary with cacheKV
+// Forked from go-ethereum
 
 type accessList struct {
 	Addresses map[common.Address]int
@@ -19,38 +19,18 @@ type accessList struct {
 func (s *DBImpl) AddressInAccessList(addr common.Address) bool {
 	s.k.PrepareReplayedAddr(s.ctx, addr)
 	_, ok := s.getCurrentAccessList().Addresses[addr]
-	if ok {
-		return true
-	}
-	for _, ts := range s.tempStatesHist {
-		if _, ok := ts.transientAccessLists.Addresses[addr]; ok {
-			return true
-		}
-	}
-	return false
+	return ok
 }
 
 func (s *DBImpl) SlotInAccessList(addr common.Address, slot common.Hash) (addressOk bool, slotOk bool) {
 	s.k.PrepareReplayedAddr(s.ctx, addr)
 	al := s.getCurrentAccessList()
 	idx, addrOk := al.Addresses[addr]
-	if addrOk && idx != -1 {
-		_, slotOk := al.Slots[idx][slot]
-		if slotOk {
-			return true, true
-		}
+	if !addrOk || idx == -1 {
+		return addrOk, false
 	}
-	for _, ts := range s.tempStatesHist {
-		idx, ok := ts.transientAccessLists.Addresses[addr]
-		addrOk = addrOk || ok
-		if ok && idx != -1 {
-			_, slotOk := ts.transientAccessLists.Slots[idx][slot]
-			if slotOk {
-				return true, true
-			}
-		}
-	}
-	return addrOk, false
+	_, slotOk = al.Slots[idx][slot]
+	return true, slotOk
 }
 
 func (s *DBImpl) AddAddressToAccessList(addr common.Address) {
@@ -60,26 +40,54 @@ func (s *DBImpl) AddAddressToAccessList(addr common.Address) {
 		return
 	}
 	al.Addresses[addr] = -1
+	s.journal.append(accessListAddAccountChange{addr: addr})
 }
 
 func (s *DBImpl) AddSlotToAccessList(addr common.Address, slot common.Hash) {
 	s.k.PrepareReplayedAddr(s.ctx, addr)
 	al := s.getCurrentAccessList()
 	idx, addrPresent := al.Addresses[addr]
+	if !addrPresent {
+		s.journal.append(accessListAddAccountChange{addr: addr})
+	}
 	if !addrPresent || idx == -1 {
 		// Address not present, or addr present but no slots there
 		al.Addresses[addr] = len(al.Slots)
 		slotmap := map[common.Hash]struct{}{slot: {}}
 		al.Slots = append(al.Slots, slotmap)
+		s.journal.append(accessListAddSlotChange{addr: addr, slot: slot})
 		return
 	}
 	// There is already an (address,slot) mapping
 	slotmap := al.Slots[idx]
 	if _, ok := slotmap[slot]; !ok {
 		slotmap[slot] = struct{}{}
+		s.journal.append(accessListAddSlotChange{addr: addr, slot: slot})
 	}
 }
 
+// DeleteSlot removes an (address, slot)-tuple from the access list. It must be called in
+// the reverse order of the additions, which the journal guarantees.
+func (al *accessList) DeleteSlot(addr common.Address, slot common.Hash) {
+	idx, addrOk := al.Addresses[addr]
+	if !addrOk {
+		panic("reverting slot change, address not present in list")
+	}
+	slotmap := al.Slots[idx]
+	delete(slotmap, slot)
+	// If that was the last (first) slot, remove it
+	if len(slotmap) == 0 {
+		al.Slots = al.Slots[:idx]
+		al.Addresses[addr] = -1
+	}
+}
+
+// DeleteAddress removes an address from the access list. It must be called in the reverse
+// order of the additions, which the journal guarantees.
+func (al *accessList) DeleteAddress(addr common.Address) {
+	delete(al.Addresses, addr)
+}
+
 func (s *DBImpl) Prepare(_ params.Rules, sender, coinbase common.Address, dest *common.Address, precompiles []common.Address, txAccesses ethtypes.AccessList) {
 	s.k.PrepareReplayedAddr(s.ctx, sender)
 	s.k.PrepareReplayedAddr(s.ctx, coinbase)
@@ -109,5 +117,5 @@ func (s *DBImpl) Prepare(_ params.Rules, sender, coinbase common.Address, dest *
 }
 
 func (s *DBImpl) getCurrentAccessList() *accessList {
-	return s.tempStateCurrent.transientAccessLists
+	return s.tempState.transientAccessLists
 }
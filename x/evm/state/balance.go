@@ -47,7 +47,7 @@ func (s *DBImpl) SubBalance(evmAddr common.Address, amt *big.Int, reason tracing
 		s.logger.OnBalanceChange(evmAddr, oldBalance, newBalance, reason)
 	}
 
-	s.tempStateCurrent.surplus = s.tempStateCurrent.surplus.Add(sdk.NewIntFromBigInt(amt))
+	s.addSurplus(sdk.NewIntFromBigInt(amt))
 }
 
 func (s *DBImpl) AddBalance(evmAddr common.Address, amt *big.Int, reason tracing.BalanceChangeReason) {
@@ -87,7 +87,7 @@ func (s *DBImpl) AddBalance(evmAddr common.Address, amt *big.Int, reason tracing
 		s.logger.OnBalanceChange(evmAddr, oldBalance, newBalance, reason)
 	}
 
-	s.tempStateCurrent.surplus = s.tempStateCurrent.surplus.Sub(sdk.NewIntFromBigInt(amt))
+	s.addSurplus(sdk.NewIntFromBigInt(amt).Neg())
 }
 
 func (s *DBImpl) GetBalance(evmAddr common.Address) *big.Int {
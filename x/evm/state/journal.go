@@ -0,0 +1,353 @@
+package state
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// journal records every change made through a DBImpl so that the changes made after a
+// snapshot can be undone in reverse order, instead of branching the context on every
+// snapshot.
+type journal struct {
+	entries   []journalEntry
+	revisions []revision
+}
+
+type revision struct {
+	journalIndex int
+	eventCount   int
+}
+
+type journalEntry interface {
+	revert(s *DBImpl)
+}
+
+func (j *journal) append(entry journalEntry) {
+	j.entries = append(j.entries, entry)
+}
+
+// newJournaledCtx branches ctx into a single write buffer whose module stores record their
+// changes in j.
+func newJournaledCtx(ctx sdk.Context, j *journal) sdk.Context {
+	cms := ctx.MultiStore().CacheMultiStore()
+	cms.SetKVStores(func(sk storetypes.StoreKey, s storetypes.KVStore) storetypes.CacheWrap {
+		// the journal store takes the place of the cache store of the branch
+		if c, ok := s.(*cachekv.Store); ok {
+			s = c.GetParent()
+		}
+		return newJournalKVStore(s, sk, j)
+	})
+	return ctx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+}
+
+type journalValue struct {
+	value   []byte
+	deleted bool
+}
+
+// journalKVStore buffers the writes made to a module store until Write is called. Every
+// write is journaled with the buffered value it replaced, so that reverting it leaves the
+// buffer exactly as it was, including which keys are dirty.
+type journalKVStore struct {
+	parent   storetypes.KVStore
+	storeKey storetypes.StoreKey
+	dirty    map[string]*journalValue
+	journal  *journal
+}
+
+var _ storetypes.CacheKVStore = (*journalKVStore)(nil)
+
+func newJournalKVStore(parent storetypes.KVStore, storeKey storetypes.StoreKey, j *journal) *journalKVStore {
+	return &journalKVStore{
+		parent:   parent,
+		storeKey: storeKey,
+		dirty:    map[string]*journalValue{},
+		journal:  j,
+	}
+}
+
+func (store *journalKVStore) GetStoreType() storetypes.StoreType {
+	return store.parent.GetStoreType()
+}
+
+func (store *journalKVStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+	if v, ok := store.dirty[string(key)]; ok {
+		if v.deleted {
+			return nil
+		}
+		return v.value
+	}
+	return store.parent.Get(key)
+}
+
+func (store *journalKVStore) Has(key []byte) bool {
+	return store.Get(key) != nil
+}
+
+func (store *journalKVStore) Set(key []byte, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	store.set(string(key), &journalValue{value: value})
+}
+
+func (store *journalKVStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	store.set(string(key), &journalValue{deleted: true})
+}
+
+func (store *journalKVStore) set(key string, value *journalValue) {
+	store.journal.append(kvChange{store: store, key: key, prev: store.dirty[key]})
+	store.dirty[key] = value
+}
+
+func (store *journalKVStore) Write() {
+	keys := make([]string, 0, len(store.dirty))
+	for key := range store.dirty {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if v := store.dirty[key]; v.deleted {
+			store.parent.Delete([]byte(key))
+		} else {
+			store.parent.Set([]byte(key), v.value)
+		}
+	}
+	store.dirty = map[string]*journalValue{}
+}
+
+func (store *journalKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return store.iterator(start, end, true)
+}
+
+func (store *journalKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return store.iterator(start, end, false)
+}
+
+// iterator merges the parent with the keys buffered in the domain at the time the iterator
+// is created, so that writes made while iterating do not affect it.
+func (store *journalKVStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	var parent storetypes.Iterator
+	if ascending {
+		parent = store.parent.Iterator(start, end)
+	} else {
+		parent = store.parent.ReverseIterator(start, end)
+	}
+	items := []journalItem{}
+	for key, v := range store.dirty {
+		kbz := []byte(key)
+		if (start != nil && bytes.Compare(kbz, start) < 0) || (end != nil && bytes.Compare(kbz, end) >= 0) {
+			continue
+		}
+		items = append(items, journalItem{key: kbz, value: v})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if ascending {
+			return bytes.Compare(items[i].key, items[j].key) < 0
+		}
+		return bytes.Compare(items[i].key, items[j].key) > 0
+	})
+	return cachekv.NewCacheMergeIterator(parent, &journalIterator{start: start, end: end, items: items}, ascending, store.storeKey)
+}
+
+func (store *journalKVStore) GetWorkingHash() ([]byte, error) {
+	panic("should never attempt to get working hash from journal kv store")
+}
+
+func (store *journalKVStore) VersionExists(version int64) bool {
+	return store.parent.VersionExists(version)
+}
+
+func (store *journalKVStore) DeleteAll(start, end []byte) error {
+	for _, k := range store.GetAllKeyStrsInRange(start, end) {
+		store.Delete([]byte(k))
+	}
+	return nil
+}
+
+func (store *journalKVStore) GetAllKeyStrsInRange(start, end []byte) (res []string) {
+	keyStrs := map[string]struct{}{}
+	// the parent is iterated rather than asked for its keys, since a cache store parent
+	// skips its own buffered keys when a bound is nil
+	iter := store.parent.Iterator(start, end)
+	for ; iter.Valid(); iter.Next() {
+		keyStrs[string(iter.Key())] = struct{}{}
+	}
+	_ = iter.Close()
+	for key, v := range store.dirty {
+		kbz := []byte(key)
+		if (start != nil && bytes.Compare(kbz, start) < 0) || (end != nil && bytes.Compare(kbz, end) >= 0) {
+			continue
+		}
+		if v.deleted {
+			delete(keyStrs, key)
+		} else {
+			keyStrs[key] = struct{}{}
+		}
+	}
+	for k := range keyStrs {
+		res = append(res, k)
+	}
+	return res
+}
+
+func (store *journalKVStore) GetEvents() []abci.Event {
+	return []abci.Event{}
+}
+
+func (store *journalKVStore) ResetEvents() {}
+
+func (store *journalKVStore) CacheWrap(storeKey storetypes.StoreKey) storetypes.CacheWrap {
+	return cachekv.NewStore(store, storeKey, storetypes.DefaultCacheSizeLimit)
+}
+
+func (store *journalKVStore) CacheWrapWithTrace(storeKey storetypes.StoreKey, w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(store, w, tc), storeKey, storetypes.DefaultCacheSizeLimit)
+}
+
+func (store *journalKVStore) CacheWrapWithListeners(storeKey storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(store, storeKey, listeners), storeKey, storetypes.DefaultCacheSizeLimit)
+}
+
+type journalItem struct {
+	key   []byte
+	value *journalValue
+}
+
+// journalIterator iterates over buffered items in order. Deleted items have a nil value,
+// which is how the merge iterator expects deletions to be represented.
+type journalIterator struct {
+	start, end []byte
+	items      []journalItem
+	pos        int
+}
+
+func (iter *journalIterator) Domain() ([]byte, []byte) {
+	return iter.start, iter.end
+}
+
+func (iter *journalIterator) Valid() bool {
+	return iter.pos < len(iter.items)
+}
+
+func (iter *journalIterator) Next() {
+	iter.pos++
+}
+
+func (iter *journalIterator) Key() []byte {
+	return iter.items[iter.pos].key
+}
+
+func (iter *journalIterator) Value() []byte {
+	if v := iter.items[iter.pos].value; !v.deleted {
+		return v.value
+	}
+	return nil
+}
+
+func (iter *journalIterator) Error() error {
+	return nil
+}
+
+func (iter *journalIterator) Close() error {
+	return nil
+}
+
+type (
+	// a write to a module store
+	kvChange struct {
+		store *journalKVStore
+		key   string
+		prev  *journalValue
+	}
+	transientStateChange struct {
+		addr      string
+		key       string
+		prev      common.Hash
+		prevFound bool
+	}
+	transientAccountChange struct {
+		addr      string
+		prev      []byte
+		prevFound bool
+	}
+	transientModuleChange struct {
+		key       string
+		prev      []byte
+		prevFound bool
+	}
+	accessListAddAccountChange struct {
+		addr common.Address
+	}
+	accessListAddSlotChange struct {
+		addr common.Address
+		slot common.Hash
+	}
+	addLogChange  struct{}
+	surplusChange struct {
+		prev sdk.Int
+	}
+)
+
+func (ch kvChange) revert(*DBImpl) {
+	if ch.prev == nil {
+		delete(ch.store.dirty, ch.key)
+	} else {
+		ch.store.dirty[ch.key] = ch.prev
+	}
+}
+
+func (ch transientStateChange) revert(s *DBImpl) {
+	st := s.tempState.transientStates[ch.addr]
+	if ch.prevFound {
+		st[ch.key] = ch.prev
+		return
+	}
+	delete(st, ch.key)
+	if len(st) == 0 {
+		delete(s.tempState.transientStates, ch.addr)
+	}
+}
+
+func (ch transientAccountChange) revert(s *DBImpl) {
+	if ch.prevFound {
+		s.tempState.transientAccounts[ch.addr] = ch.prev
+	} else {
+		delete(s.tempState.transientAccounts, ch.addr)
+	}
+	s.tempState.accountMarks = s.tempState.accountMarks[:len(s.tempState.accountMarks)-1]
+}
+
+func (ch transientModuleChange) revert(s *DBImpl) {
+	if ch.prevFound {
+		s.tempState.transientModuleStates[ch.key] = ch.prev
+	} else {
+		delete(s.tempState.transientModuleStates, ch.key)
+	}
+}
+
+func (ch accessListAddAccountChange) revert(s *DBImpl) {
+	s.tempState.transientAccessLists.DeleteAddress(ch.addr)
+}
+
+func (ch accessListAddSlotChange) revert(s *DBImpl) {
+	s.tempState.transientAccessLists.DeleteSlot(ch.addr, ch.slot)
+}
+
+func (ch addLogChange) revert(s *DBImpl) {
+	s.tempState.logs = s.tempState.logs[:len(s.tempState.logs)-1]
+}
+
+func (ch surplusChange) revert(s *DBImpl) {
+	s.tempState.surplus = ch.prev
+}
@@ -10,8 +10,9 @@ type Logs struct {
 }
 
 func (s *DBImpl) AddLog(l *ethtypes.Log) {
-	l.Index = uint(len(s.GetAllLogs()))
-	s.tempStateCurrent.logs = append(s.tempStateCurrent.logs, l)
+	l.Index = uint(len(s.tempState.logs))
+	s.journal.append(addLogChange{})
+	s.tempState.logs = append(s.tempState.logs, l)
 
 	if s.logger != nil && s.logger.OnLog != nil {
 		s.logger.OnLog(l)
@@ -20,11 +21,7 @@ func (s *DBImpl) AddLog(l *ethtypes.Log) {
 
 func (s *DBImpl) GetAllLogs() []*ethtypes.Log {
 	res := []*ethtypes.Log{}
-	for _, st := range s.tempStatesHist {
-		res = append(res, st.logs...)
-	}
-	res = append(res, s.tempStateCurrent.logs...)
-	return res
+	return append(res, s.tempState.logs...)
 }
 
 func (s *DBImpl) GetLogs(common.Hash, uint64, common.Hash) []*ethtypes.Log {
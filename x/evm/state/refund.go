@@ -8,7 +8,7 @@ import (
 func (s *DBImpl) AddRefund(gas uint64) {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, s.GetRefund()+gas)
-	s.tempStateCurrent.transientModuleStates[string(GasRefundKey)] = bz
+	s.setTransientModule(GasRefundKey, bz)
 }
 
 // Copied from go-ethereum as-is
@@ -21,7 +21,7 @@ func (s *DBImpl) SubRefund(gas uint64) {
 	}
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, refund-gas)
-	s.tempStateCurrent.transientModuleStates[string(GasRefundKey)] = bz
+	s.setTransientModule(GasRefundKey, bz)
 }
 
 func (s *DBImpl) GetRefund() uint64 {
@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"sort"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,7 +20,7 @@ func (s *DBImpl) CreateAccount(acc common.Address) {
 }
 
 func (s *DBImpl) GetCommittedState(addr common.Address, hash common.Hash) common.Hash {
-	return s.getState(s.snapshottedCtxs[0], addr, hash)
+	return s.getState(s.committedCtx, addr, hash)
 }
 
 func (s *DBImpl) GetState(addr common.Address, hash common.Hash) common.Hash {
@@ -50,10 +51,12 @@ func (s *DBImpl) GetTransientState(addr common.Address, key common.Hash) common.
 }
 
 func (s *DBImpl) SetTransientState(addr common.Address, key, val common.Hash) {
-	st, ok := s.tempStateCurrent.transientStates[addr.Hex()]
+	prev, found := s.getTransientState(addr, key)
+	s.journal.append(transientStateChange{addr: addr.Hex(), key: key.Hex(), prev: prev, prevFound: found})
+	st, ok := s.tempState.transientStates[addr.Hex()]
 	if !ok {
 		st = make(map[string]common.Hash)
-		s.tempStateCurrent.transientStates[addr.Hex()] = st
+		s.tempState.transientStates[addr.Hex()] = st
 	}
 	st[key.Hex()] = val
 }
@@ -93,46 +96,62 @@ func (s *DBImpl) HasSelfDestructed(acc common.Address) bool {
 }
 
 func (s *DBImpl) Snapshot() int {
-	newCtx := s.ctx.WithMultiStore(s.ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
-	s.snapshottedCtxs = append(s.snapshottedCtxs, s.ctx)
-	s.ctx = newCtx
-	s.tempStatesHist = append(s.tempStatesHist, s.tempStateCurrent)
-	s.tempStateCurrent = NewTemporaryState()
-	return len(s.snapshottedCtxs) - 1
+	s.journal.revisions = append(s.journal.revisions, revision{
+		journalIndex: len(s.journal.entries),
+		eventCount:   len(s.ctx.EventManager().Events()),
+	})
+	return len(s.journal.revisions) - 1
 }
 
 func (s *DBImpl) RevertToSnapshot(rev int) {
-	s.ctx = s.snapshottedCtxs[rev]
-	s.snapshottedCtxs = s.snapshottedCtxs[:rev]
-	s.tempStateCurrent = s.tempStatesHist[rev]
-	s.tempStatesHist = s.tempStatesHist[:rev]
+	r := s.journal.revisions[rev]
+	for i := len(s.journal.entries) - 1; i >= r.journalIndex; i-- {
+		s.journal.entries[i].revert(s)
+	}
+	s.journal.entries = s.journal.entries[:r.journalIndex]
+	s.journal.revisions = s.journal.revisions[:rev]
+	if events := s.ctx.EventManager().Events(); len(events) > r.eventCount {
+		em := sdk.NewEventManager()
+		em.EmitEvents(events[:r.eventCount])
+		s.ctx = s.ctx.WithEventManager(em)
+	}
 	s.Snapshot()
 }
 
-func (s *DBImpl) handleResidualFundsInDestructedAccounts(st *TemporaryState) {
-	for a, status := range st.transientAccounts {
-		if !bytes.Equal(status, AccountDeleted) {
-			continue
-		}
-		acc := common.HexToAddress(a)
-		residual := s.GetBalance(acc)
-		if residual.Cmp(utils.Big0) == 0 {
-			continue
+// destructedAccounts returns the accounts that were last marked as deleted under any
+// snapshot that has not been reverted.
+func (s *DBImpl) destructedAccounts() []common.Address {
+	type markKey struct {
+		revision int
+		acc      string
+	}
+	statuses := map[markKey][]byte{}
+	for _, m := range s.tempState.accountMarks {
+		statuses[markKey{m.revision, m.acc}] = m.status
+	}
+	destructed := map[string]struct{}{}
+	for k, status := range statuses {
+		if bytes.Equal(status, AccountDeleted) {
+			destructed[k.acc] = struct{}{}
 		}
-		s.SubBalance(acc, residual, tracing.BalanceDecreaseSelfdestructBurn)
-		// we don't want to really "burn" the token since it will mess up
-		// total supply calculation, so we send them to fee collector instead
-		s.AddBalance(s.coinbaseEvmAddress, residual, tracing.BalanceDecreaseSelfdestructBurn)
 	}
+	res := make([]common.Address, 0, len(destructed))
+	for acc := range destructed {
+		res = append(res, common.HexToAddress(acc))
+	}
+	sort.Slice(res, func(i, j int) bool { return bytes.Compare(res[i][:], res[j][:]) < 0 })
+	return res
 }
 
-func (s *DBImpl) clearAccountStateIfDestructed(st *TemporaryState) {
-	for acc, status := range st.transientAccounts {
-		if !bytes.Equal(status, AccountDeleted) {
-			continue
-		}
-		s.clearAccountState(common.HexToAddress(acc))
+func (s *DBImpl) handleResidualFundsInDestructedAccount(acc common.Address) {
+	residual := s.GetBalance(acc)
+	if residual.Cmp(utils.Big0) == 0 {
+		return
 	}
+	s.SubBalance(acc, residual, tracing.BalanceDecreaseSelfdestructBurn)
+	// we don't want to really "burn" the token since it will mess up
+	// total supply calculation, so we send them to fee collector instead
+	s.AddBalance(s.coinbaseEvmAddress, residual, tracing.BalanceDecreaseSelfdestructBurn)
 }
 
 func (s *DBImpl) clearAccountState(acc common.Address) {
@@ -146,7 +165,14 @@ func (s *DBImpl) clearAccountState(acc common.Address) {
 
 func (s *DBImpl) MarkAccount(acc common.Address, status []byte) {
 	// val being nil means it's deleted
-	s.tempStateCurrent.transientAccounts[acc.Hex()] = status
+	prev, found := s.tempState.transientAccounts[acc.Hex()]
+	s.journal.append(transientAccountChange{addr: acc.Hex(), prev: prev, prevFound: found})
+	s.tempState.transientAccounts[acc.Hex()] = status
+	s.tempState.accountMarks = append(s.tempState.accountMarks, accountMark{
+		revision: len(s.journal.revisions),
+		acc:      acc.Hex(),
+		status:   status,
+	})
 }
 
 func (s *DBImpl) Created(acc common.Address) bool {
@@ -165,33 +191,27 @@ func (s *DBImpl) SetStorage(addr common.Address, states map[common.Hash]common.H
 }
 
 func (s *DBImpl) getTransientAccount(acc common.Address) ([]byte, bool) {
-	val, found := s.tempStateCurrent.transientAccounts[acc.Hex()]
-	for i := len(s.tempStatesHist) - 1; !found && i >= 0; i-- {
-		val, found = s.tempStatesHist[i].transientAccounts[acc.Hex()]
-	}
+	val, found := s.tempState.transientAccounts[acc.Hex()]
 	return val, found
 }
 
 func (s *DBImpl) getTransientModule(key []byte) ([]byte, bool) {
-	val, found := s.tempStateCurrent.transientModuleStates[string(key)]
-	for i := len(s.tempStatesHist) - 1; !found && i >= 0; i-- {
-		val, found = s.tempStatesHist[i].transientModuleStates[string(key)]
-	}
+	val, found := s.tempState.transientModuleStates[string(key)]
 	return val, found
 }
 
+func (s *DBImpl) setTransientModule(key []byte, val []byte) {
+	prev, found := s.getTransientModule(key)
+	s.journal.append(transientModuleChange{key: string(key), prev: prev, prevFound: found})
+	s.tempState.transientModuleStates[string(key)] = val
+}
+
 func (s *DBImpl) getTransientState(acc common.Address, key common.Hash) (common.Hash, bool) {
 	var val common.Hash
-	m, found := s.tempStateCurrent.transientStates[acc.Hex()]
+	m, found := s.tempState.transientStates[acc.Hex()]
 	if found {
 		val, found = m[key.Hex()]
 	}
-	for i := len(s.tempStatesHist) - 1; !found && i >= 0; i-- {
-		m, found = s.tempStatesHist[i].transientStates[acc.Hex()]
-		if found {
-			val, found = m[key.Hex()]
-		}
-	}
 	return val, found
 }
 
@@ -7,15 +7,17 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
 // Initialized for each transaction individually
 type DBImpl struct {
-	ctx             sdk.Context
-	snapshottedCtxs []sdk.Context
+	// ctx writes to a single buffer over committedCtx, which is flushed in Finalize
+	ctx          sdk.Context
+	committedCtx sdk.Context
+	journal      *journal
 
-	tempStateCurrent *TemporaryState
-	tempStatesHist   []*TemporaryState
+	tempState *TemporaryState
 	// If err is not nil at the end of the execution, the transaction will be rolled
 	// back.
 	err error
@@ -36,20 +38,24 @@ type DBImpl struct {
 	eventsSuppressed bool
 
 	logger *tracing.Hooks
+	// set if logger follows calls into other VMs
+	crossVMTracer types.CrossVMTracer
 }
 
 func NewDBImpl(ctx sdk.Context, k EVMKeeper, simulation bool) *DBImpl {
 	feeCollector, _ := k.GetFeeCollectorAddress(ctx)
+	j := &journal{}
 	s := &DBImpl{
-		ctx:                ctx,
+		ctx:                newJournaledCtx(ctx, j),
+		committedCtx:       ctx,
+		journal:            j,
 		k:                  k,
-		snapshottedCtxs:    []sdk.Context{},
 		coinbaseAddress:    GetCoinbaseAddress(ctx.TxIndex()),
 		simulation:         simulation,
-		tempStateCurrent:   NewTemporaryState(),
+		tempState:          NewTemporaryState(),
 		coinbaseEvmAddress: feeCollector,
 	}
-	s.Snapshot() // take an initial snapshot for GetCommitted
+	s.Snapshot() // take an initial snapshot that reverts every change of the transaction
 	return s
 }
 
@@ -57,7 +63,12 @@ func (s *DBImpl) AddSurplus(surplus sdk.Int) {
 	if surplus.IsNil() || surplus.IsZero() {
 		return
 	}
-	s.tempStateCurrent.surplus = s.tempStateCurrent.surplus.Add(surplus)
+	s.addSurplus(surplus)
+}
+
+func (s *DBImpl) addSurplus(surplus sdk.Int) {
+	s.journal.append(surplusChange{prev: s.tempState.surplus})
+	s.tempState.surplus = s.tempState.surplus.Add(surplus)
 }
 
 func (s *DBImpl) DisableEvents() {
@@ -70,6 +81,7 @@ func (s *DBImpl) EnableEvents() {
 
 func (s *DBImpl) SetLogger(logger *tracing.Hooks) {
 	s.logger = logger
+	s.crossVMTracer = types.GetCrossVMTracerForHooks(logger)
 }
 
 // for interface compliance
@@ -82,10 +94,9 @@ func (s *DBImpl) SetEVM(evm *vm.EVM) {}
 func (s *DBImpl) AddPreimage(_ common.Hash, _ []byte) {}
 
 func (s *DBImpl) Cleanup() {
-	s.tempStateCurrent = nil
-	s.tempStatesHist = []*TemporaryState{}
+	s.tempState = nil
 	s.logger = nil
-	s.snapshottedCtxs = nil
+	s.journal = nil
 }
 
 func (s *DBImpl) Finalize() (surplus sdk.Int, err error) {
@@ -98,39 +109,22 @@ func (s *DBImpl) Finalize() (surplus sdk.Int, err error) {
 	}
 
 	// delete state of self-destructed accounts
-	s.handleResidualFundsInDestructedAccounts(s.tempStateCurrent)
-	s.clearAccountStateIfDestructed(s.tempStateCurrent)
-	for _, ts := range s.tempStatesHist {
-		s.handleResidualFundsInDestructedAccounts(ts)
-		s.clearAccountStateIfDestructed(ts)
-	}
-
-	// remove transient states
-	// write cache to underlying
-	s.flushCtx(s.ctx)
-	// write all snapshotted caches in reverse order, except the very first one (base) which will be written by baseapp::runTx
-	for i := len(s.snapshottedCtxs) - 1; i > 0; i-- {
-		s.flushCtx(s.snapshottedCtxs[i])
+	destructed := s.destructedAccounts()
+	for _, acc := range destructed {
+		s.handleResidualFundsInDestructedAccount(acc)
 	}
-	// write all events in order
-	for i := 1; i < len(s.snapshottedCtxs); i++ {
-		s.flushEvents(s.snapshottedCtxs[i])
+	for _, acc := range destructed {
+		s.clearAccountState(acc)
 	}
-	s.flushEvents(s.ctx)
 
-	surplus = s.tempStateCurrent.surplus
-	for _, ts := range s.tempStatesHist {
-		surplus = surplus.Add(ts.surplus)
-	}
-	return
-}
-
-func (s *DBImpl) flushCtx(ctx sdk.Context) {
-	ctx.MultiStore().(sdk.CacheMultiStore).Write()
-}
+	// write the buffer to the committed context, which will be written by baseapp::runTx
+	s.ctx.MultiStore().(sdk.CacheMultiStore).Write()
+	s.committedCtx.EventManager().EmitEvents(s.ctx.EventManager().Events())
 
-func (s *DBImpl) flushEvents(ctx sdk.Context) {
-	s.snapshottedCtxs[0].EventManager().EmitEvents(ctx.EventManager().Events())
+	// adding to zero normalizes the representation of a zero surplus, as summing
+	// the surplus of each snapshot used to
+	surplus = utils.Sdk0.Add(s.tempState.surplus)
+	return
 }
 
 // Backward-compatibility functions
@@ -142,13 +136,15 @@ func (s *DBImpl) GetStorageRoot(common.Address) common.Hash {
 	panic("GetStorageRoot is not implemented and called unexpectedly")
 }
 
+// Copy returns a DBImpl that buffers its writes over the current state of s. Finalizing
+// the copy writes its changes to the buffer of s.
 func (s *DBImpl) Copy() vm.StateDB {
-	newCtx := s.ctx.WithMultiStore(s.ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
-	return &DBImpl{
-		ctx:                newCtx,
-		snapshottedCtxs:    append(s.snapshottedCtxs, s.ctx),
-		tempStateCurrent:   NewTemporaryState(),
-		tempStatesHist:     append(s.tempStatesHist, s.tempStateCurrent),
+	j := &journal{}
+	c := &DBImpl{
+		ctx:                newJournaledCtx(s.ctx, j),
+		committedCtx:       s.committedCtx,
+		journal:            j,
+		tempState:          s.tempState.copy(),
 		k:                  s.k,
 		coinbaseAddress:    s.coinbaseAddress,
 		coinbaseEvmAddress: s.coinbaseEvmAddress,
@@ -156,7 +152,10 @@ func (s *DBImpl) Copy() vm.StateDB {
 		err:                s.err,
 		precompileErr:      s.precompileErr,
 		logger:             s.logger,
+		crossVMTracer:      s.crossVMTracer,
 	}
+	c.Snapshot()
+	return c
 }
 
 func (s *DBImpl) Finalise(bool) {
@@ -201,6 +200,9 @@ func (s *DBImpl) WithErr(err error) {
 }
 
 func (s *DBImpl) Ctx() sdk.Context {
+	if s.crossVMTracer != nil {
+		return types.WithCrossVMTracer(s.ctx, s.crossVMTracer)
+	}
 	return s.ctx
 }
 
@@ -208,8 +210,8 @@ func (s *DBImpl) WithCtx(ctx sdk.Context) {
 	s.ctx = ctx
 }
 
-// in-memory state that's generated by a specific
-// EVM snapshot in a single transaction
+// in-memory state of a single transaction. Changes to it are journaled
+// so that they can be reverted along with the store writes.
 type TemporaryState struct {
 	logs                  []*ethtypes.Log
 	transientStates       map[string]map[string]common.Hash
@@ -217,6 +219,16 @@ type TemporaryState struct {
 	transientModuleStates map[string][]byte
 	transientAccessLists  *accessList
 	surplus               sdk.Int // in wei
+
+	// every MarkAccount call that has not been reverted, along with the snapshot it
+	// was made under
+	accountMarks []accountMark
+}
+
+type accountMark struct {
+	revision int
+	acc      string
+	status   []byte
 }
 
 func NewTemporaryState() *TemporaryState {
@@ -229,3 +241,34 @@ func NewTemporaryState() *TemporaryState {
 		surplus:               utils.Sdk0,
 	}
 }
+
+func (ts *TemporaryState) copy() *TemporaryState {
+	c := NewTemporaryState()
+	c.logs = append(c.logs, ts.logs...)
+	for addr, st := range ts.transientStates {
+		m := make(map[string]common.Hash, len(st))
+		for k, v := range st {
+			m[k] = v
+		}
+		c.transientStates[addr] = m
+	}
+	for k, v := range ts.transientAccounts {
+		c.transientAccounts[k] = v
+	}
+	for k, v := range ts.transientModuleStates {
+		c.transientModuleStates[k] = v
+	}
+	for addr, idx := range ts.transientAccessLists.Addresses {
+		c.transientAccessLists.Addresses[addr] = idx
+	}
+	for _, slots := range ts.transientAccessLists.Slots {
+		m := make(map[common.Hash]struct{}, len(slots))
+		for slot := range slots {
+			m[slot] = struct{}{}
+		}
+		c.transientAccessLists.Slots = append(c.transientAccessLists.Slots, m)
+	}
+	c.surplus = ts.surplus
+	c.accountMarks = append(c.accountMarks, ts.accountMarks...)
+	return c
+}
@@ -0,0 +1,457 @@
+package state_test
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+type storeWrite struct {
+	key     string
+	value   []byte
+	deleted bool
+}
+
+// recordingStore records the writes flushed to a store of the committed context.
+type recordingStore struct {
+	*cachekv.Store
+	name   string
+	writes *[]storeWrite
+}
+
+func (s *recordingStore) Set(key []byte, value []byte) {
+	*s.writes = append(*s.writes, storeWrite{key: s.name + string(key), value: value})
+	s.Store.Set(key, value)
+}
+
+func (s *recordingStore) Delete(key []byte) {
+	*s.writes = append(*s.writes, storeWrite{key: s.name + string(key), deleted: true})
+	s.Store.Delete(key)
+}
+
+func recordingCtx(ctx sdk.Context, writes *[]storeWrite) sdk.Context {
+	cms := ctx.MultiStore().CacheMultiStore()
+	cms.SetKVStores(func(sk storetypes.StoreKey, s storetypes.KVStore) storetypes.CacheWrap {
+		return &recordingStore{Store: s.(*cachekv.Store), name: sk.Name(), writes: writes}
+	})
+	return ctx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+}
+
+// nestedDB is a reference model of the state DB that branches the context on every
+// snapshot, which is how DBImpl used to be implemented.
+type nestedDB struct {
+	k    *keeper.Keeper
+	ctx  sdk.Context
+	ctxs []sdk.Context
+	cur  *nestedLayer
+	hist []*nestedLayer
+}
+
+type nestedLayer struct {
+	transientStates   map[common.Address]map[common.Hash]common.Hash
+	transientAccounts map[common.Address][]byte
+	refund            *uint64
+	logs              int
+	addresses         map[common.Address]struct{}
+	slots             map[common.Address]map[common.Hash]struct{}
+	surplus           sdk.Int
+}
+
+func newNestedLayer() *nestedLayer {
+	return &nestedLayer{
+		transientStates:   map[common.Address]map[common.Hash]common.Hash{},
+		transientAccounts: map[common.Address][]byte{},
+		addresses:         map[common.Address]struct{}{},
+		slots:             map[common.Address]map[common.Hash]struct{}{},
+		surplus:           utils.Sdk0,
+	}
+}
+
+func newNestedDB(ctx sdk.Context, k *keeper.Keeper) *nestedDB {
+	db := &nestedDB{k: k, ctx: ctx, cur: newNestedLayer()}
+	db.Snapshot()
+	return db
+}
+
+func (db *nestedDB) layers() []*nestedLayer {
+	return append(append([]*nestedLayer{}, db.hist...), db.cur)
+}
+
+func (db *nestedDB) Snapshot() int {
+	db.ctxs = append(db.ctxs, db.ctx)
+	db.ctx = db.ctx.WithMultiStore(db.ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
+	db.hist = append(db.hist, db.cur)
+	db.cur = newNestedLayer()
+	return len(db.ctxs) - 1
+}
+
+func (db *nestedDB) RevertToSnapshot(rev int) {
+	db.ctx = db.ctxs[rev]
+	db.ctxs = db.ctxs[:rev]
+	db.cur = db.hist[rev]
+	db.hist = db.hist[:rev]
+	db.Snapshot()
+}
+
+func (db *nestedDB) GetState(addr common.Address, key common.Hash) common.Hash {
+	return db.k.GetState(db.ctx, addr, key)
+}
+
+func (db *nestedDB) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	return db.k.GetState(db.ctxs[0], addr, key)
+}
+
+func (db *nestedDB) GetBalance(addr common.Address) *big.Int {
+	return db.k.GetBalance(db.ctx, db.k.GetSeiAddressOrDefault(db.ctx, addr))
+}
+
+func (db *nestedDB) AddBalance(addr common.Address, amt *big.Int) {
+	usei, wei := state.SplitUseiWeiAmount(amt)
+	seiAddr := db.k.GetSeiAddressOrDefault(db.ctx, addr)
+	if addr == coinbase(db.k, db.ctx) {
+		seiAddr = state.GetCoinbaseAddress(db.ctx.TxIndex())
+	}
+	if err := db.k.BankKeeper().AddCoins(db.ctx, seiAddr, sdk.NewCoins(sdk.NewCoin(db.k.GetBaseDenom(db.ctx), usei)), true); err != nil {
+		panic(err)
+	}
+	if err := db.k.BankKeeper().AddWei(db.ctx, seiAddr, wei); err != nil {
+		panic(err)
+	}
+	db.cur.surplus = db.cur.surplus.Sub(sdk.NewIntFromBigInt(amt))
+}
+
+func (db *nestedDB) SubBalance(addr common.Address, amt *big.Int) {
+	usei, wei := state.SplitUseiWeiAmount(amt)
+	seiAddr := db.k.GetSeiAddressOrDefault(db.ctx, addr)
+	if err := db.k.BankKeeper().SubUnlockedCoins(db.ctx, seiAddr, sdk.NewCoins(sdk.NewCoin(db.k.GetBaseDenom(db.ctx), usei)), true); err != nil {
+		panic(err)
+	}
+	if err := db.k.BankKeeper().SubWei(db.ctx, seiAddr, wei); err != nil {
+		panic(err)
+	}
+	db.cur.surplus = db.cur.surplus.Add(sdk.NewIntFromBigInt(amt))
+}
+
+func (db *nestedDB) clearAccountState(acc common.Address) {
+	db.k.PurgePrefix(db.ctx, types.StateKey(acc))
+	for _, prefix := range [][]byte{types.CodeKeyPrefix, types.CodeSizeKeyPrefix, types.CodeHashKeyPrefix, types.NonceKeyPrefix} {
+		if store := db.k.PrefixStore(db.ctx, prefix); store.Has(acc[:]) {
+			store.Delete(acc[:])
+		}
+	}
+}
+
+func (db *nestedDB) transientAccount(acc common.Address) []byte {
+	layers := db.layers()
+	for i := len(layers) - 1; i >= 0; i-- {
+		if status, ok := layers[i].transientAccounts[acc]; ok {
+			return status
+		}
+	}
+	return nil
+}
+
+func (db *nestedDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	layers := db.layers()
+	for i := len(layers) - 1; i >= 0; i-- {
+		if val, ok := layers[i].transientStates[addr][key]; ok {
+			return val
+		}
+	}
+	return common.Hash{}
+}
+
+func (db *nestedDB) GetRefund() uint64 {
+	layers := db.layers()
+	for i := len(layers) - 1; i >= 0; i-- {
+		if layers[i].refund != nil {
+			return *layers[i].refund
+		}
+	}
+	return 0
+}
+
+func (db *nestedDB) LogCount() int {
+	count := 0
+	for _, l := range db.layers() {
+		count += l.logs
+	}
+	return count
+}
+
+func (db *nestedDB) SlotInAccessList(addr common.Address, slot common.Hash) (bool, bool) {
+	addrOk, slotOk := false, false
+	for _, l := range db.layers() {
+		_, ok := l.addresses[addr]
+		addrOk = addrOk || ok
+		_, ok = l.slots[addr][slot]
+		slotOk = slotOk || ok
+	}
+	return addrOk, slotOk
+}
+
+func (db *nestedDB) Events() sdk.Events {
+	events := sdk.Events{}
+	for _, ctx := range db.ctxs[1:] {
+		events = append(events, ctx.EventManager().Events()...)
+	}
+	return append(events, db.ctx.EventManager().Events()...)
+}
+
+func (db *nestedDB) Finalize() sdk.Int {
+	layers := append([]*nestedLayer{db.cur}, db.hist...)
+	for _, l := range layers {
+		for acc, status := range l.transientAccounts {
+			if !bytes.Equal(status, state.AccountDeleted) {
+				continue
+			}
+			if residual := db.GetBalance(acc); residual.Sign() != 0 {
+				db.SubBalance(acc, residual)
+				db.AddBalance(coinbase(db.k, db.ctx), residual)
+			}
+			db.clearAccountState(acc)
+		}
+	}
+	db.ctx.MultiStore().(sdk.CacheMultiStore).Write()
+	for i := len(db.ctxs) - 1; i > 0; i-- {
+		db.ctxs[i].MultiStore().(sdk.CacheMultiStore).Write()
+	}
+	for i := 1; i < len(db.ctxs); i++ {
+		db.ctxs[0].EventManager().EmitEvents(db.ctxs[i].EventManager().Events())
+	}
+	db.ctxs[0].EventManager().EmitEvents(db.ctx.EventManager().Events())
+	surplus := utils.Sdk0
+	for _, l := range layers {
+		surplus = surplus.Add(l.surplus)
+	}
+	return surplus
+}
+
+func coinbase(k *keeper.Keeper, ctx sdk.Context) common.Address {
+	addr, _ := k.GetFeeCollectorAddress(ctx)
+	return addr
+}
+
+func FuzzStateDBParity(f *testing.F) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 64; i++ {
+		ops := make([]byte, 64+r.Intn(512))
+		r.Read(ops)
+		f.Add(ops)
+	}
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	addrs := []common.Address{common.HexToAddress("0xa1"), common.HexToAddress("0xa2"), common.HexToAddress("0xa3")}
+	keys := []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2"), common.HexToHash("0x3")}
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+		// seed some committed state so that reads fall through to the committed context
+		for i, addr := range addrs {
+			k.SetState(ctx, addr, keys[0], common.BytesToHash([]byte{byte(i + 1)}))
+			k.SetNonce(ctx, addr, uint64(i))
+			require.Nil(t, k.BankKeeper().AddCoins(ctx, k.GetSeiAddressOrDefault(ctx, addr), sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(100))), true))
+		}
+		var refWrites, writes []storeWrite
+		refCtx, ctx := recordingCtx(ctx, &refWrites), recordingCtx(ctx, &writes)
+		ref := newNestedDB(refCtx, k)
+		db := state.NewDBImpl(ctx, k, false)
+
+		pos := 0
+		next := func() byte {
+			if pos >= len(ops) {
+				return 0
+			}
+			pos++
+			return ops[pos-1]
+		}
+		for pos < len(ops) {
+			addr, key, val := addrs[int(next())%len(addrs)], keys[int(next())%len(keys)], common.BytesToHash([]byte{next()})
+			switch next() % 16 {
+			case 0, 1:
+				k.SetState(ref.ctx, addr, key, val)
+				db.SetState(addr, key, val)
+			case 2:
+				k.SetNonce(ref.ctx, addr, uint64(val[31]))
+				db.SetNonce(addr, uint64(val[31]))
+			case 3:
+				k.SetCode(ref.ctx, addr, val[31:])
+				db.SetCode(addr, val[31:])
+			case 4:
+				amt := big.NewInt(int64(val[31]) * 1_000_000_000_001)
+				if amt.Sign() > 0 {
+					ref.AddBalance(addr, amt)
+				}
+				db.AddBalance(addr, amt, tracing.BalanceChangeUnspecified)
+			case 5:
+				amt := new(big.Int).Div(ref.GetBalance(addr), big.NewInt(int64(val[31]%4)+1))
+				if amt.Sign() > 0 {
+					ref.SubBalance(addr, amt)
+				}
+				db.SubBalance(addr, amt, tracing.BalanceChangeUnspecified)
+			case 6:
+				if _, ok := ref.cur.transientStates[addr]; !ok {
+					ref.cur.transientStates[addr] = map[common.Hash]common.Hash{}
+				}
+				ref.cur.transientStates[addr][key] = val
+				db.SetTransientState(addr, key, val)
+			case 7:
+				refund := ref.GetRefund() + uint64(val[31])
+				ref.cur.refund = &refund
+				db.AddRefund(uint64(val[31]))
+			case 8:
+				ref.cur.logs++
+				db.AddLog(&ethtypes.Log{Address: addr})
+			case 9:
+				ref.cur.addresses[addr] = struct{}{}
+				db.AddAddressToAccessList(addr)
+			case 10:
+				ref.cur.addresses[addr] = struct{}{}
+				if _, ok := ref.cur.slots[addr]; !ok {
+					ref.cur.slots[addr] = map[common.Hash]struct{}{}
+				}
+				ref.cur.slots[addr][key] = struct{}{}
+				db.AddSlotToAccessList(addr, key)
+			case 11:
+				ref.clearAccountState(addr)
+				ref.cur.transientAccounts[addr] = state.AccountCreated
+				db.CreateAccount(addr)
+			case 12:
+				if seiAddr, ok := k.GetSeiAddress(ref.ctx, addr); ok {
+					k.DeleteAddressMapping(ref.ctx, seiAddr, addr)
+				}
+				if balance := ref.GetBalance(addr); balance.Sign() > 0 {
+					ref.SubBalance(addr, balance)
+				}
+				ref.cur.transientAccounts[addr] = state.AccountDeleted
+				db.SelfDestruct(addr)
+			case 13:
+				if val[31]%2 == 0 {
+					require.Equal(t, ref.Snapshot(), db.Snapshot())
+				} else {
+					rev := int(val[31]) % len(ref.ctxs)
+					ref.RevertToSnapshot(rev)
+					db.RevertToSnapshot(rev)
+				}
+			case 14:
+				event := sdk.NewEvent("fuzz", sdk.NewAttribute("value", val.Hex()))
+				ref.ctx.EventManager().EmitEvent(event)
+				db.Ctx().EventManager().EmitEvent(event)
+			case 15:
+				// open-ended ranges must cover the keys buffered in the journal as well
+				start, end := []byte(nil), []byte{types.StateKeyPrefix[0] + 1}
+				if val[31]%2 == 1 {
+					start, end = types.StateKeyPrefix, nil
+				}
+				// the reference deletes what an iterator over the range sees, since the cache
+				// store's own DeleteAll shares the bug
+				refStore := ref.ctx.KVStore(k.GetStoreKey())
+				iter := refStore.Iterator(start, end)
+				var refKeys [][]byte
+				for ; iter.Valid(); iter.Next() {
+					refKeys = append(refKeys, iter.Key())
+				}
+				require.Nil(t, iter.Close())
+				for _, key := range refKeys {
+					refStore.Delete(key)
+				}
+				require.Nil(t, db.Ctx().KVStore(k.GetStoreKey()).DeleteAll(start, end))
+			}
+			require.Nil(t, db.Err())
+
+			for _, a := range addrs {
+				require.Equal(t, ref.GetBalance(a), db.GetBalance(a))
+				require.Equal(t, k.GetNonce(ref.ctx, a), db.GetNonce(a))
+				require.Equal(t, k.GetCode(ref.ctx, a), db.GetCode(a))
+				require.Equal(t, k.GetCodeHash(ref.ctx, a), db.GetCodeHash(a))
+				require.Equal(t, ref.transientAccount(a) != nil && bytes.Equal(ref.transientAccount(a), state.AccountDeleted), db.HasSelfDestructed(a))
+				require.Equal(t, ref.transientAccount(a) != nil && bytes.Equal(ref.transientAccount(a), state.AccountCreated), db.Created(a))
+				refAddrOk, _ := ref.SlotInAccessList(a, common.Hash{})
+				require.Equal(t, refAddrOk, db.AddressInAccessList(a))
+				for _, s := range keys {
+					require.Equal(t, ref.GetState(a, s), db.GetState(a, s))
+					require.Equal(t, ref.GetCommittedState(a, s), db.GetCommittedState(a, s))
+					require.Equal(t, ref.GetTransientState(a, s), db.GetTransientState(a, s))
+					refAddrOk, refSlotOk := ref.SlotInAccessList(a, s)
+					addrOk, slotOk := db.SlotInAccessList(a, s)
+					require.Equal(t, refAddrOk, addrOk)
+					require.Equal(t, refSlotOk, slotOk)
+				}
+			}
+			require.Equal(t, ref.GetRefund(), db.GetRefund())
+			require.Equal(t, ref.LogCount(), len(db.GetAllLogs()))
+		}
+
+		require.Equal(t, ref.Events(), db.Ctx().EventManager().Events())
+		refSurplus := ref.Finalize()
+		surplus, err := db.Finalize()
+		require.Nil(t, err)
+		require.Equal(t, refSurplus, surplus)
+		// the residual funds of destructed accounts used to be moved in map order
+		require.ElementsMatch(t, refCtx.EventManager().Events(), ctx.EventManager().Events())
+		// the committed context receives exactly the same writes, including writes that
+		// do not change the value
+		sortWrites := func(w []storeWrite) {
+			sort.SliceStable(w, func(i, j int) bool { return w[i].key < w[j].key })
+		}
+		sortWrites(refWrites)
+		sortWrites(writes)
+		require.Equal(t, refWrites, writes)
+	})
+}
+
+func TestJournalIterator(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	addr := common.HexToAddress("0xb1")
+	slot := func(i byte) common.Hash { return common.BytesToHash([]byte{i}) }
+	for i := byte(1); i <= 4; i++ {
+		k.SetState(ctx, addr, slot(i), slot(i))
+	}
+	db := state.NewDBImpl(ctx, k, false)
+	db.SetState(addr, slot(5), slot(5))
+	rev := db.Snapshot()
+	db.SetState(addr, slot(3), slot(9))
+	db.SetState(addr, slot(6), slot(6))
+
+	collect := func(reverse bool) []common.Hash {
+		store := k.PrefixStore(db.Ctx(), types.StateKey(addr))
+		iter := store.Iterator(nil, nil)
+		if reverse {
+			iter = store.ReverseIterator(nil, nil)
+		}
+		defer func() { _ = iter.Close() }()
+		res := []common.Hash{}
+		for ; iter.Valid(); iter.Next() {
+			res = append(res, common.BytesToHash(iter.Value()))
+		}
+		return res
+	}
+	require.Equal(t, []common.Hash{slot(1), slot(2), slot(9), slot(4), slot(5), slot(6)}, collect(false))
+	require.Equal(t, []common.Hash{slot(6), slot(5), slot(4), slot(9), slot(2), slot(1)}, collect(true))
+
+	db.RevertToSnapshot(rev)
+	require.Equal(t, []common.Hash{slot(1), slot(2), slot(3), slot(4), slot(5)}, collect(false))
+
+	// purging iterates over the buffered keys as well
+	db.CreateAccount(addr)
+	require.Empty(t, collect(false))
+	_, err := db.Finalize()
+	require.Nil(t, err)
+	require.Equal(t, common.Hash{}, k.GetState(ctx, addr, slot(1)))
+}
@@ -49,6 +49,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&AddCWERC721PointerProposal{},
 		&AddCWERC1155PointerProposal{},
 		&AddERCNativePointerProposalV2{},
+		&RemovePointerProposal{},
+		&MigratePointerProposal{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TxConditionsMemoPrefix prefixes the memo of a Cosmos transaction wrapping an EVM
+// transaction that may only be executed if the JSON encoded TxConditions that follow hold.
+const TxConditionsMemoPrefix = "evm-conditions:"
+
+// MaxTxConditionsCost caps the number of state reads a transaction's conditions may
+// require, so that checking them stays cheap.
+const MaxTxConditionsCost = 1000
+
+var ErrTxConditionsNotMet = sdkerrors.Register(ModuleName, 2, "transaction conditions not met")
+
+// TxConditions are the preconditions of a conditional transaction, following the
+// ERC-4337 eth_sendRawTransactionConditional options. They are part of the Cosmos
+// transaction wrapping the EVM transaction, so every validator checks them at delivery.
+// The EVM signature does not cover them: they bind the wrapper they were submitted in,
+// not other submissions of the same signed EVM transaction.
+type TxConditions struct {
+	KnownAccounts  map[common.Address]KnownAccount   `json:"knownAccounts,omitempty"`
+	KnownNonces    map[common.Address]hexutil.Uint64 `json:"knownNonces,omitempty"`
+	BlockNumberMin *hexutil.Uint64                   `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Uint64                   `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                   `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                   `json:"timestampMax,omitempty"`
+}
+
+// KnownAccount is the expected storage of an account. ERC-4337 also allows the storage
+// root of the account, which is not supported since accounts have no storage trie here.
+type KnownAccount struct {
+	StorageRoot  *common.Hash
+	StorageSlots map[common.Hash]common.Hash
+}
+
+func (a KnownAccount) MarshalJSON() ([]byte, error) {
+	if a.StorageRoot != nil {
+		return json.Marshal(a.StorageRoot)
+	}
+	return json.Marshal(a.StorageSlots)
+}
+
+func (a *KnownAccount) UnmarshalJSON(bz []byte) error {
+	var root common.Hash
+	if err := json.Unmarshal(bz, &root); err == nil {
+		a.StorageRoot = &root
+		return nil
+	}
+	return json.Unmarshal(bz, &a.StorageSlots)
+}
+
+// Cost returns the number of state reads needed to check the conditions.
+func (c *TxConditions) Cost() int {
+	cost := len(c.KnownNonces)
+	for _, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			cost++
+		}
+		cost += len(account.StorageSlots)
+	}
+	return cost
+}
+
+// Validate checks that the conditions are well formed and can be checked.
+func (c *TxConditions) Validate() error {
+	if cost := c.Cost(); cost > MaxTxConditionsCost {
+		return fmt.Errorf("conditions require %d state reads, limit %d", cost, MaxTxConditionsCost)
+	}
+	for addr, account := range c.KnownAccounts {
+		if account.StorageRoot != nil {
+			return fmt.Errorf("storage root condition on %s is not supported, specify storage slots instead", addr.Hex())
+		}
+	}
+	if c.BlockNumberMin != nil && c.BlockNumberMax != nil && *c.BlockNumberMin > *c.BlockNumberMax {
+		return errors.New("blockNumberMin is greater than blockNumberMax")
+	}
+	if c.TimestampMin != nil && c.TimestampMax != nil && *c.TimestampMin > *c.TimestampMax {
+		return errors.New("timestampMin is greater than timestampMax")
+	}
+	return nil
+}
+
+// Check returns ErrTxConditionsNotMet if the conditions do not hold in ctx. getState and
+// getNonce read the current storage and nonce of an account.
+func (c *TxConditions) Check(
+	ctx sdk.Context,
+	getState func(sdk.Context, common.Address, common.Hash) common.Hash,
+	getNonce func(sdk.Context, common.Address) uint64,
+) error {
+	if err := c.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrTxConditionsNotMet, err.Error())
+	}
+	height := uint64(ctx.BlockHeight())
+	if c.BlockNumberMin != nil && height < uint64(*c.BlockNumberMin) {
+		return sdkerrors.Wrapf(ErrTxConditionsNotMet, "block number %d is below %d", height, *c.BlockNumberMin)
+	}
+	if c.BlockNumberMax != nil && height > uint64(*c.BlockNumberMax) {
+		return sdkerrors.Wrapf(ErrTxConditionsNotMet, "block number %d is above %d", height, *c.BlockNumberMax)
+	}
+	timestamp := uint64(ctx.BlockTime().Unix())
+	if c.TimestampMin != nil && timestamp < uint64(*c.TimestampMin) {
+		return sdkerrors.Wrapf(ErrTxConditionsNotMet, "timestamp %d is below %d", timestamp, *c.TimestampMin)
+	}
+	if c.TimestampMax != nil && timestamp > uint64(*c.TimestampMax) {
+		return sdkerrors.Wrapf(ErrTxConditionsNotMet, "timestamp %d is above %d", timestamp, *c.TimestampMax)
+	}
+	// conditions are checked in a fixed order so that every validator fails on the same one
+	for _, addr := range sortedKeys(c.KnownNonces) {
+		if actual, nonce := getNonce(ctx, addr), c.KnownNonces[addr]; actual != uint64(nonce) {
+			return sdkerrors.Wrapf(ErrTxConditionsNotMet, "nonce of %s is %d, expected %d", addr.Hex(), actual, nonce)
+		}
+	}
+	for _, addr := range sortedKeys(c.KnownAccounts) {
+		slots := c.KnownAccounts[addr].StorageSlots
+		for _, slot := range sortedKeys(slots) {
+			if actual := getState(ctx, addr, slot); actual != slots[slot] {
+				return sdkerrors.Wrapf(ErrTxConditionsNotMet, "storage slot %s of %s is %s, expected %s", slot.Hex(), addr.Hex(), actual.Hex(), slots[slot].Hex())
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys[K interface {
+	comparable
+	Bytes() []byte
+}, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0 })
+	return keys
+}
+
+// ToMemo encodes the conditions as the memo of the Cosmos transaction wrapping the
+// conditional transaction.
+func (c *TxConditions) ToMemo() (string, error) {
+	bz, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return TxConditionsMemoPrefix + string(bz), nil
+}
+
+// GetTxConditions returns the conditions attached to tx, or nil if it has none.
+func GetTxConditions(tx sdk.Tx) (*TxConditions, error) {
+	txWithMemo, ok := tx.(sdk.TxWithMemo)
+	if !ok || !strings.HasPrefix(txWithMemo.GetMemo(), TxConditionsMemoPrefix) {
+		return nil, nil
+	}
+	conditions := &TxConditions{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(txWithMemo.GetMemo(), TxConditionsMemoPrefix)), conditions); err != nil {
+		return nil, sdkerrors.Wrap(ErrTxConditionsNotMet, fmt.Sprintf("invalid conditions: %s", err))
+	}
+	return conditions, nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxConditionsJSON(t *testing.T) {
+	raw := `{
+		"knownAccounts": {
+			"0x1234567890123456789012345678901234567890": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000002"},
+			"0x0000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000003"
+		},
+		"blockNumberMin": "0x1",
+		"timestampMax": "0x10"
+	}`
+	conditions := types.TxConditions{}
+	require.Nil(t, json.Unmarshal([]byte(raw), &conditions))
+	slots := conditions.KnownAccounts[common.HexToAddress("0x1234567890123456789012345678901234567890")].StorageSlots
+	require.Equal(t, common.HexToHash("0x2"), slots[common.HexToHash("0x1")])
+	root := conditions.KnownAccounts[common.HexToAddress("0x1")].StorageRoot
+	require.Equal(t, common.HexToHash("0x3"), *root)
+	require.Equal(t, hexutil.Uint64(1), *conditions.BlockNumberMin)
+	require.Equal(t, hexutil.Uint64(16), *conditions.TimestampMax)
+	require.Equal(t, 2, conditions.Cost())
+	require.NotNil(t, conditions.Validate())
+
+	delete(conditions.KnownAccounts, common.HexToAddress("0x1"))
+	require.Nil(t, conditions.Validate())
+	memo, err := conditions.ToMemo()
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(memo, types.TxConditionsMemoPrefix))
+	decoded := types.TxConditions{}
+	require.Nil(t, json.Unmarshal([]byte(strings.TrimPrefix(memo, types.TxConditionsMemoPrefix)), &decoded))
+	require.Equal(t, conditions, decoded)
+}
+
+func TestTxConditionsValidate(t *testing.T) {
+	slots := map[common.Hash]common.Hash{}
+	for i := 0; i <= types.MaxTxConditionsCost; i++ {
+		slots[common.BigToHash(big.NewInt(int64(i)))] = common.Hash{}
+	}
+	conditions := types.TxConditions{KnownAccounts: map[common.Address]types.KnownAccount{{}: {StorageSlots: slots}}}
+	require.Contains(t, conditions.Validate().Error(), "state reads")
+
+	min, max := hexutil.Uint64(2), hexutil.Uint64(1)
+	conditions = types.TxConditions{BlockNumberMin: &min, BlockNumberMax: &max}
+	require.NotNil(t, conditions.Validate())
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/tracing"
+)
+
+// Types of the frames reported to a CrossVMTracer.
+const (
+	CrossVMWasmExecute     = "WASM_EXECUTE"
+	CrossVMWasmInstantiate = "WASM_INSTANTIATE"
+	CrossVMWasmQuery       = "WASM_QUERY"
+)
+
+// CrossVMFrame is a call made outside of the EVM while an EVM transaction is traced, e.g.
+// the execution of a CosmWasm contract by the wasmd precompile. Addresses are in the
+// format of the VM being called.
+type CrossVMFrame struct {
+	Type  string
+	From  string
+	To    string
+	Input []byte
+	Value *big.Int
+}
+
+// CrossVMTracer is implemented by EVM tracers that also follow execution across VMs. Frames
+// are nested under the EVM call that is being executed when they are entered, and EVM calls
+// made back into the EVM are reported to NestedHooks.
+type CrossVMTracer interface {
+	OnCrossVMEnter(frame CrossVMFrame)
+	OnCrossVMExit(output []byte, err error)
+	// OnCosmosEvent is called with the events emitted by the Cosmos modules a precompile
+	// called into.
+	OnCosmosEvent(event sdk.Event)
+	// NestedHooks returns the hooks of the EVMs created for calls made back into the EVM.
+	// Their depths start at 0 again.
+	NestedHooks() *tracing.Hooks
+}
+
+type crossVMTracerKey struct{}
+
+// crossVMTracers maps the hooks handed to the EVM to the CrossVMTracer they belong to,
+// since only the hooks reach the state DB.
+var crossVMTracers sync.Map
+
+// RegisterCrossVMTracer associates hooks with t until UnregisterCrossVMTracer is called.
+func RegisterCrossVMTracer(hooks *tracing.Hooks, t CrossVMTracer) {
+	crossVMTracers.Store(hooks, t)
+}
+
+func UnregisterCrossVMTracer(hooks *tracing.Hooks) {
+	crossVMTracers.Delete(hooks)
+}
+
+// GetCrossVMTracerForHooks returns the CrossVMTracer hooks belong to, if any.
+func GetCrossVMTracerForHooks(hooks *tracing.Hooks) CrossVMTracer {
+	if hooks == nil {
+		return nil
+	}
+	t, ok := crossVMTracers.Load(hooks)
+	if !ok {
+		return nil
+	}
+	return t.(CrossVMTracer)
+}
+
+func WithCrossVMTracer(ctx sdk.Context, t CrossVMTracer) sdk.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context(), crossVMTracerKey{}, t))
+}
+
+// GetCrossVMTracer returns the tracer following the execution of ctx, or nil if it is not
+// traced.
+func GetCrossVMTracer(ctx sdk.Context) CrossVMTracer {
+	if ctx.Context() == nil {
+		return nil
+	}
+	t, _ := ctx.Context().Value(crossVMTracerKey{}).(CrossVMTracer)
+	return t
+}
+
+// GetNestedTracingHooks returns the hooks to attach to an EVM created while executing ctx.
+func GetNestedTracingHooks(ctx sdk.Context) *tracing.Hooks {
+	if t := GetCrossVMTracer(ctx); t != nil {
+		return t.NestedHooks()
+	}
+	return nil
+}
+
+// TraceCrossVMCall runs call within the frame built by frame if ctx is traced. frame is
+// only called when it is. The frame is exited even if call panics, e.g. by running out of
+// gas, so that the frames entered later are not nested under it.
+func TraceCrossVMCall(ctx sdk.Context, frame func() CrossVMFrame, call func() ([]byte, error)) (res []byte, err error) {
+	t := GetCrossVMTracer(ctx)
+	if t == nil {
+		return call()
+	}
+	t.OnCrossVMEnter(frame())
+	defer func() {
+		if r := recover(); r != nil {
+			t.OnCrossVMExit(nil, fmt.Errorf("%v", r))
+			panic(r)
+		}
+		t.OnCrossVMExit(res, err)
+	}()
+	return call()
+}
+
+// TraceCosmosEvents reports events to the tracer of ctx, if any.
+func TraceCosmosEvents(ctx sdk.Context, events sdk.Events) {
+	t := GetCrossVMTracer(ctx)
+	if t == nil {
+		return
+	}
+	for _, event := range events {
+		t.OnCosmosEvent(event)
+	}
+}
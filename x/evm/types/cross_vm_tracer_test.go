@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingTracer struct {
+	calls []string
+}
+
+func (r *recordingTracer) OnCrossVMEnter(frame types.CrossVMFrame) {
+	r.calls = append(r.calls, "enter "+frame.Type+" "+frame.To)
+}
+
+func (r *recordingTracer) OnCrossVMExit(output []byte, err error) {
+	r.calls = append(r.calls, "exit "+string(output)+" "+err.Error())
+}
+
+func (r *recordingTracer) OnCosmosEvent(event sdk.Event) {
+	r.calls = append(r.calls, "event "+event.Type)
+}
+
+func (r *recordingTracer) NestedHooks() *tracing.Hooks {
+	return &tracing.Hooks{}
+}
+
+func TestTraceCrossVMCall(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	call := func() ([]byte, error) { return []byte("out"), errors.New("failed") }
+	built := 0
+	frame := func() types.CrossVMFrame {
+		built++
+		return types.CrossVMFrame{Type: types.CrossVMWasmExecute, To: "sei1contract"}
+	}
+
+	// untraced contexts only run the call, without building its frame
+	res, err := types.TraceCrossVMCall(ctx, frame, call)
+	require.Equal(t, []byte("out"), res)
+	require.NotNil(t, err)
+	require.Zero(t, built)
+	require.Nil(t, types.GetNestedTracingHooks(ctx))
+	types.TraceCosmosEvents(ctx, sdk.Events{sdk.NewEvent("transfer")})
+
+	tracer := &recordingTracer{}
+	hooks := &tracing.Hooks{}
+	types.RegisterCrossVMTracer(hooks, tracer)
+	require.Equal(t, tracer, types.GetCrossVMTracerForHooks(hooks))
+	require.Nil(t, types.GetCrossVMTracerForHooks(&tracing.Hooks{}))
+	ctx = types.WithCrossVMTracer(ctx, types.GetCrossVMTracerForHooks(hooks))
+	_, _ = types.TraceCrossVMCall(ctx, frame, call)
+	types.TraceCosmosEvents(ctx, sdk.Events{sdk.NewEvent("transfer")})
+	require.NotNil(t, types.GetNestedTracingHooks(ctx))
+	require.Equal(t, []string{"enter WASM_EXECUTE sei1contract", "exit out failed", "event transfer"}, tracer.calls)
+	require.Equal(t, 1, built)
+
+	// frames of calls that panic are exited before the panic propagates
+	tracer.calls = nil
+	require.PanicsWithValue(t, "out of gas", func() {
+		_, _ = types.TraceCrossVMCall(ctx, frame, func() ([]byte, error) { panic("out of gas") })
+	})
+	require.Equal(t, []string{"enter WASM_EXECUTE sei1contract", "exit  out of gas"}, tracer.calls)
+
+	types.UnregisterCrossVMTracer(hooks)
+	require.Nil(t, types.GetCrossVMTracerForHooks(hooks))
+}
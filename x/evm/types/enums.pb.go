@@ -60,26 +60,66 @@ func (PointerType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9ba0923a26222f98, []int{0}
 }
 
+// BaseFeeAdjustmentCurve is the curve along which the dynamic base fee moves
+// towards the gas target.
+type BaseFeeAdjustmentCurve int32
+
+const (
+	// the base fee moves linearly with the distance to the target, within the
+	// max upward and downward adjustments
+	BaseFeeAdjustmentCurve_LINEAR BaseFeeAdjustmentCurve = 0
+	// the base fee grows exponentially with the excess gas accumulated above
+	// the target, like EIP-4844 blob fees
+	BaseFeeAdjustmentCurve_EXPONENTIAL BaseFeeAdjustmentCurve = 1
+	// the base fee is driven by a PID controller on the relative distance to
+	// the target
+	BaseFeeAdjustmentCurve_PID BaseFeeAdjustmentCurve = 2
+)
+
+var BaseFeeAdjustmentCurve_name = map[int32]string{
+	0: "LINEAR",
+	1: "EXPONENTIAL",
+	2: "PID",
+}
+
+var BaseFeeAdjustmentCurve_value = map[string]int32{
+	"LINEAR":      0,
+	"EXPONENTIAL": 1,
+	"PID":         2,
+}
+
+func (x BaseFeeAdjustmentCurve) String() string {
+	return proto.EnumName(BaseFeeAdjustmentCurve_name, int32(x))
+}
+
+func (BaseFeeAdjustmentCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9ba0923a26222f98, []int{1}
+}
+
 func init() {
 	proto.RegisterEnum("seiprotocol.seichain.evm.PointerType", PointerType_name, PointerType_value)
+	proto.RegisterEnum("seiprotocol.seichain.evm.BaseFeeAdjustmentCurve", BaseFeeAdjustmentCurve_name, BaseFeeAdjustmentCurve_value)
 }
 
 func init() { proto.RegisterFile("evm/enums.proto", fileDescriptor_9ba0923a26222f98) }
 
 var fileDescriptor_9ba0923a26222f98 = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x2d, 0xcb, 0xd5,
-	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x28, 0x4e, 0xcd,
-	0x04, 0xb3, 0x92, 0xf3, 0x73, 0xf4, 0x8a, 0x53, 0x33, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x52,
-	0xcb, 0x72, 0xb5, 0xe2, 0xb8, 0xb8, 0x03, 0xf2, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0x42, 0x2a, 0x0b,
-	0x52, 0x85, 0x38, 0xb9, 0x58, 0x5d, 0x83, 0x9c, 0x8d, 0x0c, 0x04, 0x18, 0x84, 0xb8, 0xb8, 0xd8,
-	0x5c, 0x83, 0x9c, 0xcd, 0x8d, 0x0c, 0x05, 0x18, 0x41, 0x6c, 0x3f, 0xc7, 0x10, 0xcf, 0x30, 0x57,
-	0x01, 0x26, 0x21, 0x0e, 0x2e, 0x16, 0xe7, 0x70, 0x23, 0x03, 0x01, 0x66, 0x90, 0x62, 0xe7, 0x70,
-	0x90, 0x02, 0x16, 0x21, 0x6e, 0x2e, 0x76, 0xd7, 0x20, 0x67, 0x43, 0x43, 0x53, 0x53, 0x01, 0x56,
-	0x90, 0x6a, 0xe7, 0x70, 0x30, 0x9b, 0xcd, 0xc9, 0xfd, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0x74, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b,
-	0x53, 0x33, 0x75, 0x61, 0xee, 0x03, 0x73, 0xc0, 0x0e, 0xd4, 0xaf, 0xd0, 0x07, 0x79, 0xa4, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x2c, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x3a,
-	0x55, 0xe4, 0xdc, 0x00, 0x00, 0x00,
+	// 269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x46, 0x93, 0xfe, 0xea, 0x74, 0xd1, 0x61, 0x16, 0xe2, 0x6a, 0x1e, 0xa0, 0xd0, 0xa4, 0x89,
+	0x14, 0x77, 0x42, 0x3a, 0x8e, 0x12, 0x28, 0x31, 0x84, 0x60, 0xc4, 0x85, 0xd0, 0xc6, 0x8b, 0x1d,
+	0x31, 0x3f, 0x64, 0x26, 0xc1, 0xbe, 0x85, 0x8f, 0xe5, 0xb2, 0x4b, 0x97, 0x92, 0xbc, 0x88, 0x4c,
+	0xc0, 0xdd, 0xb9, 0xdc, 0xb3, 0x39, 0x1f, 0x9a, 0x43, 0x93, 0xd9, 0x90, 0xd7, 0x99, 0xb4, 0xca,
+	0xaa, 0x50, 0x05, 0xb9, 0x94, 0x20, 0x7a, 0x4a, 0x8b, 0x0f, 0x4b, 0x82, 0x48, 0x0f, 0x3b, 0x91,
+	0x5b, 0xd0, 0x64, 0x8b, 0x17, 0x34, 0x0b, 0x0b, 0x91, 0x2b, 0xa8, 0xe2, 0x63, 0x09, 0xe4, 0x1c,
+	0x8d, 0x79, 0xc4, 0xdc, 0x15, 0x36, 0x08, 0x42, 0x13, 0x1e, 0xb1, 0x6b, 0xd7, 0xc1, 0xa6, 0xe6,
+	0xc0, 0x8b, 0xfd, 0x47, 0x8e, 0x07, 0xe4, 0x0c, 0x8d, 0x58, 0xe2, 0xae, 0xf0, 0x50, 0xcb, 0x2c,
+	0xd1, 0xc2, 0x88, 0xcc, 0xd0, 0x94, 0x47, 0xcc, 0x71, 0xd6, 0x6b, 0x3c, 0xd6, 0x36, 0x4b, 0x7a,
+	0x9e, 0x2c, 0x6e, 0xd0, 0xc5, 0x66, 0x27, 0xe1, 0x0e, 0xc0, 0x7b, 0x7d, 0xaf, 0xa5, 0xca, 0x20,
+	0x57, 0xac, 0xae, 0x1a, 0xd0, 0xd6, 0xd6, 0x0f, 0xb8, 0x17, 0x61, 0x83, 0xcc, 0xd1, 0x8c, 0x3f,
+	0x85, 0x0f, 0x01, 0x0f, 0x62, 0xdf, 0xdb, 0x62, 0x93, 0x4c, 0xd1, 0x30, 0xf4, 0x6f, 0xf1, 0x60,
+	0x73, 0xff, 0xdd, 0x52, 0xf3, 0xd4, 0x52, 0xf3, 0xb7, 0xa5, 0xe6, 0x57, 0x47, 0x8d, 0x53, 0x47,
+	0x8d, 0x9f, 0x8e, 0x1a, 0xcf, 0xcb, 0x37, 0xa1, 0x0e, 0xf5, 0xde, 0x4a, 0x8b, 0xcc, 0x96, 0x20,
+	0x96, 0xff, 0x7d, 0xfd, 0xd1, 0x07, 0xda, 0x9f, 0xb6, 0x1e, 0x42, 0x1d, 0x4b, 0x90, 0xfb, 0x49,
+	0xff, 0xbf, 0xfa, 0x1b, 0x00, 0xa6, 0x9d, 0x50, 0x72, 0x1c, 0x01, 0x00, 0x00,
 }
@@ -3,6 +3,8 @@ package types
 const (
 	EventTypeAddressAssociated = "address_associated"
 	EventTypePointerRegistered = "pointer_registered"
+	EventTypePointerRemoved    = "pointer_removed"
+	EventTypePointerMigrated   = "pointer_migrated"
 	EventTypeSigner            = "signer"
 
 	AttributeKeySeiAddress     = "sei_addr"
@@ -11,4 +13,6 @@ const (
 	AttributeKeyPointee        = "pointee"
 	AttributeKeyPointerAddress = "pointer_address"
 	AttributeKeyPointerVersion = "pointer_version"
+	AttributeKeyOldPointer     = "old_pointer"
+	AttributeKeyForce          = "force"
 )
@@ -20,6 +20,8 @@ const (
 	ProposalTypeAddCWERC721Pointer    = "AddCWERC721Pointer"
 	ProposalTypeAddCWERC1155Pointer   = "AddCWERC1155Pointer"
 	ProposalTypeAddERCNativePointerV2 = "AddERCNativePointerV2"
+	ProposalTypeRemovePointer         = "RemovePointer"
+	ProposalTypeMigratePointer        = "MigratePointer"
 )
 
 func init() {
@@ -32,6 +34,8 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeAddCWERC721Pointer)
 	govtypes.RegisterProposalType(ProposalTypeAddCWERC1155Pointer)
 	govtypes.RegisterProposalType(ProposalTypeAddERCNativePointerV2)
+	govtypes.RegisterProposalType(ProposalTypeRemovePointer)
+	govtypes.RegisterProposalType(ProposalTypeMigratePointer)
 
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddERCNativePointerProposal{}, "evm/AddERCNativePointerProposal")
@@ -42,6 +46,8 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&AddCWERC721PointerProposal{}, "evm/AddCWERC721PointerProposal")
 	govtypes.RegisterProposalTypeCodec(&AddCWERC1155PointerProposal{}, "evm/AddCWERC1155PointerProposal")
 	govtypes.RegisterProposalTypeCodec(&AddERCNativePointerProposalV2{}, "evm/AddERCNativePointerProposalV2")
+	govtypes.RegisterProposalTypeCodec(&RemovePointerProposal{}, "evm/RemovePointerProposal")
+	govtypes.RegisterProposalTypeCodec(&MigratePointerProposal{}, "evm/MigratePointerProposal")
 }
 
 func (p *AddERCNativePointerProposal) GetTitle() string { return p.Title }
@@ -339,3 +345,98 @@ func (p AddERCNativePointerProposalV2) String() string {
 `, p.Title, p.Description, p.Token, p.Name, p.Symbol, p.Decimals))
 	return b.String()
 }
+
+func (p *RemovePointerProposal) GetTitle() string { return p.Title }
+
+func (p *RemovePointerProposal) GetDescription() string { return p.Description }
+
+func (p *RemovePointerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemovePointerProposal) ProposalType() string {
+	return ProposalTypeRemovePointer
+}
+
+func (p *RemovePointerProposal) ValidateBasic() error {
+	if err := validatePointee(p.PointerType, p.Pointee); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p RemovePointerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove pointer Proposal:
+  Title:       %s
+  Description: %s
+  PointerType: %s
+  Pointee:     %s
+  Force:       %t
+`, p.Title, p.Description, p.PointerType, p.Pointee, p.Force))
+	return b.String()
+}
+
+func (p *MigratePointerProposal) GetTitle() string { return p.Title }
+
+func (p *MigratePointerProposal) GetDescription() string { return p.Description }
+
+func (p *MigratePointerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *MigratePointerProposal) ProposalType() string {
+	return ProposalTypeMigratePointer
+}
+
+func (p *MigratePointerProposal) ValidateBasic() error {
+	if err := validatePointee(p.PointerType, p.Pointee); err != nil {
+		return err
+	}
+
+	switch p.PointerType {
+	case PointerType_ERC20, PointerType_ERC721, PointerType_ERC1155:
+		if _, err := sdk.AccAddressFromBech32(p.NewPointer); err != nil {
+			return err
+		}
+	default:
+		if !common.IsHexAddress(p.NewPointer) {
+			return errors.New("new pointer address must be a valid hex-encoded string")
+		}
+	}
+
+	if p.Version == 0 || p.Version > math.MaxUint16 {
+		return errors.New("pointer version must be > 0 and <= 65535")
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+func (p MigratePointerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Migrate pointer Proposal:
+  Title:       %s
+  Description: %s
+  PointerType: %s
+  Pointee:     %s
+  NewPointer:  %s
+  Version:     %d
+`, p.Title, p.Description, p.PointerType, p.Pointee, p.NewPointer, p.Version))
+	return b.String()
+}
+
+// validatePointee checks that pointee is well-formed for the given pointee type: a denom for
+// native tokens, a bech32 address for CW contracts and a hex address for ERC contracts.
+func validatePointee(pointerType PointerType, pointee string) error {
+	switch pointerType {
+	case PointerType_NATIVE:
+		return sdk.ValidateDenom(pointee)
+	case PointerType_CW20, PointerType_CW721, PointerType_CW1155:
+		_, err := sdk.AccAddressFromBech32(pointee)
+		return err
+	case PointerType_ERC20, PointerType_ERC721, PointerType_ERC1155:
+		if !common.IsHexAddress(pointee) {
+			return errors.New("pointee address must be a valid hex-encoded string")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown pointer type %s", pointerType)
+	}
+}
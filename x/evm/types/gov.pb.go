@@ -344,6 +344,87 @@ func (m *AddERCNativePointerProposalV2) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddERCNativePointerProposalV2 proto.InternalMessageInfo
 
+type RemovePointerProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PointerType PointerType `protobuf:"varint,3,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty" yaml:"pointer_type"`
+	Pointee     string      `protobuf:"bytes,4,opt,name=pointee,proto3" json:"pointee,omitempty" yaml:"pointee"`
+	Force       bool        `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty" yaml:"force"`
+}
+
+func (m *RemovePointerProposal) Reset()      { *m = RemovePointerProposal{} }
+func (*RemovePointerProposal) ProtoMessage() {}
+func (*RemovePointerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb66eb1aab5c39af, []int{8}
+}
+func (m *RemovePointerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovePointerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovePointerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemovePointerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePointerProposal.Merge(m, src)
+}
+func (m *RemovePointerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovePointerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePointerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePointerProposal proto.InternalMessageInfo
+
+type MigratePointerProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	PointerType PointerType `protobuf:"varint,3,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty" yaml:"pointer_type"`
+	Pointee     string      `protobuf:"bytes,4,opt,name=pointee,proto3" json:"pointee,omitempty" yaml:"pointee"`
+	NewPointer  string      `protobuf:"bytes,5,opt,name=new_pointer,json=newPointer,proto3" json:"new_pointer,omitempty" yaml:"new_pointer"`
+	Version     uint32      `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty" yaml:"version"`
+}
+
+func (m *MigratePointerProposal) Reset()      { *m = MigratePointerProposal{} }
+func (*MigratePointerProposal) ProtoMessage() {}
+func (*MigratePointerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb66eb1aab5c39af, []int{9}
+}
+func (m *MigratePointerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratePointerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratePointerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigratePointerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratePointerProposal.Merge(m, src)
+}
+func (m *MigratePointerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratePointerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratePointerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratePointerProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddERCNativePointerProposal)(nil), "seiprotocol.seichain.evm.AddERCNativePointerProposal")
 	proto.RegisterType((*AddERCCW20PointerProposal)(nil), "seiprotocol.seichain.evm.AddERCCW20PointerProposal")
@@ -353,43 +434,52 @@ func init() {
 	proto.RegisterType((*AddCWERC721PointerProposal)(nil), "seiprotocol.seichain.evm.AddCWERC721PointerProposal")
 	proto.RegisterType((*AddCWERC1155PointerProposal)(nil), "seiprotocol.seichain.evm.AddCWERC1155PointerProposal")
 	proto.RegisterType((*AddERCNativePointerProposalV2)(nil), "seiprotocol.seichain.evm.AddERCNativePointerProposalV2")
+	proto.RegisterType((*RemovePointerProposal)(nil), "seiprotocol.seichain.evm.RemovePointerProposal")
+	proto.RegisterType((*MigratePointerProposal)(nil), "seiprotocol.seichain.evm.MigratePointerProposal")
 }
 
 func init() { proto.RegisterFile("evm/gov.proto", fileDescriptor_fb66eb1aab5c39af) }
 
 var fileDescriptor_fb66eb1aab5c39af = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xcf, 0x6a, 0xd4, 0x40,
-	0x1c, 0xc7, 0x93, 0xd8, 0xae, 0x76, 0xda, 0xb5, 0x1a, 0x45, 0x62, 0xc5, 0x4c, 0x19, 0x41, 0x2a,
-	0xd8, 0x8d, 0xbb, 0x52, 0x94, 0xde, 0xdc, 0x50, 0xbc, 0x49, 0x99, 0x83, 0x0b, 0xde, 0xb2, 0xd9,
-	0x1f, 0xdb, 0xc1, 0x24, 0x13, 0x32, 0x31, 0xb8, 0x6f, 0xe0, 0x51, 0x0f, 0xfe, 0x39, 0xee, 0x63,
-	0xf8, 0x08, 0x1e, 0x7b, 0xf4, 0x14, 0x64, 0xf7, 0xe2, 0x39, 0x4f, 0x20, 0x99, 0x49, 0x96, 0xd5,
-	0x80, 0xc7, 0xd2, 0x43, 0x4e, 0x9b, 0xfd, 0x7d, 0x3f, 0x30, 0x33, 0x1f, 0xf8, 0x32, 0x83, 0xba,
-	0x90, 0x85, 0xce, 0x94, 0x67, 0xbd, 0x38, 0xe1, 0x29, 0x37, 0x2d, 0x01, 0x4c, 0x7e, 0xf9, 0x3c,
-	0xe8, 0x09, 0x60, 0xfe, 0x99, 0xc7, 0xa2, 0x1e, 0x64, 0xe1, 0xde, 0xed, 0x29, 0x9f, 0x72, 0x19,
-	0x39, 0xe5, 0x97, 0xe2, 0xc9, 0x27, 0x03, 0xdd, 0x7b, 0x31, 0x99, 0x9c, 0x50, 0xf7, 0x95, 0x97,
-	0xb2, 0x0c, 0x4e, 0x39, 0x8b, 0x52, 0x48, 0x4e, 0x13, 0x1e, 0x73, 0xe1, 0x05, 0xe6, 0x43, 0xb4,
-	0x99, 0xb2, 0x34, 0x00, 0x4b, 0xdf, 0xd7, 0x0f, 0xb6, 0x86, 0x37, 0x8a, 0x1c, 0xef, 0xcc, 0xbc,
-	0x30, 0x38, 0x26, 0x72, 0x4c, 0xa8, 0x8a, 0xcd, 0xe7, 0x68, 0x7b, 0x02, 0xc2, 0x4f, 0x58, 0x9c,
-	0x32, 0x1e, 0x59, 0x86, 0xa4, 0xef, 0x14, 0x39, 0x36, 0x15, 0xbd, 0x16, 0x12, 0xba, 0x8e, 0xca,
-	0x15, 0xf8, 0x5b, 0x88, 0xac, 0x2b, 0x8d, 0x15, 0xca, 0x71, 0xb9, 0x42, 0xf9, 0x6b, 0x3e, 0x46,
-	0x57, 0x63, 0xb5, 0x39, 0x6b, 0x43, 0x92, 0x66, 0x91, 0xe3, 0xeb, 0x8a, 0xac, 0x02, 0x42, 0x6b,
-	0xa4, 0xa4, 0x33, 0x48, 0x44, 0xb9, 0x97, 0xcd, 0x7d, 0xfd, 0xa0, 0xbb, 0x4e, 0x57, 0x01, 0xa1,
-	0x35, 0x72, 0xbc, 0xf3, 0x61, 0x8e, 0xb5, 0x6f, 0x73, 0xac, 0xfd, 0x9e, 0x63, 0x8d, 0x7c, 0x36,
-	0xd0, 0x5d, 0xe5, 0xc4, 0x1d, 0x0d, 0x9e, 0x5c, 0xbc, 0x91, 0xd5, 0x49, 0xa1, 0x72, 0xd2, 0x38,
-	0x29, 0xac, 0x4e, 0x0a, 0x17, 0xe8, 0xe5, 0x8b, 0x81, 0xf6, 0x6a, 0x2f, 0xcf, 0x06, 0xfd, 0x56,
-	0x4c, 0x2d, 0xe6, 0xeb, 0xaa, 0x44, 0xee, 0xa8, 0xdf, 0x3f, 0x3a, 0x6a, 0xcd, 0xfc, 0x53, 0x25,
-	0x77, 0x74, 0x42, 0xdd, 0xb6, 0x4a, 0x8d, 0x2a, 0x49, 0x2f, 0x6d, 0x95, 0x9a, 0x55, 0x92, 0x62,
-	0xda, 0x2a, 0xad, 0x9b, 0xf9, 0x6e, 0xa0, 0xfb, 0xff, 0xb9, 0xa9, 0x5f, 0x0f, 0x2e, 0xd1, 0x5d,
-	0xfd, 0x00, 0x6d, 0x44, 0x5e, 0x08, 0x95, 0x92, 0xdd, 0x22, 0xc7, 0xdb, 0x0a, 0x2b, 0xa7, 0x84,
-	0xca, 0xd0, 0x7c, 0x84, 0x3a, 0x62, 0x16, 0x8e, 0x79, 0x20, 0x5d, 0x6c, 0x0d, 0x6f, 0x16, 0x39,
-	0xee, 0x2a, 0x4c, 0xcd, 0x09, 0xad, 0x00, 0xd3, 0x41, 0xd7, 0x26, 0xe0, 0xb3, 0xd0, 0x0b, 0x84,
-	0xd5, 0x91, 0xe2, 0x6e, 0x15, 0x39, 0xde, 0xad, 0xb7, 0xab, 0x12, 0x42, 0x57, 0xd0, 0xdf, 0xea,
-	0x86, 0x2f, 0x7f, 0x2c, 0x6c, 0xfd, 0x7c, 0x61, 0xeb, 0xbf, 0x16, 0xb6, 0xfe, 0x71, 0x69, 0x6b,
-	0xe7, 0x4b, 0x5b, 0xfb, 0xb9, 0xb4, 0xb5, 0x37, 0x87, 0x53, 0x96, 0x9e, 0xbd, 0x1b, 0xf7, 0x7c,
-	0x1e, 0x3a, 0x02, 0xd8, 0x61, 0xfd, 0x74, 0x92, 0x7f, 0xe4, 0xdb, 0xc9, 0x79, 0xef, 0x94, 0x2f,
-	0xac, 0x74, 0x16, 0x83, 0x18, 0x77, 0x64, 0xfe, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x81,
-	0x75, 0xa1, 0x20, 0x75, 0x09, 0x00, 0x00,
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x6d, 0xb7, 0xc9, 0xb7, 0xbd, 0x34, 0xcd, 0x17, 0x17, 0x8a, 0x09, 0xc2, 0xae, 0x0e,
+	0x51, 0x15, 0x89, 0xda, 0x24, 0xa8, 0x2a, 0xea, 0x46, 0xa2, 0x8a, 0x09, 0x14, 0x9d, 0x10, 0x91,
+	0x58, 0x2a, 0xc7, 0x39, 0xd2, 0x13, 0xb6, 0xcf, 0xb2, 0xdd, 0x94, 0x6c, 0x8c, 0x8c, 0x30, 0xf0,
+	0x63, 0xcc, 0x9f, 0xc1, 0xc8, 0xc8, 0xd8, 0x91, 0xc9, 0x42, 0xc9, 0xc2, 0xec, 0xbf, 0x00, 0xf9,
+	0xce, 0x0e, 0x26, 0x11, 0x20, 0x96, 0xc2, 0xe0, 0x29, 0xce, 0xfb, 0x3c, 0xc9, 0xdd, 0xfb, 0xf1,
+	0xfb, 0xd8, 0x07, 0xaa, 0x78, 0xe8, 0x18, 0x03, 0x3a, 0xd4, 0x3d, 0x9f, 0x86, 0x54, 0x56, 0x02,
+	0x4c, 0xd8, 0x95, 0x45, 0x6d, 0x3d, 0xc0, 0xc4, 0x3a, 0x36, 0x89, 0xab, 0xe3, 0xa1, 0x53, 0xbf,
+	0x38, 0xa0, 0x03, 0xca, 0x24, 0x23, 0xb9, 0xe2, 0xfe, 0x7a, 0x2d, 0xf9, 0x39, 0x76, 0x4f, 0x9c,
+	0x80, 0x17, 0xe0, 0x6b, 0x09, 0x5c, 0xbd, 0xd7, 0xef, 0x1f, 0xa2, 0xf6, 0x43, 0x33, 0x24, 0x43,
+	0xdc, 0xa1, 0xc4, 0x0d, 0xb1, 0xdf, 0xf1, 0xa9, 0x47, 0x03, 0xd3, 0x96, 0xb7, 0x41, 0x29, 0x24,
+	0xa1, 0x8d, 0x15, 0x71, 0x4b, 0xdc, 0x59, 0x6d, 0xfd, 0x1f, 0x47, 0xda, 0xda, 0xc8, 0x74, 0xec,
+	0x03, 0xc8, 0xca, 0x10, 0x71, 0x59, 0xbe, 0x0b, 0x2a, 0x7d, 0x1c, 0x58, 0x3e, 0xf1, 0x42, 0x42,
+	0x5d, 0x45, 0x62, 0xee, 0xcd, 0x38, 0xd2, 0x64, 0xee, 0xce, 0x89, 0x10, 0xe5, 0xad, 0x6c, 0x05,
+	0xfa, 0x0c, 0xbb, 0xca, 0xd2, 0xc2, 0x0a, 0x49, 0x39, 0x59, 0x21, 0xf9, 0x94, 0x6f, 0x81, 0xff,
+	0x3c, 0xbe, 0x39, 0x65, 0x99, 0x39, 0xe5, 0x38, 0xd2, 0xd6, 0xb9, 0x33, 0x15, 0x20, 0xca, 0x2c,
+	0x89, 0x7b, 0x88, 0xfd, 0x20, 0xd9, 0x4b, 0x69, 0x4b, 0xdc, 0xa9, 0xe6, 0xdd, 0xa9, 0x00, 0x51,
+	0x66, 0x39, 0x58, 0x7b, 0x39, 0xd6, 0x84, 0xf7, 0x63, 0x4d, 0xf8, 0x3a, 0xd6, 0x04, 0xf8, 0x46,
+	0x02, 0x57, 0x38, 0x93, 0x76, 0xb7, 0x79, 0xfb, 0xfc, 0x89, 0xcc, 0x3a, 0xc5, 0x29, 0x93, 0x85,
+	0x4e, 0xf1, 0xac, 0x53, 0x7c, 0x8e, 0x5c, 0xde, 0x4a, 0xa0, 0x9e, 0x71, 0xd9, 0x6f, 0x36, 0x0a,
+	0x30, 0x19, 0x98, 0x77, 0xb3, 0x10, 0xb5, 0xbb, 0x8d, 0xc6, 0xde, 0x5e, 0x41, 0x66, 0x2e, 0x4a,
+	0xed, 0xee, 0x21, 0x6a, 0x17, 0x51, 0x5a, 0x88, 0x12, 0xe3, 0x52, 0x44, 0x69, 0x31, 0x4a, 0x0c,
+	0x4c, 0x11, 0xa5, 0x3c, 0x99, 0x0f, 0x12, 0xb8, 0xf6, 0x8b, 0x37, 0xf5, 0xe3, 0xe6, 0x3f, 0xf4,
+	0xae, 0xbe, 0x0e, 0x96, 0x5d, 0xd3, 0xc1, 0x29, 0x92, 0x5a, 0x1c, 0x69, 0x15, 0x6e, 0x4b, 0xaa,
+	0x10, 0x31, 0x51, 0xbe, 0x09, 0xca, 0xc1, 0xc8, 0xe9, 0x51, 0x9b, 0xb1, 0x58, 0x6d, 0x5d, 0x88,
+	0x23, 0xad, 0xca, 0x6d, 0xbc, 0x0e, 0x51, 0x6a, 0x90, 0x0d, 0xb0, 0xd2, 0xc7, 0x16, 0x71, 0x4c,
+	0x3b, 0x50, 0xca, 0x0c, 0xdc, 0x46, 0x1c, 0x69, 0xb5, 0x6c, 0xbb, 0x5c, 0x81, 0x68, 0x66, 0x9a,
+	0x43, 0xf7, 0x51, 0x02, 0x97, 0x10, 0x76, 0xe8, 0xdf, 0x38, 0xde, 0x98, 0x60, 0x2d, 0xbd, 0xfb,
+	0x47, 0xe1, 0xc8, 0xe3, 0x33, 0xb5, 0xde, 0xbc, 0xa1, 0xff, 0xec, 0xe0, 0xa6, 0xa7, 0x5b, 0x7c,
+	0x34, 0xf2, 0x70, 0xeb, 0x72, 0x1c, 0x69, 0x1b, 0x3f, 0x0c, 0x13, 0xfb, 0x13, 0x88, 0x2a, 0xde,
+	0x77, 0x57, 0x7e, 0x62, 0x97, 0x7f, 0x3f, 0xb1, 0xdb, 0xa0, 0xf4, 0x94, 0xfa, 0x16, 0x66, 0xd4,
+	0x57, 0xf2, 0x2d, 0xb3, 0x32, 0x44, 0x5c, 0x9e, 0x43, 0xf8, 0x62, 0x09, 0x6c, 0x3e, 0x20, 0x03,
+	0xdf, 0x0c, 0x0b, 0x86, 0x58, 0xde, 0x07, 0x15, 0x17, 0x9f, 0x1e, 0x65, 0xc9, 0x2f, 0xcd, 0xb7,
+	0x92, 0x13, 0x21, 0x02, 0x2e, 0x3e, 0xed, 0x2c, 0x3e, 0x00, 0xca, 0x7f, 0xf8, 0x00, 0x68, 0xdd,
+	0xff, 0x34, 0x51, 0xc5, 0xb3, 0x89, 0x2a, 0x7e, 0x99, 0xa8, 0xe2, 0xab, 0xa9, 0x2a, 0x9c, 0x4d,
+	0x55, 0xe1, 0xf3, 0x54, 0x15, 0x9e, 0xec, 0x0e, 0x48, 0x78, 0x7c, 0xd2, 0xd3, 0x2d, 0xea, 0x18,
+	0x01, 0x26, 0xbb, 0x19, 0x14, 0xf6, 0x85, 0x51, 0x31, 0x9e, 0x1b, 0xc9, 0xc9, 0x3f, 0x69, 0x3c,
+	0xe8, 0x95, 0x99, 0x7e, 0xe7, 0xdb, 0x00, 0xf4, 0x97, 0x16, 0xdd, 0x4c, 0x0c, 0x00, 0x00,
 }
 
 func (m *AddERCNativePointerProposal) Marshal() (dAtA []byte, err error) {
@@ -847,6 +937,126 @@ func (m *AddERCNativePointerProposalV2) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *RemovePointerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovePointerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovePointerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Pointee) > 0 {
+		i -= len(m.Pointee)
+		copy(dAtA[i:], m.Pointee)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pointee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigratePointerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratePointerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratePointerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NewPointer) > 0 {
+		i -= len(m.NewPointer)
+		copy(dAtA[i:], m.NewPointer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NewPointer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Pointee) > 0 {
+		i -= len(m.Pointee)
+		copy(dAtA[i:], m.Pointee)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Pointee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1086,6 +1296,64 @@ func (m *AddERCNativePointerProposalV2) Size() (n int) {
 	return n
 }
 
+func (m *RemovePointerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PointerType != 0 {
+		n += 1 + sovGov(uint64(m.PointerType))
+	}
+	l = len(m.Pointee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *MigratePointerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.PointerType != 0 {
+		n += 1 + sovGov(uint64(m.PointerType))
+	}
+	l = len(m.Pointee)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NewPointer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2700,6 +2968,407 @@ func (m *AddERCNativePointerProposalV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemovePointerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePointerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePointerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MigratePointerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigratePointerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigratePointerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
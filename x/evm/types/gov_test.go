@@ -27,3 +27,44 @@ func TestAddERCNativePointerProposalV2(t *testing.T) {
 	require.Nil(t, p.ValidateBasic())
 	require.NotEmpty(t, p.String())
 }
+
+func TestRemovePointerProposal(t *testing.T) {
+	p := types.RemovePointerProposal{
+		Title:       "title",
+		Description: "desc",
+		PointerType: types.PointerType_NATIVE,
+		Pointee:     "test",
+	}
+	require.Equal(t, "evm", p.ProposalRoute())
+	require.Equal(t, "RemovePointer", p.ProposalType())
+	require.Nil(t, p.ValidateBasic())
+	p.PointerType = types.PointerType_ERC20
+	require.NotNil(t, p.ValidateBasic())
+	p.Pointee = "0x0000000000000000000000000000000000000001"
+	require.Nil(t, p.ValidateBasic())
+	require.NotEmpty(t, p.String())
+}
+
+func TestMigratePointerProposal(t *testing.T) {
+	p := types.MigratePointerProposal{
+		Title:       "title",
+		Description: "desc",
+		PointerType: types.PointerType_NATIVE,
+		Pointee:     "test",
+		NewPointer:  "0x0000000000000000000000000000000000000001",
+		Version:     2,
+	}
+	require.Equal(t, "evm", p.ProposalRoute())
+	require.Equal(t, "MigratePointer", p.ProposalType())
+	require.Nil(t, p.ValidateBasic())
+	p.Version = 0
+	require.NotNil(t, p.ValidateBasic())
+	p.Version = math.MaxUint16 + 1
+	require.NotNil(t, p.ValidateBasic())
+	p.Version = 2
+	// pointers of ERC pointees are CW contracts
+	p.PointerType = types.PointerType_ERC20
+	p.Pointee = "0x0000000000000000000000000000000000000002"
+	require.NotNil(t, p.ValidateBasic())
+	require.NotEmpty(t, p.String())
+}
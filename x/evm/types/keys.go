@@ -58,6 +58,48 @@ var (
 	LegacyBlockBloomCutoffHeightKey = []byte{0x1a}
 	BaseFeePerGasPrefix             = []byte{0x1b}
 	NextBaseFeePerGasPrefix         = []byte{0x1c}
+
+	LogIndexPrefix        = []byte{0x1d} // receipt store
+	TxAddressIndexPrefix  = []byte{0x1e} // receipt store
+	AccountHistoryPrefix  = []byte{0x1f} // receipt store, transient
+	ModifiedAccountPrefix = []byte{0x20} // receipt store
+
+	BaseFeeHistoryPrefix   = []byte{0x21}
+	BaseFeeExcessGasKey    = []byte{0x22}
+	BaseFeePidIntegralKey  = []byte{0x23}
+	BaseFeePidLastErrorKey = []byte{0x24}
+
+	FlatSnapshotEntryPrefix     = []byte{0x25} // receipt store
+	FlatSnapshotBaseLayerPrefix = []byte{0x26} // receipt store
+	FlatSnapshotStatusKey       = []byte{0x27} // receipt store
+
+	LogIndexStartHeightKey = []byte{0x28} // receipt store
+
+	BaseFeeCurveKey = []byte{0x29}
+)
+
+const (
+	// FlatSnapshotEVMStore marks flat snapshot entries of keys of the EVM module store.
+	FlatSnapshotEVMStore byte = 0x0
+	// FlatSnapshotBankStore marks flat snapshot entries of keys of the bank module store.
+	FlatSnapshotBankStore byte = 0x1
+)
+
+const (
+	// LogIndexAddressKind marks log index entries keyed by emitting address. Entries keyed
+	// by topic use the topic position + 1 as their kind.
+	LogIndexAddressKind byte = 0x0
+	LogIndexMaxTopics        = 4
+)
+
+const (
+	// AccountHistoryEVMKind marks account history entries of EVM transactions.
+	AccountHistoryEVMKind byte = 0x0
+	// AccountHistoryCosmosKind marks account history entries of Cosmos transactions.
+	AccountHistoryCosmosKind byte = 0x1
+	// AccountHistorySyntheticKind marks account history entries of EVM events synthesized
+	// from CosmWasm events of pointer contracts.
+	AccountHistorySyntheticKind byte = 0x2
 )
 
 var (
@@ -86,6 +128,128 @@ func ReceiptKey(txHash common.Hash) []byte {
 	return append(ReceiptKeyPrefix, txHash[:]...)
 }
 
+// LogIndexValuePrefix returns the prefix shared by all log index entries of the given kind
+// and value (an address or a topic).
+func LogIndexValuePrefix(kind byte, value []byte) []byte {
+	key := make([]byte, 0, len(LogIndexPrefix)+1+len(value))
+	key = append(key, LogIndexPrefix...)
+	key = append(key, kind)
+	return append(key, value...)
+}
+
+// LogIndexHeightPrefix returns the prefix of all log index entries of the given kind and
+// value that were emitted at the given height.
+func LogIndexHeightPrefix(kind byte, value []byte, height uint64) []byte {
+	return binary.BigEndian.AppendUint64(LogIndexValuePrefix(kind, value), height)
+}
+
+// LogIndexKey is laid out as prefix | kind | value | height | txIndex | logIndex so that
+// entries for the same address or topic are sorted by their position in the chain.
+func LogIndexKey(kind byte, value []byte, height uint64, txIndex uint32, logIndex uint32) []byte {
+	key := LogIndexHeightPrefix(kind, value, height)
+	key = binary.BigEndian.AppendUint32(key, txIndex)
+	return binary.BigEndian.AppendUint32(key, logIndex)
+}
+
+// LogIndexKeyHeight extracts the height from a log index key built by LogIndexKey.
+func LogIndexKeyHeight(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-16 : len(key)-8])
+}
+
+// TxAddressIndexAddressPrefix returns the prefix shared by all transaction address index
+// entries of addr.
+func TxAddressIndexAddressPrefix(addr common.Address) []byte {
+	key := make([]byte, 0, len(TxAddressIndexPrefix)+common.AddressLength)
+	key = append(key, TxAddressIndexPrefix...)
+	return append(key, addr[:]...)
+}
+
+// TxAddressIndexKey is laid out as prefix | address | height | txIndex so that the
+// transactions of an address are sorted by their position in the chain.
+func TxAddressIndexKey(addr common.Address, height uint64, txIndex uint32) []byte {
+	key := binary.BigEndian.AppendUint64(TxAddressIndexAddressPrefix(addr), height)
+	return binary.BigEndian.AppendUint32(key, txIndex)
+}
+
+// TxAddressIndexKeyPosition extracts the height and transaction index from a key built by
+// TxAddressIndexKey.
+func TxAddressIndexKeyPosition(key []byte) (uint64, uint32) {
+	return binary.BigEndian.Uint64(key[len(key)-12 : len(key)-4]), binary.BigEndian.Uint32(key[len(key)-4:])
+}
+
+// AccountHistoryAddressPrefix returns the prefix shared by all account history entries of
+// the 20 byte address addr, which is either an EVM or a Sei address.
+func AccountHistoryAddressPrefix(addr []byte) []byte {
+	key := make([]byte, 0, len(AccountHistoryPrefix)+len(addr))
+	key = append(key, AccountHistoryPrefix...)
+	return append(key, addr...)
+}
+
+// AccountHistoryKey is laid out as prefix | address | height | txIndex | kind so that the
+// activity of an address is sorted by its position in the chain, and a transaction that
+// both a Cosmos message and its synthetic events tie to the address has two entries.
+func AccountHistoryKey(addr []byte, height uint64, txIndex uint32, kind byte) []byte {
+	key := binary.BigEndian.AppendUint64(AccountHistoryAddressPrefix(addr), height)
+	key = binary.BigEndian.AppendUint32(key, txIndex)
+	return append(key, kind)
+}
+
+// AccountHistoryKeyPosition extracts the height, transaction index and kind from a key
+// built by AccountHistoryKey.
+func AccountHistoryKeyPosition(key []byte) (uint64, uint32, byte) {
+	return binary.BigEndian.Uint64(key[len(key)-13 : len(key)-5]), binary.BigEndian.Uint32(key[len(key)-5 : len(key)-1]), key[len(key)-1]
+}
+
+// ModifiedAccountHeightPrefix returns the prefix shared by all the accounts modified at
+// the given height.
+func ModifiedAccountHeightPrefix(height uint64) []byte {
+	key := make([]byte, 0, len(ModifiedAccountPrefix)+8)
+	key = append(key, ModifiedAccountPrefix...)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
+// ModifiedAccountKey is laid out as prefix | height | address so that the accounts
+// modified by a range of blocks can be iterated in chain order.
+func ModifiedAccountKey(height uint64, addr common.Address) []byte {
+	return append(ModifiedAccountHeightPrefix(height), addr[:]...)
+}
+
+// ModifiedAccountKeyPosition extracts the height and address from a key built by
+// ModifiedAccountKey.
+func ModifiedAccountKeyPosition(key []byte) (uint64, common.Address) {
+	return binary.BigEndian.Uint64(key[len(key)-28 : len(key)-20]), common.BytesToAddress(key[len(key)-20:])
+}
+
+// FlatSnapshotKeyPrefix returns the prefix shared by all flat snapshot entries of key in
+// the given module store. The length of key is included so that no key is a prefix of
+// the entries of another.
+func FlatSnapshotKeyPrefix(store byte, key []byte) []byte {
+	prefix := make([]byte, 0, len(FlatSnapshotEntryPrefix)+3+len(key)+8)
+	prefix = append(prefix, FlatSnapshotEntryPrefix...)
+	prefix = append(prefix, store)
+	prefix = binary.BigEndian.AppendUint16(prefix, uint16(len(key))) //nolint:gosec
+	return append(prefix, key...)
+}
+
+// FlatSnapshotEntryKey is laid out as prefix | store | key length | key | height so that
+// the value of a key at a height is the last entry at or below that height.
+func FlatSnapshotEntryKey(store byte, key []byte, height uint64) []byte {
+	return binary.BigEndian.AppendUint64(FlatSnapshotKeyPrefix(store, key), height)
+}
+
+// FlatSnapshotEntryKeyPosition extracts the module store, key and height from a key built
+// by FlatSnapshotEntryKey.
+func FlatSnapshotEntryKeyPosition(entryKey []byte) (byte, []byte, uint64) {
+	start := len(FlatSnapshotEntryPrefix) + 3
+	return entryKey[len(FlatSnapshotEntryPrefix)], entryKey[start : len(entryKey)-8], binary.BigEndian.Uint64(entryKey[len(entryKey)-8:])
+}
+
+func FlatSnapshotBaseLayerKey(height uint64) []byte {
+	key := make([]byte, 0, len(FlatSnapshotBaseLayerPrefix)+8)
+	key = append(key, FlatSnapshotBaseLayerPrefix...)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
 func BlockBloomKey(height int64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, uint64(height))
@@ -150,3 +314,10 @@ func PointerCW1155ERC1155Key(erc1155Addr common.Address) []byte {
 func PointerReverseRegistryKey(addr common.Address) []byte {
 	return append(PointerReverseRegistryPrefix, addr[:]...)
 }
+
+// BaseFeeHistoryKey is laid out as prefix | height so that the history is sorted by height.
+func BaseFeeHistoryKey(height uint64) []byte {
+	key := make([]byte, 0, len(BaseFeeHistoryPrefix)+8)
+	key = append(key, BaseFeeHistoryPrefix...)
+	return binary.BigEndian.AppendUint64(key, height)
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -17,6 +18,15 @@ var (
 	KeyMaxDynamicBaseFeeUpwardAdjustment   = []byte("KeyMaxDynamicBaseFeeUpwardAdjustment")
 	KeyMaxDynamicBaseFeeDownwardAdjustment = []byte("KeyMaxDynamicBaseFeeDownwardAdjustment")
 	KeyTargetGasUsedPerBlock               = []byte("KeyTargetGasUsedPerBlock")
+	KeyBaseFeeAdjustmentCurve              = []byte("KeyBaseFeeAdjustmentCurve")
+	KeyBaseFeeHistorySize                  = []byte("KeyBaseFeeHistorySize")
+	KeyExponentialBaseFeeUpdateFraction    = []byte("KeyExponentialBaseFeeUpdateFraction")
+	KeyPidProportionalGain                 = []byte("KeyPidProportionalGain")
+	KeyPidIntegralGain                     = []byte("KeyPidIntegralGain")
+	KeyPidDerivativeGain                   = []byte("KeyPidDerivativeGain")
+	KeyAutoRegisterNativePointers          = []byte("KeyAutoRegisterNativePointers")
+	KeyNativePointerAllowlist              = []byte("KeyNativePointerAllowlist")
+	KeyNativePointerDenylist               = []byte("KeyNativePointerDenylist")
 	// deprecated
 	KeyBaseFeePerGas                          = []byte("KeyBaseFeePerGas")
 	KeyWhitelistedCwCodeHashesForDelegateCall = []byte("KeyWhitelistedCwCodeHashesForDelegateCall")
@@ -38,6 +48,23 @@ var DefaultMaxDynamicBaseFeeDownwardAdjustment = sdk.NewDecWithPrec(39, 4) // .3
 var DefaultTargetGasUsedPerBlock = uint64(250000)                          // 250k
 var DefaultMaxFeePerGas = sdk.NewDec(1000000000000)                        // 1,000gwei
 
+var DefaultBaseFeeAdjustmentCurve = BaseFeeAdjustmentCurve_LINEAR
+var DefaultBaseFeeHistorySize = uint64(1024)
+
+// DefaultExponentialBaseFeeUpdateFraction makes the base fee grow by at most
+// e^(1/8) per block with the default target and block gas limit, like EIP-4844.
+var DefaultExponentialBaseFeeUpdateFraction = 8 * DefaultTargetGasUsedPerBlock
+var DefaultPidProportionalGain = sdk.NewDecWithPrec(125, 4) // 1.25%
+var DefaultPidIntegralGain = sdk.NewDecWithPrec(125, 5)     // .125%
+var DefaultPidDerivativeGain = sdk.ZeroDec()
+
+var DefaultAutoRegisterNativePointers = false
+var DefaultNativePointerAllowlist []string // allows every denom
+var DefaultNativePointerDenylist []string
+
+// MaxBaseFeeHistorySize bounds the number of blocks kept in the base fee history.
+const MaxBaseFeeHistorySize = uint64(100000)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -55,6 +82,15 @@ func DefaultParams() Params {
 		WhitelistedCwCodeHashesForDelegateCall: DefaultWhitelistedCwCodeHashesForDelegateCall,
 		TargetGasUsedPerBlock:                  DefaultTargetGasUsedPerBlock,
 		MaximumFeePerGas:                       DefaultMaxFeePerGas,
+		BaseFeeAdjustmentCurve:                 DefaultBaseFeeAdjustmentCurve,
+		BaseFeeHistorySize:                     DefaultBaseFeeHistorySize,
+		ExponentialBaseFeeUpdateFraction:       DefaultExponentialBaseFeeUpdateFraction,
+		PidProportionalGain:                    DefaultPidProportionalGain,
+		PidIntegralGain:                        DefaultPidIntegralGain,
+		PidDerivativeGain:                      DefaultPidDerivativeGain,
+		AutoRegisterNativePointers:             DefaultAutoRegisterNativePointers,
+		NativePointerAllowlist:                 DefaultNativePointerAllowlist,
+		NativePointerDenylist:                  DefaultNativePointerDenylist,
 	}
 }
 
@@ -69,6 +105,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDeliverTxHookWasmGasLimit, &p.DeliverTxHookWasmGasLimit, validateDeliverTxHookWasmGasLimit),
 		paramtypes.NewParamSetPair(KeyTargetGasUsedPerBlock, &p.TargetGasUsedPerBlock, func(i interface{}) error { return nil }),
 		paramtypes.NewParamSetPair(KeyMaxFeePerGas, &p.MaximumFeePerGas, validateMaxFeePerGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeAdjustmentCurve, &p.BaseFeeAdjustmentCurve, validateBaseFeeAdjustmentCurve),
+		paramtypes.NewParamSetPair(KeyBaseFeeHistorySize, &p.BaseFeeHistorySize, validateBaseFeeHistorySize),
+		paramtypes.NewParamSetPair(KeyExponentialBaseFeeUpdateFraction, &p.ExponentialBaseFeeUpdateFraction, validateExponentialBaseFeeUpdateFraction),
+		paramtypes.NewParamSetPair(KeyPidProportionalGain, &p.PidProportionalGain, validatePidGain),
+		paramtypes.NewParamSetPair(KeyPidIntegralGain, &p.PidIntegralGain, validatePidGain),
+		paramtypes.NewParamSetPair(KeyPidDerivativeGain, &p.PidDerivativeGain, validatePidGain),
+		paramtypes.NewParamSetPair(KeyAutoRegisterNativePointers, &p.AutoRegisterNativePointers, validateAutoRegisterNativePointers),
+		paramtypes.NewParamSetPair(KeyNativePointerAllowlist, &p.NativePointerAllowlist, validateNativePointerDenomPrefixes),
+		paramtypes.NewParamSetPair(KeyNativePointerDenylist, &p.NativePointerDenylist, validateNativePointerDenomPrefixes),
 	}
 }
 
@@ -97,6 +142,30 @@ func (p Params) Validate() error {
 	if err := validateBaseFeeAdjustment(p.MaxDynamicBaseFeeDownwardAdjustment); err != nil {
 		return fmt.Errorf("invalid max dynamic base fee downward adjustment: %s, err: %s", p.MaxDynamicBaseFeeDownwardAdjustment, err)
 	}
+	if err := validateBaseFeeAdjustmentCurve(p.BaseFeeAdjustmentCurve); err != nil {
+		return err
+	}
+	if err := validateBaseFeeHistorySize(p.BaseFeeHistorySize); err != nil {
+		return err
+	}
+	if err := validateExponentialBaseFeeUpdateFraction(p.ExponentialBaseFeeUpdateFraction); err != nil {
+		return err
+	}
+	if err := validatePidGain(p.PidProportionalGain); err != nil {
+		return fmt.Errorf("invalid pid proportional gain: %s, err: %s", p.PidProportionalGain, err)
+	}
+	if err := validatePidGain(p.PidIntegralGain); err != nil {
+		return fmt.Errorf("invalid pid integral gain: %s, err: %s", p.PidIntegralGain, err)
+	}
+	if err := validatePidGain(p.PidDerivativeGain); err != nil {
+		return fmt.Errorf("invalid pid derivative gain: %s, err: %s", p.PidDerivativeGain, err)
+	}
+	if err := validateNativePointerDenomPrefixes(p.NativePointerAllowlist); err != nil {
+		return fmt.Errorf("invalid native pointer allowlist: %s", err)
+	}
+	if err := validateNativePointerDenomPrefixes(p.NativePointerDenylist); err != nil {
+		return fmt.Errorf("invalid native pointer denylist: %s", err)
+	}
 	return validateWhitelistedCwHashesForDelegateCall(p.WhitelistedCwCodeHashesForDelegateCall)
 }
 
@@ -181,6 +250,50 @@ func validateDeliverTxHookWasmGasLimit(i interface{}) error {
 	return nil
 }
 
+func validateBaseFeeAdjustmentCurve(i interface{}) error {
+	v, ok := i.(BaseFeeAdjustmentCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := BaseFeeAdjustmentCurve_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown base fee adjustment curve: %d", v)
+	}
+	return nil
+}
+
+func validateBaseFeeHistorySize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxBaseFeeHistorySize {
+		return fmt.Errorf("base fee history size must be at most %d, got %d", MaxBaseFeeHistorySize, v)
+	}
+	return nil
+}
+
+func validateExponentialBaseFeeUpdateFraction(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("invalid exponential_base_fee_update_fraction: must be greater than 0, got %d", v)
+	}
+	return nil
+}
+
+func validatePidGain(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("negative pid gain: %s", v)
+	}
+	return nil
+}
+
 func validateWhitelistedCwHashesForDelegateCall(i interface{}) error {
 	_, ok := i.([][]byte)
 	if !ok {
@@ -189,6 +302,49 @@ func validateWhitelistedCwHashesForDelegateCall(i interface{}) error {
 	return nil
 }
 
+func validateAutoRegisterNativePointers(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateNativePointerDenomPrefixes(i interface{}) error {
+	prefixes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			return errors.New("denom prefix cannot be empty")
+		}
+	}
+	return nil
+}
+
+// NativePointerAutoRegistrationAllowed returns whether a native pointer may be registered
+// automatically for denom. The denylist takes precedence over the allowlist, and an empty
+// allowlist allows every denom that is not denylisted.
+func (p Params) NativePointerAutoRegistrationAllowed(denom string) bool {
+	if !p.AutoRegisterNativePointers {
+		return false
+	}
+	for _, prefix := range p.NativePointerDenylist {
+		if strings.HasPrefix(denom, prefix) {
+			return false
+		}
+	}
+	if len(p.NativePointerAllowlist) == 0 {
+		return true
+	}
+	for _, prefix := range p.NativePointerAllowlist {
+		if strings.HasPrefix(denom, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func generateDefaultWhitelistedCwCodeHashesForDelegateCall() [][]byte {
 	return [][]byte(nil)
 }
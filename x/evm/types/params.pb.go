@@ -50,6 +50,21 @@ type Params struct {
 	MaxDynamicBaseFeeDownwardAdjustment    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_dynamic_base_fee_downward_adjustment,json=maxDynamicBaseFeeDownwardAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_dynamic_base_fee_downward_adjustment" yaml:"max_dynamic_base_fee_downward_adjustment"`
 	TargetGasUsedPerBlock                  uint64                                 `protobuf:"varint,12,opt,name=target_gas_used_per_block,json=targetGasUsedPerBlock,proto3" json:"target_gas_used_per_block,omitempty"`
 	MaximumFeePerGas                       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=maximum_fee_per_gas,json=maximumFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_fee_per_gas" yaml:"maximum_fee_per_gas"`
+	BaseFeeAdjustmentCurve                 BaseFeeAdjustmentCurve                 `protobuf:"varint,14,opt,name=base_fee_adjustment_curve,json=baseFeeAdjustmentCurve,proto3,enum=seiprotocol.seichain.evm.BaseFeeAdjustmentCurve" json:"base_fee_adjustment_curve" yaml:"base_fee_adjustment_curve"`
+	// number of blocks whose base fee is kept in the base fee history
+	BaseFeeHistorySize uint64 `protobuf:"varint,15,opt,name=base_fee_history_size,json=baseFeeHistorySize,proto3" json:"base_fee_history_size" yaml:"base_fee_history_size"`
+	// denominator of the exponent of the EXPONENTIAL curve, in gas
+	ExponentialBaseFeeUpdateFraction uint64                                 `protobuf:"varint,16,opt,name=exponential_base_fee_update_fraction,json=exponentialBaseFeeUpdateFraction,proto3" json:"exponential_base_fee_update_fraction" yaml:"exponential_base_fee_update_fraction"`
+	PidProportionalGain              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=pid_proportional_gain,json=pidProportionalGain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_proportional_gain" yaml:"pid_proportional_gain"`
+	PidIntegralGain                  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=pid_integral_gain,json=pidIntegralGain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_integral_gain" yaml:"pid_integral_gain"`
+	PidDerivativeGain                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=pid_derivative_gain,json=pidDerivativeGain,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_derivative_gain" yaml:"pid_derivative_gain"`
+	// whether a native pointer is deployed the first time a tokenfactory denom is created or an
+	// IBC denom is received
+	AutoRegisterNativePointers bool `protobuf:"varint,20,opt,name=auto_register_native_pointers,json=autoRegisterNativePointers,proto3" json:"auto_register_native_pointers" yaml:"auto_register_native_pointers"`
+	// denom prefixes eligible for automatic native pointer registration; empty allows all denoms
+	NativePointerAllowlist []string `protobuf:"bytes,21,rep,name=native_pointer_allowlist,json=nativePointerAllowlist,proto3" json:"native_pointer_allowlist" yaml:"native_pointer_allowlist"`
+	// denom prefixes excluded from automatic native pointer registration, even if allowlisted
+	NativePointerDenylist []string `protobuf:"bytes,22,rep,name=native_pointer_denylist,json=nativePointerDenylist,proto3" json:"native_pointer_denylist" yaml:"native_pointer_denylist"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -105,6 +120,48 @@ func (m *Params) GetTargetGasUsedPerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeAdjustmentCurve() BaseFeeAdjustmentCurve {
+	if m != nil {
+		return m.BaseFeeAdjustmentCurve
+	}
+	return BaseFeeAdjustmentCurve_LINEAR
+}
+
+func (m *Params) GetBaseFeeHistorySize() uint64 {
+	if m != nil {
+		return m.BaseFeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetExponentialBaseFeeUpdateFraction() uint64 {
+	if m != nil {
+		return m.ExponentialBaseFeeUpdateFraction
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRegisterNativePointers() bool {
+	if m != nil {
+		return m.AutoRegisterNativePointers
+	}
+	return false
+}
+
+func (m *Params) GetNativePointerAllowlist() []string {
+	if m != nil {
+		return m.NativePointerAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetNativePointerDenylist() []string {
+	if m != nil {
+		return m.NativePointerDenylist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.evm.Params")
 }
@@ -112,49 +169,74 @@ func init() {
 func init() { proto.RegisterFile("evm/params.proto", fileDescriptor_9272f3679901ea94) }
 
 var fileDescriptor_9272f3679901ea94 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6b, 0xdb, 0x4a,
-	0x10, 0xb6, 0x5e, 0xf2, 0xc2, 0xcb, 0xbe, 0x04, 0x82, 0xf2, 0x1e, 0x55, 0x72, 0x90, 0x52, 0x15,
-	0x82, 0x0f, 0xb5, 0x55, 0x08, 0x85, 0x90, 0x5b, 0x1c, 0x13, 0xe7, 0x50, 0x8a, 0x11, 0x4d, 0x0b,
-	0x85, 0xb2, 0xac, 0xa5, 0x89, 0xbc, 0xb5, 0xd6, 0x6b, 0x76, 0xd7, 0xb1, 0xdd, 0x1f, 0x50, 0xe8,
-	0xa1, 0x50, 0x42, 0x0f, 0x3d, 0xf6, 0xcf, 0x14, 0x72, 0xcc, 0xb1, 0x14, 0x2a, 0x4a, 0x42, 0x2e,
-	0x39, 0xfa, 0x17, 0x14, 0xad, 0x1c, 0xc7, 0x8d, 0x4d, 0x89, 0x73, 0xe9, 0x25, 0x27, 0xad, 0x66,
-	0xbe, 0x9d, 0x9d, 0x6f, 0x66, 0xbf, 0x61, 0xd1, 0x12, 0x1c, 0x32, 0xaf, 0x45, 0x04, 0x61, 0xb2,
-	0xd8, 0x12, 0x5c, 0x71, 0xd3, 0x92, 0x40, 0xf5, 0x2a, 0xe0, 0x71, 0x51, 0x02, 0x0d, 0xea, 0x84,
-	0x36, 0x8b, 0x70, 0xc8, 0x56, 0xff, 0x8b, 0x78, 0xc4, 0xb5, 0xcb, 0x4b, 0x57, 0x19, 0xde, 0x3d,
-	0x47, 0x68, 0xae, 0xaa, 0x03, 0x98, 0x1f, 0x0d, 0xb4, 0xdc, 0x12, 0x94, 0x0b, 0xaa, 0x7a, 0xb8,
-	0xc9, 0x05, 0x23, 0x31, 0x7d, 0x03, 0xc2, 0xfa, 0x6b, 0xcd, 0xc8, 0xcf, 0x97, 0x82, 0xe3, 0xc4,
-	0xc9, 0x7d, 0x4b, 0x9c, 0xf5, 0x88, 0xaa, 0x7a, 0xbb, 0x56, 0x0c, 0x38, 0xf3, 0x02, 0x2e, 0x19,
-	0x97, 0x83, 0x4f, 0x41, 0x86, 0x0d, 0x4f, 0xf5, 0x5a, 0x20, 0x8b, 0x65, 0x08, 0x2e, 0x12, 0x67,
-	0x52, 0xb0, 0x7e, 0xe2, 0xac, 0xf6, 0x08, 0x8b, 0xb7, 0xdc, 0x09, 0x4e, 0xd7, 0x37, 0x2f, 0xad,
-	0x4f, 0x87, 0x46, 0xf3, 0xad, 0x81, 0x96, 0x6a, 0x44, 0x02, 0x3e, 0x00, 0xc0, 0x2d, 0x10, 0x38,
-	0x22, 0xd2, 0x9a, 0xd1, 0x39, 0xbd, 0x9a, 0x3a, 0xa7, 0xb1, 0x48, 0xfd, 0xc4, 0xb9, 0x97, 0x25,
-	0x74, 0xdd, 0xe3, 0xfa, 0x8b, 0xa9, 0x69, 0x17, 0xa0, 0x0a, 0xa2, 0x42, 0xa4, 0x79, 0x64, 0xa0,
-	0x65, 0x46, 0x9b, 0x94, 0xb5, 0xd9, 0x2f, 0xb9, 0xcc, 0xde, 0xb6, 0x3e, 0x13, 0x82, 0x5d, 0xd5,
-	0x67, 0x82, 0xd3, 0xf5, 0x97, 0x06, 0xd6, 0xab, 0xa4, 0xbe, 0x18, 0xe8, 0x61, 0xa7, 0x4e, 0x15,
-	0xc4, 0x54, 0x2a, 0x08, 0x71, 0xd0, 0xc1, 0x01, 0x0f, 0x01, 0xd7, 0x89, 0xac, 0x83, 0xc4, 0x07,
-	0x5c, 0xe0, 0x10, 0x62, 0x88, 0x88, 0x02, 0x1c, 0x90, 0x38, 0xb6, 0xfe, 0x59, 0x9b, 0xc9, 0x2f,
-	0x94, 0xa2, 0x8b, 0xc4, 0x99, 0x6a, 0x5f, 0x3f, 0x71, 0x36, 0xb2, 0xc4, 0xa6, 0xd9, 0xe5, 0xfa,
-	0xeb, 0x23, 0xf0, 0x9d, 0xce, 0x0e, 0x0f, 0x61, 0x4f, 0x63, 0x77, 0xb9, 0x28, 0x0f, 0x90, 0x3b,
-	0x24, 0x8e, 0xcd, 0x6d, 0x64, 0x87, 0x10, 0xd3, 0x43, 0x10, 0x58, 0x75, 0x71, 0x9d, 0xf3, 0x06,
-	0xee, 0x10, 0xc9, 0x52, 0xda, 0x38, 0xa6, 0x8c, 0x2a, 0x6b, 0x7e, 0xcd, 0xc8, 0xcf, 0xfa, 0x2b,
-	0x03, 0xd4, 0xb3, 0xee, 0x1e, 0xe7, 0x8d, 0x17, 0x44, 0xb2, 0x0a, 0x91, 0x4f, 0x52, 0x80, 0xf9,
-	0xdd, 0x40, 0xeb, 0x8c, 0x74, 0x71, 0xd8, 0x6b, 0x12, 0x46, 0x03, 0x3c, 0x6c, 0x68, 0xbb, 0xd5,
-	0x21, 0x22, 0xc4, 0x24, 0x7c, 0xdd, 0x96, 0x8a, 0x41, 0x53, 0x59, 0x48, 0xb7, 0xec, 0x9d, 0x31,
-	0x75, 0xcf, 0x6e, 0x78, 0x40, 0x3f, 0x71, 0x0a, 0x83, 0x36, 0xde, 0x08, 0xef, 0xfa, 0xf7, 0x19,
-	0xe9, 0x96, 0x33, 0x5c, 0x29, 0xbb, 0x75, 0xfb, 0x1a, 0xb4, 0x3d, 0xc4, 0x98, 0xe7, 0x06, 0xca,
-	0x4f, 0x0c, 0x17, 0xf2, 0x4e, 0xf3, 0x3a, 0xc3, 0x7f, 0x35, 0xc3, 0xf7, 0xd3, 0x33, 0xbc, 0xf1,
-	0x11, 0xfd, 0xc4, 0xf1, 0x7e, 0xc3, 0x71, 0xc2, 0x0e, 0xd7, 0x7f, 0x30, 0xc6, 0xb2, 0x3c, 0x80,
-	0x8d, 0xf0, 0xdc, 0x44, 0x2b, 0x8a, 0x88, 0x08, 0x94, 0x6e, 0x7e, 0x5b, 0x42, 0xa8, 0x05, 0x50,
-	0x8b, 0x79, 0xd0, 0xb0, 0x16, 0xf4, 0x2d, 0xf8, 0x3f, 0x03, 0x54, 0x88, 0xdc, 0x97, 0x10, 0x56,
-	0x41, 0x94, 0x52, 0x67, 0xa6, 0x50, 0xd2, 0x1d, 0x53, 0xe8, 0xe2, 0xad, 0x15, 0x3a, 0x1e, 0x6c,
-	0x44, 0xa1, 0xe3, 0xce, 0x54, 0xa1, 0x99, 0x75, 0xa8, 0xd0, 0xad, 0xd9, 0x4f, 0x9f, 0x9d, 0x9c,
-	0x7b, 0xf4, 0x37, 0x5a, 0xcc, 0xe6, 0x6c, 0x55, 0xc0, 0xf3, 0xc7, 0x9b, 0x8f, 0xee, 0xc6, 0xed,
-	0xdd, 0xb8, 0xfd, 0x63, 0xe3, 0x36, 0xbb, 0x94, 0xa5, 0xca, 0xf1, 0xa9, 0x6d, 0x9c, 0x9c, 0xda,
-	0xc6, 0x8f, 0x53, 0xdb, 0xf8, 0x70, 0x66, 0xe7, 0x4e, 0xce, 0xec, 0xdc, 0xd7, 0x33, 0x3b, 0xf7,
-	0xb2, 0x30, 0x52, 0x56, 0x09, 0xb4, 0x70, 0xf9, 0xa4, 0xd0, 0x3f, 0xfa, 0x4d, 0xe1, 0x75, 0xbd,
-	0xf4, 0xf1, 0xa1, 0x2b, 0x5c, 0x9b, 0xd3, 0xfe, 0x8d, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x19,
-	0x33, 0xf5, 0x29, 0x90, 0x08, 0x00, 0x00,
+	// 1063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6b, 0xdc, 0xc6,
+	0x17, 0xb7, 0xbe, 0xc9, 0x37, 0xc4, 0x6a, 0x1c, 0x3b, 0xf2, 0x8f, 0xc8, 0xa6, 0xdd, 0xd9, 0xaa,
+	0xc1, 0x2c, 0xb4, 0xde, 0x0d, 0x0d, 0x85, 0x34, 0x50, 0x8a, 0xd7, 0x8b, 0xed, 0x40, 0x09, 0x8b,
+	0xda, 0x34, 0x50, 0x28, 0xc3, 0x58, 0x7a, 0xde, 0x9d, 0x5a, 0xd2, 0x88, 0x19, 0xed, 0x2f, 0xff,
+	0x01, 0x85, 0xd2, 0x16, 0x4a, 0xda, 0x43, 0xa1, 0x97, 0xde, 0x7a, 0xeb, 0x7f, 0x51, 0xc8, 0x31,
+	0xc7, 0x52, 0xe8, 0x50, 0x6c, 0x7a, 0xd9, 0x5b, 0xf5, 0x17, 0x14, 0x8d, 0xb4, 0x3f, 0xbc, 0x2b,
+	0x1b, 0xaf, 0x2f, 0xbd, 0xe4, 0x64, 0xf9, 0x7d, 0x3e, 0xef, 0xbd, 0xcf, 0x7b, 0x33, 0x4f, 0x7a,
+	0xab, 0x2f, 0x41, 0xdb, 0xaf, 0x84, 0x84, 0x13, 0x5f, 0x94, 0x43, 0xce, 0x22, 0x66, 0x98, 0x02,
+	0xa8, 0x7a, 0x72, 0x98, 0x57, 0x16, 0x40, 0x9d, 0x26, 0xa1, 0x41, 0x19, 0xda, 0xfe, 0xc6, 0x4a,
+	0x83, 0x35, 0x98, 0x82, 0x2a, 0xc9, 0x53, 0xca, 0xdf, 0x58, 0x4c, 0x22, 0x40, 0xd0, 0x1a, 0x04,
+	0xb0, 0xfe, 0x59, 0xd1, 0x6f, 0xd4, 0x55, 0x44, 0xe3, 0x07, 0x4d, 0x5f, 0x0e, 0x39, 0x65, 0x9c,
+	0x46, 0x3d, 0x1c, 0x30, 0xee, 0x13, 0x8f, 0x1e, 0x03, 0x37, 0xff, 0x57, 0xd4, 0x4a, 0xf3, 0x55,
+	0xe7, 0x85, 0x44, 0x73, 0x7f, 0x48, 0xb4, 0xd9, 0xa0, 0x51, 0xb3, 0x75, 0x50, 0x76, 0x98, 0x5f,
+	0x71, 0x98, 0xf0, 0x99, 0xc8, 0xfe, 0x6c, 0x09, 0xf7, 0xa8, 0x12, 0xf5, 0x42, 0x10, 0xe5, 0x1a,
+	0x38, 0x7d, 0x89, 0xf2, 0x82, 0xc5, 0x12, 0x6d, 0xf4, 0x88, 0xef, 0x3d, 0xb2, 0x72, 0x40, 0xcb,
+	0x36, 0x06, 0xd6, 0x27, 0x43, 0xa3, 0xf1, 0xa5, 0xa6, 0x2f, 0x1d, 0x10, 0x01, 0xf8, 0x10, 0x00,
+	0x87, 0xc0, 0x71, 0x83, 0x08, 0xf3, 0x9a, 0xd2, 0xf4, 0xf9, 0xcc, 0x9a, 0xa6, 0x22, 0xc5, 0x12,
+	0xdd, 0x4d, 0x05, 0x4d, 0x22, 0x96, 0xbd, 0x90, 0x98, 0x76, 0x01, 0xea, 0xc0, 0xf7, 0x88, 0x30,
+	0x9e, 0x6b, 0xfa, 0xb2, 0x4f, 0x03, 0xea, 0xb7, 0xfc, 0x33, 0x5a, 0xae, 0x5f, 0xb5, 0x3f, 0x39,
+	0xc1, 0x46, 0xfd, 0xc9, 0x01, 0x2d, 0x7b, 0x29, 0xb3, 0x8e, 0x44, 0xfd, 0xa6, 0xe9, 0xef, 0x74,
+	0x9a, 0x34, 0x02, 0x8f, 0x8a, 0x08, 0x5c, 0xec, 0x74, 0xb0, 0xc3, 0x5c, 0xc0, 0x4d, 0x22, 0x9a,
+	0x20, 0xf0, 0x21, 0xe3, 0xd8, 0x05, 0x0f, 0x1a, 0x24, 0x02, 0xec, 0x10, 0xcf, 0x33, 0x6f, 0x16,
+	0xaf, 0x95, 0x6e, 0x55, 0x1b, 0x7d, 0x89, 0x66, 0xf2, 0x8b, 0x25, 0x7a, 0x90, 0x0a, 0x9b, 0xc5,
+	0xcb, 0xb2, 0x37, 0xc7, 0xe8, 0x3b, 0x9d, 0x1d, 0xe6, 0xc2, 0xbe, 0xe2, 0xee, 0x32, 0x5e, 0xcb,
+	0x98, 0x3b, 0xc4, 0xf3, 0x8c, 0x6d, 0xbd, 0xe0, 0x82, 0x47, 0xdb, 0xc0, 0x71, 0xd4, 0xc5, 0x4d,
+	0xc6, 0x8e, 0x70, 0x87, 0x08, 0x3f, 0x29, 0x1b, 0x7b, 0xd4, 0xa7, 0x91, 0x39, 0x5f, 0xd4, 0x4a,
+	0xd7, 0xed, 0xf5, 0x8c, 0xf5, 0x49, 0x77, 0x9f, 0xb1, 0xa3, 0x67, 0x44, 0xf8, 0x7b, 0x44, 0x7c,
+	0x94, 0x10, 0x8c, 0x3f, 0x35, 0x7d, 0xd3, 0x27, 0x5d, 0xec, 0xf6, 0x02, 0xe2, 0x53, 0x07, 0x0f,
+	0x0f, 0xb4, 0x15, 0x76, 0x08, 0x77, 0x31, 0x71, 0xbf, 0x68, 0x89, 0xc8, 0x87, 0x20, 0x32, 0x75,
+	0x75, 0x64, 0x5f, 0x69, 0x33, 0x9f, 0xd9, 0x25, 0x13, 0xc4, 0x12, 0x6d, 0x65, 0xc7, 0x78, 0x29,
+	0xbe, 0x65, 0xbf, 0xe9, 0x93, 0x6e, 0x2d, 0xe5, 0x55, 0xd3, 0x5b, 0xf7, 0x54, 0x91, 0xb6, 0x87,
+	0x1c, 0xe3, 0x6f, 0x4d, 0x2f, 0xe5, 0x86, 0x73, 0x59, 0x27, 0x98, 0xac, 0xf0, 0x35, 0x55, 0xe1,
+	0xb7, 0xb3, 0x57, 0x78, 0xe9, 0x14, 0xb1, 0x44, 0x95, 0x0b, 0x6a, 0xcc, 0xf1, 0xb0, 0xec, 0xb7,
+	0xa6, 0xaa, 0xac, 0x65, 0xb4, 0xb1, 0x3a, 0x1f, 0xea, 0xeb, 0x11, 0xe1, 0x0d, 0x88, 0xd4, 0xe1,
+	0xb7, 0x04, 0xb8, 0x6a, 0x00, 0x0e, 0x3c, 0xe6, 0x1c, 0x99, 0xb7, 0xd4, 0x2d, 0x58, 0x4d, 0x09,
+	0x7b, 0x44, 0x3c, 0x15, 0xe0, 0xd6, 0x81, 0x57, 0x13, 0x30, 0x9d, 0x50, 0xd2, 0x9d, 0x9a, 0xd0,
+	0x85, 0x2b, 0x4f, 0x28, 0xe9, 0x5e, 0x30, 0xa1, 0xa4, 0x9b, 0x37, 0xa1, 0xa4, 0x7b, 0x76, 0x42,
+	0x7f, 0xd5, 0xf4, 0xf5, 0x61, 0x57, 0x46, 0xcd, 0xc0, 0x4e, 0x8b, 0xb7, 0xc1, 0xbc, 0x5d, 0xd4,
+	0x4a, 0xb7, 0xdf, 0xbd, 0x5f, 0x3e, 0xef, 0x3d, 0x5e, 0xce, 0xfa, 0x34, 0xea, 0xcf, 0x4e, 0xe2,
+	0x57, 0xdd, 0xee, 0x4b, 0x74, 0x7e, 0xd8, 0x58, 0xa2, 0xe2, 0xc4, 0x5b, 0x6d, 0x92, 0x62, 0xd9,
+	0x6b, 0x07, 0xb9, 0xa1, 0x0d, 0x4f, 0x5f, 0x1d, 0x7a, 0x35, 0xa9, 0x88, 0x18, 0xef, 0x61, 0x41,
+	0x8f, 0xc1, 0x5c, 0x4c, 0x7a, 0x5f, 0x7d, 0xbf, 0x2f, 0x51, 0x3e, 0x21, 0x96, 0xe8, 0xf5, 0x89,
+	0xac, 0xe3, 0xb0, 0x65, 0x1b, 0x59, 0xc6, 0xfd, 0xd4, 0xfa, 0x31, 0x3d, 0x06, 0xe3, 0x17, 0x4d,
+	0xbf, 0x07, 0xdd, 0x90, 0x05, 0x10, 0x44, 0x94, 0x78, 0xe3, 0x43, 0xe2, 0x92, 0x08, 0xf0, 0x21,
+	0x27, 0x4e, 0x44, 0x59, 0x60, 0x2e, 0xa9, 0xec, 0xcf, 0xfa, 0x12, 0x5d, 0x8a, 0x1f, 0x4b, 0xf4,
+	0x76, 0x2a, 0xe6, 0x32, 0x6c, 0xcb, 0x2e, 0x8e, 0xd1, 0x86, 0x13, 0x98, 0x70, 0x76, 0x33, 0x8a,
+	0xf1, 0x93, 0xa6, 0xaf, 0x86, 0xd4, 0xc5, 0x21, 0x67, 0x21, 0xe3, 0x89, 0x89, 0x78, 0xb8, 0x41,
+	0x68, 0x60, 0xde, 0x51, 0xf7, 0xab, 0x31, 0xf3, 0xfd, 0xca, 0x0f, 0x37, 0x6a, 0x63, 0x2e, 0x6c,
+	0xd9, 0xcb, 0x21, 0x75, 0xeb, 0x63, 0xe6, 0x3d, 0x42, 0x03, 0xe3, 0x6b, 0x4d, 0xbf, 0x93, 0xf0,
+	0x69, 0x10, 0x41, 0x83, 0x0f, 0x94, 0x19, 0x4a, 0x19, 0x9e, 0x59, 0xd9, 0x74, 0xa8, 0x58, 0x22,
+	0x73, 0xa4, 0xea, 0x0c, 0x64, 0xd9, 0x8b, 0x21, 0x75, 0x1f, 0x67, 0x26, 0xa5, 0xe6, 0xfb, 0x64,
+	0x97, 0xa0, 0x2e, 0x76, 0x81, 0xd3, 0x36, 0x89, 0x68, 0x1b, 0x52, 0x3d, 0xcb, 0x57, 0xde, 0x25,
+	0xa6, 0x83, 0x8d, 0xed, 0x12, 0xd3, 0xa0, 0x65, 0x27, 0x25, 0xd4, 0x86, 0x46, 0xa5, 0xea, 0x1b,
+	0x4d, 0x7f, 0x83, 0xb4, 0x22, 0x86, 0x39, 0x34, 0xa8, 0x88, 0x80, 0xe3, 0x20, 0xf5, 0x08, 0x59,
+	0x52, 0x10, 0x17, 0xe6, 0x4a, 0x51, 0x2b, 0xdd, 0xac, 0x3e, 0xee, 0x4b, 0x74, 0x31, 0x31, 0x96,
+	0xe8, 0x5e, 0x9a, 0xfb, 0x42, 0x9a, 0x65, 0x6f, 0x24, 0xb8, 0x9d, 0xc1, 0x4f, 0x14, 0x5a, 0xcf,
+	0x40, 0xa3, 0xa7, 0x9b, 0x67, 0xf9, 0x98, 0x78, 0x1e, 0xeb, 0x24, 0x1f, 0x4b, 0x73, 0xb5, 0x78,
+	0xad, 0x34, 0x5f, 0xfd, 0xb0, 0x2f, 0xd1, 0xb9, 0x9c, 0x58, 0x22, 0x94, 0x6a, 0x38, 0x8f, 0x61,
+	0xd9, 0x6b, 0xc1, 0x78, 0xca, 0xed, 0x01, 0x60, 0xb4, 0xf4, 0xbb, 0x13, 0x4e, 0x2e, 0x04, 0x3d,
+	0x95, 0x79, 0x4d, 0x65, 0xfe, 0xa0, 0x2f, 0xd1, 0x79, 0x94, 0x58, 0xa2, 0x42, 0x6e, 0xe2, 0x01,
+	0xc1, 0xb2, 0x57, 0xcf, 0xe4, 0xad, 0x65, 0xf6, 0x47, 0xd7, 0x7f, 0xfc, 0x19, 0xcd, 0x59, 0xcf,
+	0xff, 0xaf, 0x2f, 0xa4, 0x3b, 0x67, 0x9d, 0xc3, 0xa7, 0xef, 0x3d, 0xbc, 0xff, 0x6a, 0xf5, 0x7c,
+	0xb5, 0x7a, 0xfe, 0x67, 0xab, 0x67, 0x7a, 0x29, 0xab, 0x7b, 0x2f, 0x4e, 0x0a, 0xda, 0xcb, 0x93,
+	0x82, 0xf6, 0xd7, 0x49, 0x41, 0xfb, 0xee, 0xb4, 0x30, 0xf7, 0xf2, 0xb4, 0x30, 0xf7, 0xfb, 0x69,
+	0x61, 0xee, 0xb3, 0xad, 0xb1, 0xb6, 0x0a, 0xa0, 0x5b, 0x83, 0xef, 0xb4, 0xfa, 0x47, 0x7d, 0xa8,
+	0x2b, 0xdd, 0x4a, 0xf2, 0xbb, 0x4a, 0x75, 0xf8, 0xe0, 0x86, 0xc2, 0x1f, 0xfc, 0x3b, 0x00, 0x86,
+	0x12, 0xa3, 0x7c, 0xad, 0x0d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -177,6 +259,93 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NativePointerDenylist) > 0 {
+		for iNdEx := len(m.NativePointerDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NativePointerDenylist[iNdEx])
+			copy(dAtA[i:], m.NativePointerDenylist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.NativePointerDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.NativePointerAllowlist) > 0 {
+		for iNdEx := len(m.NativePointerAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NativePointerAllowlist[iNdEx])
+			copy(dAtA[i:], m.NativePointerAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.NativePointerAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.AutoRegisterNativePointers {
+		i--
+		if m.AutoRegisterNativePointers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.PidDerivativeGain.Size()
+		i -= size
+		if _, err := m.PidDerivativeGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.PidIntegralGain.Size()
+		i -= size
+		if _, err := m.PidIntegralGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.PidProportionalGain.Size()
+		i -= size
+		if _, err := m.PidProportionalGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ExponentialBaseFeeUpdateFraction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExponentialBaseFeeUpdateFraction))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.BaseFeeHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeHistorySize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BaseFeeAdjustmentCurve != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeAdjustmentCurve))
+		i--
+		dAtA[i] = 0x70
+	}
 	{
 		size := m.MaximumFeePerGas.Size()
 		i -= size
@@ -300,6 +469,36 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaximumFeePerGas.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.BaseFeeAdjustmentCurve != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeAdjustmentCurve))
+	}
+	if m.BaseFeeHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeHistorySize))
+	}
+	if m.ExponentialBaseFeeUpdateFraction != 0 {
+		n += 2 + sovParams(uint64(m.ExponentialBaseFeeUpdateFraction))
+	}
+	l = m.PidProportionalGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PidIntegralGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.PidDerivativeGain.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.AutoRegisterNativePointers {
+		n += 3
+	}
+	if len(m.NativePointerAllowlist) > 0 {
+		for _, s := range m.NativePointerAllowlist {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.NativePointerDenylist) > 0 {
+		for _, s := range m.NativePointerDenylist {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -612,6 +811,249 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAdjustmentCurve", wireType)
+			}
+			m.BaseFeeAdjustmentCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAdjustmentCurve |= BaseFeeAdjustmentCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistorySize", wireType)
+			}
+			m.BaseFeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentialBaseFeeUpdateFraction", wireType)
+			}
+			m.ExponentialBaseFeeUpdateFraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExponentialBaseFeeUpdateFraction |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidProportionalGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidProportionalGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidIntegralGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidIntegralGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidDerivativeGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidDerivativeGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRegisterNativePointers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRegisterNativePointers = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePointerAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePointerAllowlist = append(m.NativePointerAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePointerDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativePointerDenylist = append(m.NativePointerDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
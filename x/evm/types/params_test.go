@@ -20,6 +20,15 @@ func TestDefaultParams(t *testing.T) {
 		MaxDynamicBaseFeeUpwardAdjustment:      types.DefaultMaxDynamicBaseFeeUpwardAdjustment,
 		MaxDynamicBaseFeeDownwardAdjustment:    types.DefaultMaxDynamicBaseFeeDownwardAdjustment,
 		TargetGasUsedPerBlock:                  types.DefaultTargetGasUsedPerBlock,
+		BaseFeeAdjustmentCurve:                 types.DefaultBaseFeeAdjustmentCurve,
+		BaseFeeHistorySize:                     types.DefaultBaseFeeHistorySize,
+		ExponentialBaseFeeUpdateFraction:       types.DefaultExponentialBaseFeeUpdateFraction,
+		PidProportionalGain:                    types.DefaultPidProportionalGain,
+		PidIntegralGain:                        types.DefaultPidIntegralGain,
+		PidDerivativeGain:                      types.DefaultPidDerivativeGain,
+		AutoRegisterNativePointers:             types.DefaultAutoRegisterNativePointers,
+		NativePointerAllowlist:                 types.DefaultNativePointerAllowlist,
+		NativePointerDenylist:                  types.DefaultNativePointerDenylist,
 	}, types.DefaultParams())
 	require.Nil(t, types.DefaultParams().Validate())
 }
@@ -107,3 +116,44 @@ func TestValidateParamsValidDeliverTxHookWasmGasLimit(t *testing.T) {
 	err := params.Validate()
 	require.NoError(t, err)
 }
+
+func TestValidateParamsFeeMarket(t *testing.T) {
+	params := types.DefaultParams()
+	params.BaseFeeAdjustmentCurve = types.BaseFeeAdjustmentCurve(3)
+	require.ErrorContains(t, params.Validate(), "unknown base fee adjustment curve")
+
+	params = types.DefaultParams()
+	params.BaseFeeHistorySize = types.MaxBaseFeeHistorySize + 1
+	require.ErrorContains(t, params.Validate(), "base fee history size must be at most")
+	params.BaseFeeHistorySize = 0
+	require.Nil(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ExponentialBaseFeeUpdateFraction = 0
+	require.ErrorContains(t, params.Validate(), "invalid exponential_base_fee_update_fraction")
+
+	params = types.DefaultParams()
+	params.PidIntegralGain = sdk.NewDec(-1)
+	require.ErrorContains(t, params.Validate(), "invalid pid integral gain")
+}
+
+func TestNativePointerAutoRegistrationAllowed(t *testing.T) {
+	params := types.DefaultParams()
+	require.False(t, params.NativePointerAutoRegistrationAllowed("factory/sei1abc/token"))
+
+	params.AutoRegisterNativePointers = true
+	require.True(t, params.NativePointerAutoRegistrationAllowed("factory/sei1abc/token"))
+	require.True(t, params.NativePointerAutoRegistrationAllowed("ibc/ABCDEF"))
+
+	params.NativePointerAllowlist = []string{"factory/"}
+	require.True(t, params.NativePointerAutoRegistrationAllowed("factory/sei1abc/token"))
+	require.False(t, params.NativePointerAutoRegistrationAllowed("ibc/ABCDEF"))
+
+	params.NativePointerDenylist = []string{"factory/sei1abc/"}
+	require.False(t, params.NativePointerAutoRegistrationAllowed("factory/sei1abc/token"))
+	require.True(t, params.NativePointerAutoRegistrationAllowed("factory/sei1def/token"))
+	require.Nil(t, params.Validate())
+
+	params.NativePointerDenylist = []string{""}
+	require.ErrorContains(t, params.Validate(), "invalid native pointer denylist")
+}
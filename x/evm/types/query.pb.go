@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -636,6 +637,244 @@ func (m *QueryPointeeResponse) GetExists() bool {
 	return false
 }
 
+type QueryBaseFeeHistoryRequest struct {
+	// maximum number of records to return, most recent first; all records if 0
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{12}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QueryBaseFeeHistoryResponse struct {
+	Records []BaseFeeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{13}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetRecords() []BaseFeeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type QueryPointerHistoryRequest struct {
+	PointerType PointerType `protobuf:"varint,1,opt,name=pointer_type,json=pointerType,proto3,enum=seiprotocol.seichain.evm.PointerType" json:"pointer_type,omitempty"`
+	Pointee     string      `protobuf:"bytes,2,opt,name=pointee,proto3" json:"pointee,omitempty"`
+}
+
+func (m *QueryPointerHistoryRequest) Reset()         { *m = QueryPointerHistoryRequest{} }
+func (m *QueryPointerHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPointerHistoryRequest) ProtoMessage()    {}
+func (*QueryPointerHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{14}
+}
+func (m *QueryPointerHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointerHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointerHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointerHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointerHistoryRequest.Merge(m, src)
+}
+func (m *QueryPointerHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointerHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointerHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointerHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPointerHistoryRequest) GetPointerType() PointerType {
+	if m != nil {
+		return m.PointerType
+	}
+	return PointerType_ERC20
+}
+
+func (m *QueryPointerHistoryRequest) GetPointee() string {
+	if m != nil {
+		return m.Pointee
+	}
+	return ""
+}
+
+type PointerHistoryEntry struct {
+	Pointer string `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *PointerHistoryEntry) Reset()         { *m = PointerHistoryEntry{} }
+func (m *PointerHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PointerHistoryEntry) ProtoMessage()    {}
+func (*PointerHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{15}
+}
+func (m *PointerHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PointerHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PointerHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PointerHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PointerHistoryEntry.Merge(m, src)
+}
+func (m *PointerHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PointerHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PointerHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PointerHistoryEntry proto.InternalMessageInfo
+
+func (m *PointerHistoryEntry) GetPointer() string {
+	if m != nil {
+		return m.Pointer
+	}
+	return ""
+}
+
+func (m *PointerHistoryEntry) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type QueryPointerHistoryResponse struct {
+	// all registered versions of the pointer, oldest first
+	Versions []PointerHistoryEntry `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *QueryPointerHistoryResponse) Reset()         { *m = QueryPointerHistoryResponse{} }
+func (m *QueryPointerHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPointerHistoryResponse) ProtoMessage()    {}
+func (*QueryPointerHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{16}
+}
+func (m *QueryPointerHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPointerHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPointerHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPointerHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPointerHistoryResponse.Merge(m, src)
+}
+func (m *QueryPointerHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPointerHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPointerHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPointerHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPointerHistoryResponse) GetVersions() []PointerHistoryEntry {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySeiAddressByEVMAddressRequest)(nil), "seiprotocol.seichain.evm.QuerySeiAddressByEVMAddressRequest")
 	proto.RegisterType((*QuerySeiAddressByEVMAddressResponse)(nil), "seiprotocol.seichain.evm.QuerySeiAddressByEVMAddressResponse")
@@ -649,54 +888,70 @@ func init() {
 	proto.RegisterType((*QueryPointerVersionResponse)(nil), "seiprotocol.seichain.evm.QueryPointerVersionResponse")
 	proto.RegisterType((*QueryPointeeRequest)(nil), "seiprotocol.seichain.evm.QueryPointeeRequest")
 	proto.RegisterType((*QueryPointeeResponse)(nil), "seiprotocol.seichain.evm.QueryPointeeResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "seiprotocol.seichain.evm.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "seiprotocol.seichain.evm.QueryBaseFeeHistoryResponse")
+	proto.RegisterType((*QueryPointerHistoryRequest)(nil), "seiprotocol.seichain.evm.QueryPointerHistoryRequest")
+	proto.RegisterType((*PointerHistoryEntry)(nil), "seiprotocol.seichain.evm.PointerHistoryEntry")
+	proto.RegisterType((*QueryPointerHistoryResponse)(nil), "seiprotocol.seichain.evm.QueryPointerHistoryResponse")
 }
 
 func init() { proto.RegisterFile("evm/query.proto", fileDescriptor_11c0d37eed5339f7) }
 
 var fileDescriptor_11c0d37eed5339f7 = []byte{
-	// 662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xae, 0xf3, 0xeb, 0xaf, 0x7f, 0xa6, 0xa5, 0x48, 0x0b, 0x2a, 0x91, 0xa9, 0x4c, 0x65, 0xfe,
-	0xa8, 0xaa, 0x88, 0x03, 0x05, 0x6e, 0xe5, 0x40, 0xab, 0x0a, 0x38, 0x20, 0x81, 0x81, 0x1e, 0xb8,
-	0x44, 0xae, 0x3d, 0x6d, 0x57, 0x8a, 0xbd, 0xae, 0x77, 0x93, 0x36, 0x57, 0x9e, 0x00, 0x09, 0xae,
-	0x1c, 0x78, 0x04, 0x1e, 0x02, 0x89, 0x63, 0x25, 0x2e, 0x1c, 0x51, 0xcb, 0x83, 0x20, 0xaf, 0xd7,
-	0xb1, 0x9d, 0xa6, 0x76, 0x52, 0xc1, 0x6d, 0x67, 0x3d, 0xdf, 0x37, 0xdf, 0xcc, 0xac, 0x3f, 0xb8,
-	0x8c, 0x5d, 0xbf, 0x79, 0xd0, 0xc1, 0xa8, 0x67, 0x85, 0x11, 0x13, 0x8c, 0xd4, 0x39, 0x52, 0x79,
-	0x72, 0x59, 0xdb, 0xe2, 0x48, 0xdd, 0x7d, 0x87, 0x06, 0x16, 0x76, 0x7d, 0x7d, 0x69, 0x8f, 0xb1,
-	0xbd, 0x36, 0x36, 0x9d, 0x90, 0x36, 0x9d, 0x20, 0x60, 0xc2, 0x11, 0x94, 0x05, 0x3c, 0xc1, 0xe9,
-	0x92, 0x08, 0x83, 0x8e, 0xaf, 0x2e, 0xcc, 0x2d, 0x30, 0x5f, 0xc5, 0xbc, 0xaf, 0x91, 0x3e, 0xf1,
-	0xbc, 0x08, 0x39, 0xdf, 0xe8, 0x6d, 0x6d, 0xbf, 0x50, 0x67, 0x1b, 0x0f, 0x3a, 0xc8, 0x05, 0xb9,
-	0x01, 0x73, 0xd8, 0xf5, 0x5b, 0x4e, 0x72, 0x5b, 0xd7, 0x96, 0xb5, 0x95, 0x59, 0x1b, 0xb0, 0xeb,
-	0xab, 0x3c, 0x73, 0x17, 0x6e, 0x96, 0xd2, 0xf0, 0x90, 0x05, 0x1c, 0x63, 0x1e, 0x8e, 0x74, 0x90,
-	0x87, 0xf7, 0x41, 0xc4, 0x00, 0x70, 0x38, 0x67, 0x2e, 0x75, 0x04, 0x7a, 0xf5, 0xda, 0xb2, 0xb6,
-	0x32, 0x63, 0xe7, 0x6e, 0xfa, 0x72, 0x33, 0xee, 0x8d, 0x5c, 0xcd, 0x9c, 0xdc, 0xd2, 0x32, 0x7d,
-	0xb9, 0xe7, 0xd1, 0x64, 0x72, 0x4b, 0xdb, 0xae, 0x94, 0xbb, 0x0e, 0x8b, 0xc9, 0x58, 0xe2, 0x2d,
-	0xb8, 0x9b, 0x4e, 0xbb, 0x9d, 0x4a, 0x24, 0x30, 0xe9, 0x39, 0xc2, 0x91, 0x9c, 0xf3, 0xb6, 0x3c,
-	0x93, 0x05, 0xa8, 0x09, 0x26, 0x59, 0x66, 0xed, 0x9a, 0x60, 0x66, 0x03, 0xae, 0x9d, 0x41, 0x2b,
-	0x65, 0x43, 0xe0, 0x66, 0x0f, 0xae, 0xc8, 0xf4, 0x97, 0x8c, 0x06, 0x02, 0xa3, 0xb4, 0xd2, 0x33,
-	0x98, 0x0f, 0x93, 0x9b, 0x96, 0xe8, 0x85, 0x28, 0x21, 0x0b, 0x6b, 0xb7, 0xad, 0xf3, 0x5e, 0x90,
-	0xa5, 0xf0, 0x6f, 0x7a, 0x21, 0xda, 0x73, 0x61, 0x16, 0x90, 0x3a, 0x4c, 0x27, 0x21, 0x2a, 0x91,
-	0x69, 0x68, 0xee, 0xc0, 0xd5, 0x62, 0x69, 0x25, 0xb3, 0x8f, 0x88, 0xd4, 0xf0, 0xd2, 0x30, 0xfe,
-	0xd2, 0xc5, 0x88, 0x53, 0x16, 0x48, 0xae, 0x4b, 0x76, 0x1a, 0x92, 0x45, 0x98, 0xc2, 0x23, 0xca,
-	0x05, 0xaf, 0xff, 0x27, 0xe7, 0xa9, 0x22, 0x73, 0x17, 0xf4, 0x7c, 0x8d, 0xed, 0x24, 0xfd, 0xaf,
-	0x77, 0x69, 0xbe, 0x85, 0xeb, 0x43, 0xeb, 0x64, 0x2d, 0xa5, 0xc2, 0xb5, 0xa2, 0xf0, 0x25, 0x00,
-	0xf7, 0xb0, 0xe5, 0x32, 0x0f, 0x5b, 0x34, 0x79, 0x0c, 0x93, 0xf6, 0x8c, 0x7b, 0xb8, 0xc9, 0x3c,
-	0x7c, 0xee, 0x0d, 0x6c, 0x07, 0xff, 0xe1, 0x76, 0xa2, 0xe2, 0x76, 0xa2, 0x81, 0xed, 0xe0, 0xd9,
-	0xed, 0x60, 0x71, 0x3b, 0x38, 0xfe, 0x76, 0xd6, 0x3e, 0xcf, 0xc0, 0xff, 0xb2, 0x08, 0xf9, 0xa6,
-	0xc1, 0xe2, 0x70, 0x1b, 0x20, 0xeb, 0xe7, 0xb7, 0x55, 0x6d, 0x42, 0xfa, 0xe3, 0x0b, 0xa2, 0x93,
-	0x6e, 0x4d, 0xeb, 0xfd, 0x8f, 0xdf, 0x1f, 0x6b, 0x2b, 0xe4, 0x4e, 0x93, 0x23, 0x6d, 0xa4, 0x3c,
-	0xcd, 0x94, 0xa7, 0x19, 0x3b, 0x63, 0xce, 0x35, 0x64, 0x1f, 0xc3, 0xfd, 0xa1, 0xb2, 0x8f, 0x52,
-	0x77, 0xaa, 0xec, 0xa3, 0xdc, 0x94, 0x46, 0xea, 0x23, 0xe7, 0x5a, 0xe4, 0x8b, 0x06, 0x90, 0x39,
-	0x08, 0xb9, 0x57, 0x35, 0xc5, 0x41, 0xab, 0xd2, 0xef, 0x8f, 0x81, 0x18, 0x67, 0xd6, 0x12, 0xd6,
-	0x72, 0x63, 0x51, 0x9f, 0x34, 0x98, 0x56, 0x0f, 0x9b, 0x34, 0x2a, 0xca, 0x15, 0xed, 0x4d, 0xb7,
-	0x46, 0x4d, 0x57, 0xd2, 0x56, 0xa5, 0xb4, 0x5b, 0xc4, 0x2c, 0x91, 0x96, 0x9a, 0xd4, 0x57, 0x0d,
-	0x16, 0x8a, 0x36, 0x40, 0x1e, 0x8e, 0x56, 0xae, 0xe8, 0x4e, 0xfa, 0xa3, 0x31, 0x51, 0x4a, 0xeb,
-	0x9a, 0xd4, 0x7a, 0x97, 0xac, 0x56, 0x6b, 0x6d, 0xa5, 0x3f, 0x68, 0x36, 0x4a, 0x1c, 0x71, 0x94,
-	0x38, 0xde, 0x28, 0xf1, 0x02, 0xa3, 0xc4, 0x8d, 0xa7, 0xdf, 0x4f, 0x0c, 0xed, 0xf8, 0xc4, 0xd0,
-	0x7e, 0x9d, 0x18, 0xda, 0x87, 0x53, 0x63, 0xe2, 0xf8, 0xd4, 0x98, 0xf8, 0x79, 0x6a, 0x4c, 0xbc,
-	0x6b, 0xec, 0x51, 0xb1, 0xdf, 0xd9, 0xb1, 0x5c, 0xe6, 0x9f, 0xe1, 0x69, 0x24, 0x44, 0x47, 0x92,
-	0x2a, 0xf6, 0x47, 0xbe, 0x33, 0x25, 0xbf, 0x3f, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xa9,
-	0x46, 0xf3, 0x13, 0x09, 0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0x8e, 0xd3, 0xf4, 0xef, 0xa4, 0x04, 0x69, 0x5a, 0x95, 0xc8, 0xad, 0xd2, 0xca, 0xfc, 0x45,
+	0x85, 0xd8, 0x90, 0xd2, 0x5d, 0xbb, 0x20, 0x55, 0x69, 0xbb, 0x40, 0x80, 0x81, 0x2e, 0xd8, 0x58,
+	0x8e, 0x7d, 0x92, 0x5a, 0x4a, 0x3c, 0xa9, 0xc7, 0x49, 0x9b, 0x1d, 0x62, 0xc1, 0x1a, 0x09, 0x5e,
+	0x80, 0x47, 0x80, 0x77, 0x40, 0xea, 0xb2, 0x12, 0x1b, 0x56, 0xe8, 0xaa, 0xbd, 0x0f, 0x72, 0xe5,
+	0xf1, 0x38, 0xb1, 0xd3, 0x24, 0x4e, 0xa2, 0x7b, 0xef, 0x6e, 0xc6, 0x39, 0xdf, 0x77, 0xbe, 0xf3,
+	0x9d, 0x99, 0x39, 0x81, 0x77, 0xb1, 0xd7, 0xd6, 0x6e, 0xba, 0xe8, 0xf5, 0xd5, 0x8e, 0x47, 0x7d,
+	0x4a, 0x8a, 0x0c, 0x1d, 0xbe, 0xb2, 0x68, 0x4b, 0x65, 0xe8, 0x58, 0xd7, 0xa6, 0xe3, 0xaa, 0xd8,
+	0x6b, 0xcb, 0xbb, 0x4d, 0x4a, 0x9b, 0x2d, 0xd4, 0xcc, 0x8e, 0xa3, 0x99, 0xae, 0x4b, 0x7d, 0xd3,
+	0x77, 0xa8, 0xcb, 0x42, 0x9c, 0xcc, 0x89, 0xd0, 0xed, 0xb6, 0xa3, 0x0f, 0x5b, 0x4d, 0xda, 0xa4,
+	0x7c, 0xa9, 0x05, 0xab, 0x78, 0x98, 0xdf, 0xef, 0xa0, 0x08, 0x53, 0xce, 0x40, 0xf9, 0x2e, 0x48,
+	0xff, 0x3d, 0x3a, 0x5f, 0xda, 0xb6, 0x87, 0x8c, 0xd5, 0xfa, 0x67, 0x57, 0x5f, 0x8b, 0xb5, 0x8e,
+	0x37, 0x5d, 0x64, 0x3e, 0xd9, 0x83, 0x3c, 0xf6, 0xda, 0x86, 0x19, 0x7e, 0x2d, 0x4a, 0xfb, 0x52,
+	0x79, 0x5d, 0x07, 0xec, 0xb5, 0x45, 0x9c, 0xd2, 0x80, 0xf7, 0xa7, 0xd2, 0xb0, 0x0e, 0x75, 0x19,
+	0x06, 0x3c, 0x0c, 0x9d, 0x51, 0x1e, 0x36, 0x00, 0x91, 0x12, 0x80, 0xc9, 0x18, 0xb5, 0x1c, 0xd3,
+	0x47, 0xbb, 0x98, 0xdd, 0x97, 0xca, 0x6b, 0x7a, 0xec, 0xcb, 0x40, 0xee, 0x90, 0xbb, 0x16, 0xcb,
+	0x19, 0x93, 0x3b, 0x35, 0xcd, 0x40, 0xee, 0x24, 0x9a, 0xa1, 0xdc, 0xa9, 0x65, 0xa7, 0xca, 0x3d,
+	0x86, 0xed, 0xd0, 0x96, 0xa0, 0x59, 0xd6, 0xa9, 0xd9, 0x6a, 0x45, 0x12, 0x09, 0xe4, 0x6c, 0xd3,
+	0x37, 0x39, 0xe7, 0x86, 0xce, 0xd7, 0xa4, 0x00, 0x59, 0x9f, 0x72, 0x96, 0x75, 0x3d, 0xeb, 0x53,
+	0xa5, 0x02, 0xef, 0x3d, 0x43, 0x0b, 0x65, 0x63, 0xe0, 0x4a, 0x1f, 0x36, 0x79, 0xf8, 0xb7, 0xd4,
+	0x71, 0x7d, 0xf4, 0xa2, 0x4c, 0x17, 0xb0, 0xd1, 0x09, 0xbf, 0x18, 0x41, 0xe3, 0x39, 0xa4, 0x50,
+	0xfd, 0x50, 0x9d, 0x74, 0xd0, 0x54, 0x81, 0xff, 0xa1, 0xdf, 0x41, 0x3d, 0xdf, 0x19, 0x6e, 0x48,
+	0x11, 0x56, 0xc3, 0x2d, 0x0a, 0x91, 0xd1, 0x56, 0xa9, 0xc3, 0x56, 0x32, 0xb5, 0x90, 0x39, 0x40,
+	0x78, 0xc2, 0xbc, 0x68, 0x1b, 0xfc, 0xd2, 0x43, 0x8f, 0x39, 0xd4, 0xe5, 0x5c, 0xef, 0xe8, 0xd1,
+	0x96, 0x6c, 0xc3, 0x0a, 0xde, 0x39, 0xcc, 0x67, 0xc5, 0x25, 0xee, 0xa7, 0xd8, 0x29, 0x0d, 0x90,
+	0xe3, 0x39, 0xae, 0xc2, 0xf0, 0xd7, 0x5e, 0xa5, 0xf2, 0x23, 0xec, 0x8c, 0xcd, 0x33, 0x2c, 0x29,
+	0x12, 0x2e, 0x25, 0x85, 0xef, 0x02, 0x58, 0xb7, 0x86, 0x45, 0x6d, 0x34, 0x9c, 0xf0, 0x30, 0xe4,
+	0xf4, 0x35, 0xeb, 0xf6, 0x94, 0xda, 0x78, 0x69, 0x8f, 0x74, 0x07, 0xdf, 0x60, 0x77, 0xbc, 0x64,
+	0x77, 0xbc, 0x91, 0xee, 0xe0, 0xf3, 0xee, 0x60, 0xb2, 0x3b, 0xb8, 0x40, 0x77, 0xaa, 0xa2, 0x3b,
+	0x35, 0x93, 0xe1, 0x57, 0x88, 0x17, 0x0e, 0xf3, 0xa9, 0xd7, 0x8f, 0xaa, 0xdc, 0x82, 0x65, 0x8b,
+	0x76, 0x5d, 0x9f, 0xe7, 0xc9, 0xe9, 0xe1, 0x46, 0x69, 0xc0, 0xce, 0x58, 0x8c, 0x90, 0x77, 0x0e,
+	0xab, 0x1e, 0x5a, 0xd4, 0xb3, 0x83, 0x9b, 0xb7, 0x54, 0xce, 0x57, 0x3f, 0x9e, 0xec, 0x8a, 0xa0,
+	0xd0, 0x79, 0x7c, 0x2d, 0x77, 0xff, 0xff, 0x5e, 0x46, 0x8f, 0xd0, 0xca, 0xcf, 0x52, 0xf2, 0xe8,
+	0x8c, 0x88, 0x7b, 0x1b, 0x17, 0xe4, 0x12, 0x36, 0x93, 0xc9, 0xcf, 0x5c, 0xdf, 0xeb, 0x2f, 0x72,
+	0x3f, 0x14, 0x37, 0x79, 0x3e, 0x47, 0x5d, 0xfb, 0x06, 0xd6, 0x44, 0x64, 0x64, 0x5b, 0x25, 0xb5,
+	0x92, 0xb8, 0x26, 0x61, 0xde, 0x80, 0xa4, 0xfa, 0x6b, 0x1e, 0x96, 0x79, 0x42, 0xf2, 0x8f, 0x04,
+	0xdb, 0xe3, 0x1f, 0x78, 0x72, 0x3c, 0x39, 0x47, 0xfa, 0x78, 0x91, 0x4f, 0x16, 0x44, 0x87, 0x25,
+	0x2b, 0xea, 0x2f, 0xff, 0xbe, 0xfc, 0x3d, 0x5b, 0x26, 0x1f, 0x69, 0x0c, 0x9d, 0x4a, 0xc4, 0xa3,
+	0x45, 0x3c, 0x5a, 0x30, 0xf3, 0x62, 0xf3, 0x80, 0xd7, 0x31, 0xfe, 0xe5, 0x4f, 0xad, 0x63, 0xea,
+	0xdc, 0x91, 0x4f, 0x16, 0x44, 0xcf, 0x51, 0x47, 0x6c, 0x1e, 0x91, 0x3f, 0x25, 0x80, 0xe1, 0x6c,
+	0x20, 0x9f, 0xa5, 0xb9, 0x38, 0x3a, 0x84, 0xe4, 0xcf, 0xe7, 0x40, 0xcc, 0xe3, 0x35, 0x87, 0x19,
+	0x56, 0x20, 0xea, 0x0f, 0x09, 0x56, 0xc5, 0x29, 0x23, 0x95, 0x94, 0x74, 0xc9, 0xc1, 0x25, 0xab,
+	0xb3, 0x86, 0x0b, 0x69, 0x07, 0x5c, 0xda, 0x07, 0x44, 0x99, 0x22, 0x2d, 0xba, 0x5e, 0x7f, 0x49,
+	0x50, 0x48, 0x3e, 0xf0, 0xe4, 0x8b, 0xd9, 0xd2, 0x25, 0xe7, 0x8e, 0x7c, 0x34, 0x27, 0x4a, 0x68,
+	0xad, 0x72, 0xad, 0x9f, 0x92, 0x83, 0x74, 0xad, 0x46, 0xf4, 0xf4, 0x0e, 0xad, 0xc4, 0x19, 0xad,
+	0xc4, 0xf9, 0xac, 0xc4, 0x05, 0xac, 0x44, 0xf2, 0xb7, 0x04, 0x85, 0xe4, 0x0b, 0x9e, 0x6a, 0xe5,
+	0xd8, 0x21, 0x21, 0x1f, 0xcd, 0x89, 0x12, 0x5a, 0x0f, 0xb9, 0xd6, 0x0a, 0xf9, 0x64, 0x8a, 0xd6,
+	0xba, 0xc9, 0xd0, 0x68, 0x20, 0x1a, 0xd7, 0x42, 0x61, 0xac, 0xff, 0xb3, 0x8a, 0x1e, 0x3b, 0x3c,
+	0xe4, 0xa3, 0x39, 0x51, 0x0b, 0xf4, 0x5f, 0x68, 0xae, 0x9d, 0xdf, 0x3f, 0x96, 0xa4, 0x87, 0xc7,
+	0x92, 0xf4, 0xe2, 0xb1, 0x24, 0xfd, 0xf6, 0x54, 0xca, 0x3c, 0x3c, 0x95, 0x32, 0xff, 0x3d, 0x95,
+	0x32, 0x3f, 0x55, 0x9a, 0x8e, 0x7f, 0xdd, 0xad, 0xab, 0x16, 0x6d, 0x3f, 0xe3, 0xab, 0x84, 0x84,
+	0x77, 0xda, 0xe0, 0x9f, 0x7f, 0x7d, 0x85, 0xff, 0x7e, 0xf8, 0x6a, 0x00, 0x9c, 0x42, 0x90, 0x68,
+	0x7d, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -717,6 +972,8 @@ type QueryClient interface {
 	Pointer(ctx context.Context, in *QueryPointerRequest, opts ...grpc.CallOption) (*QueryPointerResponse, error)
 	PointerVersion(ctx context.Context, in *QueryPointerVersionRequest, opts ...grpc.CallOption) (*QueryPointerVersionResponse, error)
 	Pointee(ctx context.Context, in *QueryPointeeRequest, opts ...grpc.CallOption) (*QueryPointeeResponse, error)
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	PointerHistory(ctx context.Context, in *QueryPointerHistoryRequest, opts ...grpc.CallOption) (*QueryPointerHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -781,6 +1038,24 @@ func (c *queryClient) Pointee(ctx context.Context, in *QueryPointeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.evm.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PointerHistory(ctx context.Context, in *QueryPointerHistoryRequest, opts ...grpc.CallOption) (*QueryPointerHistoryResponse, error) {
+	out := new(QueryPointerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.evm.Query/PointerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	SeiAddressByEVMAddress(context.Context, *QuerySeiAddressByEVMAddressRequest) (*QuerySeiAddressByEVMAddressResponse, error)
@@ -789,6 +1064,8 @@ type QueryServer interface {
 	Pointer(context.Context, *QueryPointerRequest) (*QueryPointerResponse, error)
 	PointerVersion(context.Context, *QueryPointerVersionRequest) (*QueryPointerVersionResponse, error)
 	Pointee(context.Context, *QueryPointeeRequest) (*QueryPointeeResponse, error)
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	PointerHistory(context.Context, *QueryPointerHistoryRequest) (*QueryPointerHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -813,6 +1090,12 @@ func (*UnimplementedQueryServer) PointerVersion(ctx context.Context, req *QueryP
 func (*UnimplementedQueryServer) Pointee(ctx context.Context, req *QueryPointeeRequest) (*QueryPointeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pointee not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) PointerHistory(ctx context.Context, req *QueryPointerHistoryRequest) (*QueryPointerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PointerHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -926,6 +1209,42 @@ func _Query_Pointee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.evm.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PointerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPointerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PointerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.evm.Query/PointerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PointerHistory(ctx, req.(*QueryPointerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.evm.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -954,6 +1273,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Pointee",
 			Handler:    _Query_Pointee_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "PointerHistory",
+			Handler:    _Query_PointerHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evm/query.proto",
@@ -1387,49 +1714,221 @@ func (m *QueryPointeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySeiAddressByEVMAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySeiAddressByEVMAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SeiAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Associated {
-		n += 2
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEVMAddressBySeiAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPointerHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointerHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointerHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pointee) > 0 {
+		i -= len(m.Pointee)
+		copy(dAtA[i:], m.Pointee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pointee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PointerType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PointerType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PointerHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PointerHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PointerHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pointer) > 0 {
+		i -= len(m.Pointer)
+		copy(dAtA[i:], m.Pointer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pointer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPointerHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPointerHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPointerHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySeiAddressByEVMAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySeiAddressByEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeiAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Associated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEVMAddressBySeiAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1583,6 +2082,80 @@ func (m *QueryPointeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPointerHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PointerType != 0 {
+		n += 1 + sovQuery(uint64(m.PointerType))
+	}
+	l = len(m.Pointee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PointerHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pointer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryPointerHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2758,6 +3331,445 @@ func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BaseFeeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPointerHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPointerHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPointerHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PointerHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PointerHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PointerHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPointerHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPointerHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPointerHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, PointerHistoryEntry{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
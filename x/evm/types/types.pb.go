@@ -137,36 +137,104 @@ func (m *DeferredInfo) GetError() string {
 	return ""
 }
 
+// BaseFeeRecord is the base fee a block was executed with and the gas it used.
+type BaseFeeRecord struct {
+	Height    int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BaseFee   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee"`
+	GasUsed   uint64                                 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasTarget uint64                                 `protobuf:"varint,4,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+}
+
+func (m *BaseFeeRecord) Reset()         { *m = BaseFeeRecord{} }
+func (m *BaseFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeRecord) ProtoMessage()    {}
+func (*BaseFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{2}
+}
+func (m *BaseFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeRecord.Merge(m, src)
+}
+func (m *BaseFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeRecord proto.InternalMessageInfo
+
+func (m *BaseFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BaseFeeRecord) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Whitelist)(nil), "seiprotocol.seichain.evm.Whitelist")
 	proto.RegisterType((*DeferredInfo)(nil), "seiprotocol.seichain.evm.DeferredInfo")
+	proto.RegisterType((*BaseFeeRecord)(nil), "seiprotocol.seichain.evm.BaseFeeRecord")
 }
 
 func init() { proto.RegisterFile("evm/types.proto", fileDescriptor_6eba926c274d8fd0) }
 
 var fileDescriptor_6eba926c274d8fd0 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x6b, 0xf2, 0x40,
-	0x18, 0xc7, 0x73, 0xaf, 0xaf, 0xa6, 0x1e, 0x4a, 0x69, 0x10, 0x7a, 0xed, 0x10, 0x43, 0x86, 0x92,
-	0x0e, 0x26, 0x43, 0xa1, 0x43, 0xc7, 0x50, 0xa8, 0xae, 0x59, 0x0a, 0x5d, 0x24, 0xc6, 0x47, 0x73,
-	0x34, 0xc9, 0xc9, 0x3d, 0xa7, 0x9c, 0xdf, 0xa2, 0x5f, 0xa8, 0xbb, 0xa3, 0x63, 0xe9, 0x20, 0x45,
-	0xbf, 0x41, 0x3f, 0x41, 0x49, 0x8c, 0xa5, 0xd3, 0x3d, 0xbf, 0xfb, 0x3f, 0xff, 0x3b, 0xf8, 0xd1,
-	0x73, 0x58, 0xe5, 0x81, 0x5a, 0x2f, 0x00, 0xfd, 0x85, 0x14, 0x4a, 0x58, 0x0c, 0x81, 0x57, 0x53,
-	0x22, 0x32, 0x1f, 0x81, 0x27, 0x69, 0xcc, 0x0b, 0x1f, 0x56, 0xf9, 0x75, 0x6f, 0x2e, 0xe6, 0xa2,
-	0x8a, 0x82, 0x72, 0x3a, 0xee, 0xbb, 0xf7, 0xb4, 0xfd, 0x9c, 0x72, 0x05, 0x19, 0x47, 0x65, 0xdd,
-	0xd2, 0x56, 0x1a, 0x63, 0x0a, 0xc8, 0x88, 0xd3, 0xf0, 0xda, 0xe1, 0xc5, 0xf7, 0xae, 0xdf, 0x5d,
-	0xc7, 0x79, 0xf6, 0xe0, 0x1e, 0xef, 0xdd, 0xa8, 0x5e, 0x70, 0xdf, 0x09, 0xed, 0x3c, 0xc2, 0x0c,
-	0xa4, 0x84, 0xe9, 0xa8, 0x98, 0x09, 0xeb, 0x8a, 0x9e, 0x29, 0x3d, 0xe6, 0xc5, 0x14, 0x34, 0x23,
-	0x0e, 0xf1, 0xba, 0x91, 0xa9, 0xf4, 0xa8, 0x44, 0xeb, 0x92, 0x9a, 0x4a, 0x8f, 0xcb, 0x22, 0xfb,
-	0xe7, 0x10, 0xaf, 0x13, 0xb5, 0x94, 0x1e, 0xc6, 0x98, 0xd6, 0x9d, 0x49, 0x26, 0x44, 0xce, 0x1a,
-	0x55, 0x62, 0x2a, 0x1d, 0x96, 0x68, 0x0d, 0xa9, 0x89, 0x4b, 0xb9, 0xc8, 0x96, 0xc8, 0xfe, 0x3b,
-	0xc4, 0x6b, 0x87, 0xfe, 0x66, 0xd7, 0x37, 0x3e, 0x77, 0xfd, 0x9b, 0x39, 0x57, 0xe9, 0x72, 0xe2,
-	0x27, 0x22, 0x0f, 0x12, 0x81, 0xb9, 0xc0, 0xfa, 0x18, 0xe0, 0xf4, 0xb5, 0x56, 0x31, 0x2a, 0x54,
-	0x74, 0xaa, 0x5b, 0x3d, 0xda, 0x04, 0x29, 0x85, 0x64, 0xcd, 0xf2, 0x9d, 0xe8, 0x08, 0xe1, 0xd3,
-	0x66, 0x6f, 0x93, 0xed, 0xde, 0x26, 0x5f, 0x7b, 0x9b, 0xbc, 0x1d, 0x6c, 0x63, 0x7b, 0xb0, 0x8d,
-	0x8f, 0x83, 0x6d, 0xbc, 0x0c, 0xfe, 0x7c, 0x80, 0xc0, 0x07, 0x27, 0x9b, 0x15, 0x54, 0x3a, 0x03,
-	0x1d, 0xfc, 0x6a, 0x9f, 0xb4, 0xaa, 0xfc, 0xee, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xbe, 0x17,
-	0x45, 0x8a, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xad, 0xb7, 0xdd, 0x66, 0x6b, 0x6d, 0x85, 0xb0, 0x56, 0x10, 0x90, 0x48, 0xab, 0x1c, 0x50,
+	0x39, 0x34, 0x39, 0x20, 0x71, 0xe0, 0x18, 0xad, 0x60, 0x7b, 0xb5, 0x40, 0x48, 0x5c, 0x2a, 0x27,
+	0x99, 0xda, 0x16, 0x49, 0x5d, 0x79, 0xdc, 0x55, 0xf6, 0x2f, 0xf8, 0x10, 0x7e, 0x81, 0xfb, 0x1e,
+	0xf7, 0x88, 0x38, 0x54, 0xa8, 0xfd, 0x03, 0xbe, 0x00, 0xc5, 0x4d, 0x11, 0x57, 0x4e, 0x99, 0x37,
+	0x6f, 0x5e, 0xfc, 0x9e, 0xc7, 0xf4, 0x11, 0xdc, 0xd6, 0xa9, 0xbb, 0xdb, 0x00, 0x26, 0x1b, 0x6b,
+	0x9c, 0x61, 0x21, 0x82, 0xf6, 0x55, 0x61, 0xaa, 0x04, 0x41, 0x17, 0x4a, 0xe8, 0x75, 0x02, 0xb7,
+	0xf5, 0xf3, 0x2b, 0x69, 0xa4, 0xf1, 0x54, 0xda, 0x56, 0xc7, 0xf9, 0xf8, 0x0d, 0x1d, 0x7d, 0x52,
+	0xda, 0x41, 0xa5, 0xd1, 0xb1, 0x57, 0x74, 0xa8, 0x04, 0x2a, 0xc0, 0x90, 0x4c, 0xfb, 0xb3, 0x51,
+	0xf6, 0xf8, 0xf7, 0x6e, 0x32, 0xbe, 0x13, 0x75, 0xf5, 0x36, 0x3e, 0xf6, 0x63, 0xde, 0x0d, 0xc4,
+	0xdf, 0x09, 0xbd, 0xbc, 0x86, 0x15, 0x58, 0x0b, 0xe5, 0x62, 0xbd, 0x32, 0xec, 0x19, 0xbd, 0x70,
+	0xcd, 0x52, 0xaf, 0x4b, 0x68, 0x42, 0x32, 0x25, 0xb3, 0x31, 0x0f, 0x5c, 0xb3, 0x68, 0x21, 0x7b,
+	0x4a, 0x03, 0xd7, 0x2c, 0x5b, 0x61, 0x78, 0x36, 0x25, 0xb3, 0x4b, 0x3e, 0x74, 0xcd, 0x8d, 0x40,
+	0xd5, 0x69, 0xf2, 0xca, 0x98, 0x3a, 0xec, 0x7b, 0x26, 0x70, 0x4d, 0xd6, 0x42, 0x76, 0x43, 0x03,
+	0xdc, 0xda, 0x4d, 0xb5, 0xc5, 0x70, 0x30, 0x25, 0xb3, 0x51, 0x96, 0xdc, 0xef, 0x26, 0xbd, 0x9f,
+	0xbb, 0xc9, 0x4b, 0xa9, 0x9d, 0xda, 0xe6, 0x49, 0x61, 0xea, 0xb4, 0x30, 0x58, 0x1b, 0xec, 0x3e,
+	0x73, 0x2c, 0xbf, 0x74, 0x57, 0xb1, 0x58, 0x3b, 0x7e, 0x92, 0xb3, 0x2b, 0x7a, 0x0e, 0xd6, 0x1a,
+	0x1b, 0x9e, 0xb7, 0xff, 0xe1, 0x47, 0x10, 0x7f, 0x23, 0x74, 0x9c, 0x09, 0x84, 0x77, 0x00, 0x1c,
+	0x0a, 0x63, 0x4b, 0xf6, 0x84, 0x0e, 0x15, 0x68, 0xa9, 0x9c, 0xb7, 0xdf, 0xe7, 0x1d, 0x62, 0x0b,
+	0x7a, 0x91, 0x0b, 0x84, 0xe5, 0x0a, 0x20, 0x3c, 0xfb, 0x6f, 0x2b, 0xd7, 0x50, 0xf0, 0x20, 0x3f,
+	0x1e, 0xd4, 0xe6, 0x95, 0x02, 0x97, 0x5b, 0x84, 0xd2, 0xe7, 0x1d, 0xf0, 0x40, 0x0a, 0xfc, 0x88,
+	0x50, 0xb2, 0x17, 0x94, 0xb6, 0x94, 0x13, 0x56, 0x82, 0xf3, 0x91, 0x07, 0x7c, 0x24, 0x05, 0x7e,
+	0xf0, 0x8d, 0xec, 0xfd, 0xfd, 0x3e, 0x22, 0x0f, 0xfb, 0x88, 0xfc, 0xda, 0x47, 0xe4, 0xeb, 0x21,
+	0xea, 0x3d, 0x1c, 0xa2, 0xde, 0x8f, 0x43, 0xd4, 0xfb, 0x3c, 0xff, 0xc7, 0x04, 0x82, 0x9e, 0x9f,
+	0x96, 0xef, 0x81, 0xdf, 0x7e, 0xda, 0xa4, 0x7f, 0x5f, 0x49, 0x3e, 0xf4, 0xfc, 0xeb, 0x3f, 0x03,
+	0x00, 0x17, 0xa1, 0xbf, 0x88, 0x39, 0x02, 0x00, 0x00,
 }
 
 func (m *Whitelist) Marshal() (dAtA []byte, err error) {
@@ -260,6 +328,54 @@ func (m *DeferredInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasTarget != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -312,6 +428,26 @@ func (m *DeferredInfo) Size() (n int) {
 	return n
 }
 
+func (m *BaseFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovTypes(uint64(m.GasTarget))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -603,6 +739,147 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BaseFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
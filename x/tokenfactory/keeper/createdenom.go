@@ -17,7 +17,14 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creatorAddr string, subdenom string
 	}
 
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom)
-	return denom, err
+	if err != nil {
+		return denom, err
+	}
+	// hooks only run for denoms created by messages, not for those restored from genesis
+	if k.hooks != nil {
+		k.hooks.AfterDenomCreated(ctx, denom)
+	}
+	return denom, nil
 }
 
 // Runs CreateDenom logic after the charge and all denom validation has been handled.
@@ -24,6 +24,8 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+
+		hooks types.TokenFactoryHooks
 	}
 )
 
@@ -55,6 +57,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// Set the tokenfactory hooks.
+func (k *Keeper) SetHooks(h types.TokenFactoryHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set tokenfactory hooks twice")
+	}
+	k.hooks = h
+	return k
+}
+
 // GetDenomPrefixStore returns the substore for a specific denom
 func (k Keeper) GetDenomPrefixStore(ctx sdk.Context, denom string) sdk.KVStore {
 	store := ctx.KVStore(k.storeKey)
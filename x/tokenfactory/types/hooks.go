@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type TokenFactoryHooks interface {
+	AfterDenomCreated(ctx sdk.Context, denom string)
+}
+
+var _ TokenFactoryHooks = MultiTokenFactoryHooks{}
+
+// combine multiple tokenfactory hooks, all hook functions are run in array sequence.
+type MultiTokenFactoryHooks []TokenFactoryHooks
+
+func NewMultiTokenFactoryHooks(hooks ...TokenFactoryHooks) MultiTokenFactoryHooks {
+	return hooks
+}
+
+func (h MultiTokenFactoryHooks) AfterDenomCreated(ctx sdk.Context, denom string) {
+	for i := range h {
+		h[i].AfterDenomCreated(ctx, denom)
+	}
+}